@@ -110,6 +110,8 @@ type AccessEntryStatus struct {
 	// The Unix epoch timestamp at object creation.
 	// +kubebuilder:validation:Optional
 	CreatedAt *metav1.Time `json:"createdAt,omitempty"`
+	// +kubebuilder:validation:Optional
+	DryRunPlan []*string `json:"dryRunPlan,omitempty"`
 	// The Unix epoch timestamp for the last modification to the object.
 	// +kubebuilder:validation:Optional
 	ModifiedAt *metav1.Time `json:"modifiedAt,omitempty"`
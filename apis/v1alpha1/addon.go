@@ -18,6 +18,7 @@ package v1alpha1
 import (
 	ackv1alpha1 "github.com/aws-controllers-k8s/runtime/apis/core/v1alpha1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 )
 
 // AddonSpec defines the desired state of Addon.
@@ -37,9 +38,12 @@ type AddonSpec struct {
 	// Regex Pattern: `^[0-9A-Za-z][A-Za-z0-9\-_]*$`
 	ClusterName *string                                  `json:"clusterName,omitempty"`
 	ClusterRef  *ackv1alpha1.AWSResourceReferenceWrapper `json:"clusterRef,omitempty"`
+	// +kubebuilder:pruning:PreserveUnknownFields
+	Configuration *runtime.RawExtension `json:"configuration,omitempty"`
 	// The set of configuration values for the add-on that's created. The values
 	// that you provide are validated against the schema returned by DescribeAddonConfiguration.
-	ConfigurationValues *string `json:"configurationValues,omitempty"`
+	ConfigurationValues     *string                         `json:"configurationValues,omitempty"`
+	ConfigurationValuesFrom *AddonConfigurationValuesSource `json:"configurationValuesFrom,omitempty"`
 	// The name of the add-on. The name must match one of the names returned by
 	// DescribeAddonVersions.
 	// +kubebuilder:validation:Required
@@ -108,6 +112,8 @@ type AddonStatus struct {
 	// The Unix epoch timestamp at object creation.
 	// +kubebuilder:validation:Optional
 	CreatedAt *metav1.Time `json:"createdAt,omitempty"`
+	// +kubebuilder:validation:Optional
+	DryRunPlan []*string `json:"dryRunPlan,omitempty"`
 	// An object that represents the health of the add-on.
 	// +kubebuilder:validation:Optional
 	Health *AddonHealth `json:"health,omitempty"`
@@ -123,6 +129,8 @@ type AddonStatus struct {
 	// The publisher of the add-on.
 	// +kubebuilder:validation:Optional
 	Publisher *string `json:"publisher,omitempty"`
+	// +kubebuilder:validation:Optional
+	ResolvedAddonVersion *string `json:"resolvedAddonVersion,omitempty"`
 	// The status of the add-on.
 	// +kubebuilder:validation:Optional
 	Status *string `json:"status,omitempty"`
@@ -34,6 +34,32 @@ var (
 	// the value is not one of the above, the controller will default to managing the desired size
 	// as if the annotation was set to "controller".
 	DesiredSizeManagedByAnnotation = fmt.Sprintf("%s/desired-size-managed-by", GroupVersion.Group)
+	// OwnedLabelKeysAnnotation is the annotation key used to list, comma separated, the label keys
+	// of a nodegroup owned by the controller. This annotation can only be set on a nodegroup
+	// custom resource.
+	//
+	// When set, the labels whose key is not listed are left to other operators: they are not
+	// compared, and the controller never adds, updates or removes them, even if they are declared
+	// in `spec.labels`. When not set, the controller owns all the labels of the nodegroup.
+	OwnedLabelKeysAnnotation = fmt.Sprintf("%s/owned-label-keys", GroupVersion.Group)
+	// OwnedTaintKeysAnnotation is the annotation key used to list, comma separated, the taint keys
+	// of a nodegroup owned by the controller. This annotation can only be set on a nodegroup
+	// custom resource.
+	//
+	// When set, the taints whose key is not listed are left to other operators, like the labels
+	// with the OwnedLabelKeysAnnotation annotation. When not set, the controller owns all the
+	// taints of the nodegroup.
+	OwnedTaintKeysAnnotation = fmt.Sprintf("%s/owned-taint-keys", GroupVersion.Group)
+	// OwnedScalingFieldsAnnotation is the annotation key used to list, comma separated, the
+	// fields of the `spec.scalingConfig` of a nodegroup owned by the controller, among
+	// 'minSize', 'maxSize' and 'desiredSize'. This annotation can only be set on a nodegroup
+	// custom resource.
+	//
+	// When set, the fields that are not listed are left to other operators: they are not
+	// compared, and the controller sends their observed value in its scaling updates. When not
+	// set, the controller owns all of them. In both cases, the desired size is left to the
+	// external autoscaler when the DesiredSizeManagedByAnnotation annotation says so.
+	OwnedScalingFieldsAnnotation = fmt.Sprintf("%s/owned-scaling-fields", GroupVersion.Group)
 	// ForceNodeGroupUpdateVersionAnnotation is the annotation key used to force an update of the
 	// nodegroup version. This annotation can only be set on a nodegroup custom resource.
 	// The value of this annotation must be a boolean value. If the value is "true", the controller
@@ -41,10 +67,81 @@ var (
 	// of the `spec` object. If the value is "false", the controller will not force an update of the
 	// nodegroup version.
 	ForceNodeGroupUpdateVersionAnnotation = fmt.Sprintf("%s/force-update-version", GroupVersion.Group)
+	// ResolveLaunchTemplateVersionAnnotation is the annotation key used to turn off the resolution
+	// of the launch template version of a nodegroup. This annotation can only be set on a
+	// nodegroup custom resource. The value of this annotation must be a boolean value.
+	//
+	// When the `spec.launchTemplate.version` field is omitted, '$Latest' or '$Default', the
+	// controller resolves it to a version number with the EC2 DescribeLaunchTemplateVersions API,
+	// reports it in `status.resolvedLaunchTemplateVersion`, and rolls the nodegroup out when it
+	// differs from the version the nodegroup runs. If the value is "false", the controller does
+	// not resolve the version, and publishing a new launch template version does not roll the
+	// nodegroup out.
+	ResolveLaunchTemplateVersionAnnotation = fmt.Sprintf("%s/resolve-launch-template-version", GroupVersion.Group)
 	// ForceClusterUpgradeAnnotation is an annotation whose value indicates whether
 	// the cluster version upgrade should be forced even if there are cluster insight findings.
 	// The value of this annotation must be a boolean value.
 	ForceClusterUpgradeAnnotation = fmt.Sprintf("%s/force-upgrade", GroupVersion.Group)
+	// ClusterDeletionPolicyAnnotation is the annotation key used to set what happens to the
+	// node groups, Fargate profiles, add-ons, pod identity associations and access entries of a
+	// cluster when the cluster custom resource is deleted. This annotation can only be set on a
+	// cluster custom resource, the `spec.deletionPolicy` field takes precedence over it.
+	//
+	// The value of this annotation must be one of the following:
+	//
+	// - 'Block':   The cluster is not deleted until all of its node groups are gone.
+	//
+	// - 'Orphan':  The cluster is deleted right away, the custom resources of its children are
+	//              left in place.
+	//
+	// - 'Cascade': The children of the cluster, and the custom resources managing them, are
+	//              deleted in dependency order before the cluster is deleted.
+	//
+	// If neither the annotation nor `spec.deletionPolicy` is set, the controller will default to
+	// 'Block'. Any other value is rejected with a terminal condition. Not to be confused with the
+	// `services.k8s.aws/deletion-policy` annotation, which decides whether the cluster itself is
+	// deleted.
+	ClusterDeletionPolicyAnnotation = fmt.Sprintf("%s/deletion-policy", GroupVersion.Group)
+	// MaintenanceWindowAnnotation is the annotation key used to restrict the disruptive
+	// operations on a cluster or nodegroup custom resource to a maintenance window. The value of
+	// this annotation is a JSON encoded MaintenanceWindowSpec, for instance:
+	//
+	//     {"schedule": "0 2 * * SAT", "duration": "4h", "timeZone": "Europe/Paris"}
+	//
+	// Version upgrades, launch template rollouts and scaling changes are held outside the window,
+	// other changes such as tags are applied right away.
+	MaintenanceWindowAnnotation = fmt.Sprintf("%s/maintenance-window", GroupVersion.Group)
+	// MaintenanceWindowNameAnnotation is the annotation key used to reference, by name, a
+	// MaintenanceWindow custom resource in the namespace of a cluster or nodegroup custom
+	// resource. It takes precedence over the MaintenanceWindowAnnotation annotation.
+	MaintenanceWindowNameAnnotation = fmt.Sprintf("%s/maintenance-window-name", GroupVersion.Group)
+	// DryRunAnnotation is the annotation key used to run the updates of a custom resource in
+	// dry-run mode. It can be set on cluster, nodegroup, addon, access entry and capability
+	// custom resources.
+	//
+	// When set to "true", the controller computes the EKS API calls that would bring the
+	// resource to its desired state and publishes them in `status.dryRunPlan`, without making
+	// any of them. Read-only calls, such as describes, are still made.
+	DryRunAnnotation = fmt.Sprintf("%s/dry-run", GroupVersion.Group)
+	// SelectorOverlapPolicyAnnotation is the annotation key used to configure how the overlap
+	// of the selectors of a Fargate profile with the ones of the other Fargate profiles of its
+	// cluster, either custom resources or live profiles, is handled. This annotation can only be
+	// set on a fargate profile custom resource. The live profiles are scanned before a profile is
+	// created or replaced, and at most hourly otherwise.
+	//
+	// The value of this annotation must be one of the following:
+	//
+	// - 'Ignore': The controller does not look for overlapping selectors.
+	//
+	// - 'Warn': The controller sets the SelectorOverlap condition on the custom resource when
+	//   its selectors overlap with the ones of another profile.
+	//
+	// - 'Block': Same as 'Warn', and the controller does not create the profile, or replace it,
+	//   while its selectors overlap with the ones of another profile.
+	//
+	// If the annotation is not set, or the value is not one of the above, the controller will
+	// default to 'Warn'.
+	SelectorOverlapPolicyAnnotation = fmt.Sprintf("%s/selector-overlap-policy", GroupVersion.Group)
 )
 
 const (
@@ -59,4 +156,67 @@ const (
 	// DefaultForceClusterUpgrade is the default value for ForceClusterUpgradeAnnotation if the annotation
 	// is not set or has an invalid value.
 	DefaultForceClusterUpgrade = false
+	// DefaultResolveLaunchTemplateVersion is the default value for
+	// ResolveLaunchTemplateVersionAnnotation if the annotation is not set or has an invalid value.
+	DefaultResolveLaunchTemplateVersion = true
+	// ClusterDeletionPolicyBlock is the value of the ClusterDeletionPolicyAnnotation annotation
+	// that keeps the cluster until all of its node groups are deleted.
+	ClusterDeletionPolicyBlock = "Block"
+	// ClusterDeletionPolicyOrphan is the value of the ClusterDeletionPolicyAnnotation annotation
+	// that deletes the cluster without waiting for, or deleting, its children.
+	ClusterDeletionPolicyOrphan = "Orphan"
+	// ClusterDeletionPolicyCascade is the value of the ClusterDeletionPolicyAnnotation annotation
+	// that deletes the children of the cluster before the cluster.
+	ClusterDeletionPolicyCascade = "Cascade"
+	// DefaultClusterDeletionPolicy is the default value for ClusterDeletionPolicyAnnotation if
+	// neither the annotation nor `spec.deletionPolicy` are set.
+	DefaultClusterDeletionPolicy = ClusterDeletionPolicyBlock
+	// NodegroupReplacementStrategyNone is the value of the `spec.replacementStrategy` field of a
+	// nodegroup that rejects changes to the fields EKS cannot update in place.
+	NodegroupReplacementStrategyNone = "None"
+	// NodegroupReplacementStrategyBlueGreen is the value of the `spec.replacementStrategy` field
+	// of a nodegroup that replaces the node group when a field EKS cannot update in place
+	// changes: a new node group is created, and the previous one is scaled down and deleted once
+	// the new one is active and healthy.
+	NodegroupReplacementStrategyBlueGreen = "BlueGreen"
+	// DefaultNodegroupReplacementStrategy is the default value for the `spec.replacementStrategy`
+	// field of a nodegroup if it is not set, or has an invalid value.
+	DefaultNodegroupReplacementStrategy = NodegroupReplacementStrategyNone
+	// FargateProfileReplacementStrategyNone is the value of the `spec.replacementStrategy` field
+	// of a Fargate profile that rejects changes to the fields EKS cannot update in place.
+	FargateProfileReplacementStrategyNone = "None"
+	// FargateProfileReplacementStrategyCreateBeforeDestroy is the value of the
+	// `spec.replacementStrategy` field of a Fargate profile that replaces the profile when its
+	// selectors, subnets or pod execution role change: a new profile is created, and the
+	// previous one is deleted once the new one is active.
+	FargateProfileReplacementStrategyCreateBeforeDestroy = "CreateBeforeDestroy"
+	// DefaultFargateProfileReplacementStrategy is the default value for the
+	// `spec.replacementStrategy` field of a Fargate profile if it is not set, or has an invalid
+	// value.
+	DefaultFargateProfileReplacementStrategy = FargateProfileReplacementStrategyNone
+	// IdentityProviderConfigReplacementStrategyNone is the value of the `spec.replacementStrategy`
+	// field of an identity provider configuration that rejects changes to its OIDC configuration.
+	IdentityProviderConfigReplacementStrategyNone = "None"
+	// IdentityProviderConfigReplacementStrategyRecreate is the value of the
+	// `spec.replacementStrategy` field of an identity provider configuration that applies changes
+	// to its OIDC configuration by disassociating it from the cluster, and associating it again
+	// once it is gone.
+	IdentityProviderConfigReplacementStrategyRecreate = "Recreate"
+	// DefaultIdentityProviderConfigReplacementStrategy is the default value for the
+	// `spec.replacementStrategy` field of an identity provider configuration if it is not set, or
+	// has an invalid value.
+	DefaultIdentityProviderConfigReplacementStrategy = IdentityProviderConfigReplacementStrategyNone
+	// SelectorOverlapPolicyIgnore is the value of the SelectorOverlapPolicyAnnotation
+	// annotation that turns off the detection of overlapping selectors.
+	SelectorOverlapPolicyIgnore = "Ignore"
+	// SelectorOverlapPolicyWarn is the value of the SelectorOverlapPolicyAnnotation annotation
+	// that reports overlapping selectors with the SelectorOverlap condition.
+	SelectorOverlapPolicyWarn = "Warn"
+	// SelectorOverlapPolicyBlock is the value of the SelectorOverlapPolicyAnnotation annotation
+	// that reports overlapping selectors, and holds back the creation or replacement of the
+	// profile while they overlap.
+	SelectorOverlapPolicyBlock = "Block"
+	// DefaultSelectorOverlapPolicy is the default value for SelectorOverlapPolicyAnnotation if
+	// the annotation is not set or has an invalid value.
+	DefaultSelectorOverlapPolicy = SelectorOverlapPolicyWarn
 )
@@ -95,6 +95,8 @@ type CapabilityStatus struct {
 	// The Unix epoch timestamp in seconds for when the capability was created.
 	// +kubebuilder:validation:Optional
 	CreatedAt *metav1.Time `json:"createdAt,omitempty"`
+	// +kubebuilder:validation:Optional
+	DryRunPlan []*string `json:"dryRunPlan,omitempty"`
 	// Health information for the capability, including any issues that may be affecting
 	// its operation.
 	// +kubebuilder:validation:Optional
@@ -46,6 +46,7 @@ type ClusterSpec struct {
 	// The control plane scaling tier configuration. For more information, see EKS
 	// Provisioned Control Plane in the Amazon EKS User Guide.
 	ControlPlaneScalingConfig *ControlPlaneScalingConfig `json:"controlPlaneScalingConfig,omitempty"`
+	DeletionPolicy            *string                    `json:"deletionPolicy,omitempty"`
 	// Indicates whether to enable deletion protection for the cluster. When enabled,
 	// the cluster cannot be deleted unless deletion protection is first disabled.
 	// This helps prevent accidental cluster deletion. Default value is false.
@@ -58,6 +59,7 @@ type ClusterSpec struct {
 	KubeControllerManagerConfig *KubeControllerManagerConfigRequest `json:"kubeControllerManagerConfig,omitempty"`
 	// The Kubernetes scheduler configuration for the new cluster.
 	KubeSchedulerConfig *KubeSchedulerConfigRequest `json:"kubeSchedulerConfig,omitempty"`
+	KubeconfigSecret    *KubeconfigSecret           `json:"kubeconfigSecret,omitempty"`
 	// The Kubernetes network configuration for the cluster.
 	KubernetesNetworkConfig *KubernetesNetworkConfigRequest `json:"kubernetesNetworkConfig,omitempty"`
 	// Enable or disable exporting the Kubernetes control plane logs for your cluster
@@ -114,7 +116,8 @@ type ClusterSpec struct {
 	// Metadata that assists with categorization and organization. Each tag consists
 	// of a key and an optional value. You define both. Tags don't propagate to
 	// any other cluster or Amazon Web Services resources.
-	Tags map[string]*string `json:"tags,omitempty"`
+	Tags           map[string]*string `json:"tags,omitempty"`
+	UpgradeCascade *UpgradeCascade    `json:"upgradeCascade,omitempty"`
 	// New clusters, by default, have extended support enabled. You can disable
 	// extended support when creating a cluster by setting this value to STANDARD.
 	UpgradePolicy *UpgradePolicyRequest `json:"upgradePolicy,omitempty"`
@@ -170,6 +173,10 @@ type ClusterStatus struct {
 	// The Unix epoch timestamp at object creation.
 	// +kubebuilder:validation:Optional
 	CreatedAt *metav1.Time `json:"createdAt,omitempty"`
+	// +kubebuilder:validation:Optional
+	DeletionCascadeProgress []*DeletionCascadeChild `json:"deletionCascadeProgress,omitempty"`
+	// +kubebuilder:validation:Optional
+	DryRunPlan []*string `json:"dryRunPlan,omitempty"`
 	// The endpoint for your Kubernetes API server.
 	// +kubebuilder:validation:Optional
 	Endpoint *string `json:"endpoint,omitempty"`
@@ -184,6 +191,8 @@ type ClusterStatus struct {
 	// The identity provider information for the cluster.
 	// +kubebuilder:validation:Optional
 	Identity *Identity `json:"identity,omitempty"`
+	// +kubebuilder:validation:Optional
+	KubeconfigSecretName *string `json:"kubeconfigSecretName,omitempty"`
 	// The platform version of your Amazon EKS cluster. For more information about
 	// clusters deployed on the Amazon Web Services Cloud, see Platform versions
 	// (https://docs.aws.amazon.com/eks/latest/userguide/platform-versions.html)
@@ -195,6 +204,16 @@ type ClusterStatus struct {
 	// The current status of the cluster.
 	// +kubebuilder:validation:Optional
 	Status *string `json:"status,omitempty"`
+	// +kubebuilder:validation:Optional
+	UpdatePlan []*string `json:"updatePlan,omitempty"`
+	// +kubebuilder:validation:Optional
+	Updates []*Update `json:"updates,omitempty"`
+	// +kubebuilder:validation:Optional
+	UpgradeCascadeProgress []*UpgradeCascadeChild `json:"upgradeCascadeProgress,omitempty"`
+	// +kubebuilder:validation:Optional
+	UpgradeInsights []*UpgradeInsight `json:"upgradeInsights,omitempty"`
+	// +kubebuilder:validation:Optional
+	VersionSupport *VersionSupport `json:"versionSupport,omitempty"`
 }
 
 // Cluster is the Schema for the Clusters API
@@ -0,0 +1,67 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package v1alpha1
+
+import ackv1alpha1 "github.com/aws-controllers-k8s/runtime/apis/core/v1alpha1"
+
+const (
+	// ConditionTypeUpdateFailed is set on a resource when the most recent
+	// asynchronous EKS update of a given update type ended in the 'Failed'
+	// status. The condition reason is the update type and the message carries
+	// the update ID along with the error details returned by DescribeUpdate.
+	ConditionTypeUpdateFailed ackv1alpha1.ConditionType = "UpdateFailed"
+	// ConditionTypeUpgradeBlocked is set on a Cluster when a version upgrade
+	// step is held back by failing UPGRADE_READINESS insights, or by node
+	// groups lagging more than the maximum skew behind. The condition
+	// is set to False, instead of True, when the upgrade is forced with the
+	// ForceClusterUpgradeAnnotation.
+	ConditionTypeUpgradeBlocked ackv1alpha1.ConditionType = "UpgradeBlocked"
+	// ConditionTypeWaitingForMaintenanceWindow is set on a Cluster or
+	// Nodegroup when a disruptive operation is held until its maintenance
+	// window opens. The condition message carries the time of the next
+	// opening.
+	ConditionTypeWaitingForMaintenanceWindow ackv1alpha1.ConditionType = "WaitingForMaintenanceWindow"
+	// ConditionTypeDryRun is set on a resource annotated with the
+	// DryRunAnnotation when its update was planned instead of applied. The
+	// planned calls are listed in the resource status.
+	ConditionTypeDryRun ackv1alpha1.ConditionType = "DryRun"
+	// ConditionTypeExtendedSupport is set to True on a Cluster whose
+	// Kubernetes version has left standard support. The condition reason
+	// tells whether the cluster stays in extended support or is going to be
+	// upgraded automatically, as per its upgrade policy support type.
+	ConditionTypeExtendedSupport ackv1alpha1.ConditionType = "ExtendedSupport"
+	// ConditionTypeDegraded is set to True on a Cluster, Nodegroup, Addon,
+	// FargateProfile or Capability while EKS reports health issues for it.
+	// The condition reason is the issue code, or MultipleIssues, and every
+	// issue code also gets a condition of its own. See pkg/health.
+	ConditionTypeDegraded ackv1alpha1.ConditionType = "Degraded"
+	// ConditionTypeSelectorOverlap is set to True on a FargateProfile whose
+	// selectors overlap with the ones of another Fargate profile of its
+	// cluster, making the profile a pod is scheduled on ambiguous. The
+	// condition reason is the policy of the SelectorOverlapPolicyAnnotation
+	// and the message lists the overlapping profiles.
+	ConditionTypeSelectorOverlap ackv1alpha1.ConditionType = "SelectorOverlap"
+	// ConditionTypeReplacementUnhealthy is set to True on a Nodegroup whose
+	// blue/green replacement node group is active but reports health issues,
+	// holding the replacement back. The condition reason is the issue code, or
+	// MultipleIssues, and the message lists the issues.
+	ConditionTypeReplacementUnhealthy ackv1alpha1.ConditionType = "ReplacementUnhealthy"
+	// ConditionTypeSecretSyncFailed is set to True on a Cluster whose
+	// kubeconfig Secret, or a ClusterRegistration whose activation Secret,
+	// could not be written. The condition reason is
+	// SecretNotControlled when a Secret of the same name belongs to another
+	// owner, WriteFailed otherwise, and the message carries the error. The
+	// Secret is written again on the next reconcile.
+	ConditionTypeSecretSyncFailed ackv1alpha1.ConditionType = "SecretSyncFailed"
+)
@@ -0,0 +1,218 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package v1alpha1
+
+import metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+// This file contains the types of the custom fields that have no
+// corresponding shape in the EKS API model. They are referenced from
+// generator.yaml.
+
+// AddonConfigurationValuesSource selects the ConfigMap or Secret key holding
+// the configuration values of an add-on, as a JSON or YAML document. Exactly
+// one of ConfigMapKeyRef and SecretKeyRef must be set. Changes to the selected
+// key are applied when the Addon is next reconciled, see the Addon entry of
+// the reconcile.resourceResyncPeriods Helm value.
+type AddonConfigurationValuesSource struct {
+	// ConfigMapKeyRef selects a key of a ConfigMap in the namespace of the
+	// Addon resource.
+	ConfigMapKeyRef *KeyReference `json:"configMapKeyRef,omitempty"`
+	// SecretKeyRef selects a key of a Secret in the namespace of the Addon
+	// resource.
+	SecretKeyRef *KeyReference `json:"secretKeyRef,omitempty"`
+}
+
+// DeletionCascadeChild reports the progress of the deletion of a child of the
+// cluster under the Cascade deletion policy.
+type DeletionCascadeChild struct {
+	// Kind is one of Nodegroup, FargateProfile, Addon, PodIdentityAssociation
+	// or AccessEntry.
+	Kind *string `json:"kind,omitempty"`
+	// Name is the name of the child. Pod identity associations are identified
+	// by their association ID and access entries by their principal ARN.
+	Name *string `json:"name,omitempty"`
+	// State is either Pending or Deleting.
+	State *string `json:"state,omitempty"`
+}
+
+// FargateProfileReplacement reports the progress of the create-before-destroy
+// replacement of a Fargate profile.
+type FargateProfileReplacement struct {
+	// FargateProfileName is the name of the Fargate profile replacing the
+	// previous one.
+	FargateProfileName *string `json:"fargateProfileName,omitempty"`
+	// Phase is one of Creating or DeletingPrevious.
+	Phase *string `json:"phase,omitempty"`
+	// PreviousFargateProfileName is the name of the Fargate profile being
+	// replaced.
+	PreviousFargateProfileName *string `json:"previousFargateProfileName,omitempty"`
+	// StartedAt is the time the replacement started.
+	StartedAt *metav1.Time `json:"startedAt,omitempty"`
+}
+
+// IdentityProviderConfigReplacement reports the progress of the replacement
+// of an OIDC identity provider configuration.
+type IdentityProviderConfigReplacement struct {
+	// Phase is one of Disassociating or Associating.
+	Phase *string `json:"phase,omitempty"`
+	// StartedAt is the time the replacement started.
+	StartedAt *metav1.Time `json:"startedAt,omitempty"`
+	// UpdateID is the ID of the disassociation or association update of the
+	// current phase.
+	UpdateID *string `json:"updateID,omitempty"`
+	// UpdateStatus is the last observed status of that update.
+	UpdateStatus *string `json:"updateStatus,omitempty"`
+}
+
+// KubeconfigSecret configures the Secret the controller writes, and keeps up to
+// date, with a kubeconfig for the cluster.
+type KubeconfigSecret struct {
+	// Key is the key of the kubeconfig in the Secret data. Defaults to
+	// "kubeconfig".
+	Key *string `json:"key,omitempty"`
+	// Name is the name of the Secret, in the namespace of the Cluster resource.
+	// Defaults to the name of the Cluster resource suffixed with "-kubeconfig".
+	Name *string `json:"name,omitempty"`
+	// RoleARN is the ARN of an IAM role assumed by `aws eks get-token` to
+	// authenticate against the cluster. When unset, the credentials of the
+	// kubeconfig user are used as is.
+	RoleARN *string `json:"roleARN,omitempty"`
+}
+
+// KeyReference selects a key of a ConfigMap or Secret.
+type KeyReference struct {
+	// Key is the key of the value in the ConfigMap or Secret data.
+	// +kubebuilder:validation:Required
+	Key *string `json:"key"`
+	// Name is the name of the ConfigMap or Secret.
+	// +kubebuilder:validation:Required
+	Name *string `json:"name"`
+}
+
+// NodegroupReplacement reports the progress of the blue/green replacement of
+// a managed node group.
+type NodegroupReplacement struct {
+	// NodegroupName is the name of the node group replacing the previous one.
+	NodegroupName *string `json:"nodegroupName,omitempty"`
+	// Phase is one of Creating, ScalingDown or DeletingPrevious.
+	Phase *string `json:"phase,omitempty"`
+	// PreviousNodegroupName is the name of the node group being replaced.
+	PreviousNodegroupName *string `json:"previousNodegroupName,omitempty"`
+	// StartedAt is the time the replacement started.
+	StartedAt *metav1.Time `json:"startedAt,omitempty"`
+}
+
+// NodegroupScalingSchedule sets the scaling configuration of a managed node
+// group during a recurring window of time.
+type NodegroupScalingSchedule struct {
+	// DesiredSize is the desired number of nodes while the schedule is active.
+	// Defaults to the desired size of the scaling configuration, bounded by the
+	// minimum and maximum sizes of the schedule. It is ignored when the desired
+	// size is managed by an external autoscaler.
+	// +kubebuilder:validation:Minimum=0
+	DesiredSize *int64 `json:"desiredSize,omitempty"`
+	// Duration is how long the schedule stays active after each activation, as
+	// a Go duration string such as "10h".
+	// +kubebuilder:validation:Required
+	Duration *string `json:"duration"`
+	// MaxSize is the maximum number of nodes while the schedule is active.
+	// Defaults to the maximum size of the scaling configuration.
+	// +kubebuilder:validation:Minimum=0
+	MaxSize *int64 `json:"maxSize,omitempty"`
+	// MinSize is the minimum number of nodes while the schedule is active.
+	// Defaults to the minimum size of the scaling configuration.
+	// +kubebuilder:validation:Minimum=0
+	MinSize *int64 `json:"minSize,omitempty"`
+	// Name identifies the schedule in the status of the node group.
+	// +kubebuilder:validation:Required
+	Name *string `json:"name"`
+	// Schedule is a cron expression, in the standard five field format, for
+	// the activations of the schedule. For instance "0 20 * * MON-FRI"
+	// activates it every weekday at 8 PM.
+	// +kubebuilder:validation:Required
+	Schedule *string `json:"schedule"`
+	// TimeZone is the IANA time zone the schedule is evaluated in, for
+	// instance "Europe/Paris". Defaults to UTC.
+	TimeZone *string `json:"timeZone,omitempty"`
+}
+
+// UpgradeCascade configures how a Cluster version upgrade is propagated to the
+// managed node groups and add-ons of the cluster.
+type UpgradeCascade struct {
+	// Enabled turns on the cascading upgrade. After each control plane version
+	// step, the managed node groups and then the add-ons of the cluster are
+	// moved, one at a time, to the new control plane version.
+	Enabled *bool `json:"enabled,omitempty"`
+	// MaxSkew is the maximum number of Kubernetes minor versions the managed
+	// node groups are allowed to lag behind the control plane. A control plane
+	// version step that would exceed it is held until the node groups catch
+	// up. Defaults to 1.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=3
+	MaxSkew *int64 `json:"maxSkew,omitempty"`
+}
+
+// UpgradeCascadeChild reports the cascading upgrade progress of a managed
+// node group or add-on of the cluster.
+type UpgradeCascadeChild struct {
+	// Kind is either Nodegroup or Addon.
+	Kind *string `json:"kind,omitempty"`
+	// Message gives details about the state, for instance why the upgrade of
+	// the child failed.
+	Message *string `json:"message,omitempty"`
+	// Name is the node group or add-on name.
+	Name *string `json:"name,omitempty"`
+	// State is one of Pending, Updating, Upgraded, Pinned, Skipped or Failed.
+	State *string `json:"state,omitempty"`
+	// TargetVersion is the version the child is moved to.
+	TargetVersion *string `json:"targetVersion,omitempty"`
+	// UpdateID is the ID of the last update started for the child.
+	UpdateID *string `json:"updateID,omitempty"`
+	// Version is the current version of the child.
+	Version *string `json:"version,omitempty"`
+}
+
+// UpgradeInsight summarizes a failing or warning UPGRADE_READINESS insight of
+// the cluster. The details of the insight, such as the affected resources,
+// are returned by the DescribeInsight API.
+type UpgradeInsight struct {
+	// ID is the ID of the insight.
+	ID *string `json:"id,omitempty"`
+	// Name is the name of the insight.
+	Name *string `json:"name,omitempty"`
+	// Reason explains the status of the insight.
+	Reason *string `json:"reason,omitempty"`
+	// Recommendation is how to resolve the findings of the insight.
+	Recommendation *string `json:"recommendation,omitempty"`
+	// ResourceCount is the number of resources the insight found.
+	ResourceCount *int64 `json:"resourceCount,omitempty"`
+	// Status is either ERROR or WARNING.
+	Status *string `json:"status,omitempty"`
+}
+
+// VersionSupport reports the support status of the Kubernetes version of the
+// cluster, as returned by DescribeClusterVersions.
+type VersionSupport struct {
+	// EndOfExtendedSupportDate is the date the version leaves extended
+	// support.
+	EndOfExtendedSupportDate *metav1.Time `json:"endOfExtendedSupportDate,omitempty"`
+	// EndOfStandardSupportDate is the date the version leaves standard
+	// support.
+	EndOfStandardSupportDate *metav1.Time `json:"endOfStandardSupportDate,omitempty"`
+	// Version is the Kubernetes version the support status is reported for.
+	Version *string `json:"version,omitempty"`
+	// VersionStatus is one of STANDARD_SUPPORT, EXTENDED_SUPPORT or
+	// UNSUPPORTED.
+	VersionStatus *string `json:"versionStatus,omitempty"`
+}
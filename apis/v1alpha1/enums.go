@@ -230,15 +230,15 @@ const (
 	EKSAnywhereSubscriptionLicenseType_Cluster EKSAnywhereSubscriptionLicenseType = "Cluster"
 )
 
-type EKSAnywhereSubscriptionStatus string
+type EKSAnywhereSubscriptionStatus_SDK string
 
 const (
-	EKSAnywhereSubscriptionStatus_ACTIVE   EKSAnywhereSubscriptionStatus = "ACTIVE"
-	EKSAnywhereSubscriptionStatus_CREATING EKSAnywhereSubscriptionStatus = "CREATING"
-	EKSAnywhereSubscriptionStatus_DELETING EKSAnywhereSubscriptionStatus = "DELETING"
-	EKSAnywhereSubscriptionStatus_EXPIRED  EKSAnywhereSubscriptionStatus = "EXPIRED"
-	EKSAnywhereSubscriptionStatus_EXPIRING EKSAnywhereSubscriptionStatus = "EXPIRING"
-	EKSAnywhereSubscriptionStatus_UPDATING EKSAnywhereSubscriptionStatus = "UPDATING"
+	EKSAnywhereSubscriptionStatus_SDK_ACTIVE   EKSAnywhereSubscriptionStatus_SDK = "ACTIVE"
+	EKSAnywhereSubscriptionStatus_SDK_CREATING EKSAnywhereSubscriptionStatus_SDK = "CREATING"
+	EKSAnywhereSubscriptionStatus_SDK_DELETING EKSAnywhereSubscriptionStatus_SDK = "DELETING"
+	EKSAnywhereSubscriptionStatus_SDK_EXPIRED  EKSAnywhereSubscriptionStatus_SDK = "EXPIRED"
+	EKSAnywhereSubscriptionStatus_SDK_EXPIRING EKSAnywhereSubscriptionStatus_SDK = "EXPIRING"
+	EKSAnywhereSubscriptionStatus_SDK_UPDATING EKSAnywhereSubscriptionStatus_SDK = "UPDATING"
 )
 
 type EKSAnywhereSubscriptionTermUnit string
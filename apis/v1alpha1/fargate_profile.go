@@ -42,6 +42,7 @@ type FargateProfileSpec struct {
 	// in the Amazon EKS User Guide.
 	PodExecutionRoleARN *string                                  `json:"podExecutionRoleARN,omitempty"`
 	PodExecutionRoleRef *ackv1alpha1.AWSResourceReferenceWrapper `json:"podExecutionRoleRef,omitempty"`
+	ReplacementStrategy *string                                  `json:"replacementStrategy,omitempty"`
 	// The selectors to match for a Pod to use this Fargate profile. Each selector
 	// must have an associated Kubernetes namespace. Optionally, you can also specify
 	// labels for a namespace. You may specify up to five selectors in a Fargate
@@ -74,10 +75,14 @@ type FargateProfileStatus struct {
 	// The Unix epoch timestamp at object creation.
 	// +kubebuilder:validation:Optional
 	CreatedAt *metav1.Time `json:"createdAt,omitempty"`
+	// +kubebuilder:validation:Optional
+	CurrentFargateProfileName *string `json:"currentFargateProfileName,omitempty"`
 	// The health status of the Fargate profile. If there are issues with your Fargate
 	// profile's health, they are listed here.
 	// +kubebuilder:validation:Optional
 	Health *FargateProfileHealth `json:"health,omitempty"`
+	// +kubebuilder:validation:Optional
+	Replacement *FargateProfileReplacement `json:"replacement,omitempty"`
 	// The current status of the Fargate profile.
 	// +kubebuilder:validation:Optional
 	Status *string `json:"status,omitempty"`
@@ -30,13 +30,12 @@ type IdentityProviderConfigSpec struct {
 	ClusterName *string                                  `json:"clusterName,omitempty"`
 	ClusterRef  *ackv1alpha1.AWSResourceReferenceWrapper `json:"clusterRef,omitempty"`
 	// An object representing an OpenID Connect (OIDC) identity provider configuration.
-	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Value is immutable once set"
 	// +kubebuilder:validation:Required
-	OIDC *OIDCIdentityProviderConfigRequest `json:"oidc"`
+	OIDC                *OIDCIdentityProviderConfigRequest `json:"oidc"`
+	ReplacementStrategy *string                            `json:"replacementStrategy,omitempty"`
 	// Metadata that assists with categorization and organization. Each tag consists
 	// of a key and an optional value. You define both. Tags don't propagate to
 	// any other cluster or Amazon Web Services resources.
-	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Value is immutable once set"
 	Tags map[string]*string `json:"tags,omitempty"`
 }
 
@@ -53,6 +52,8 @@ type IdentityProviderConfigStatus struct {
 	// resource
 	// +kubebuilder:validation:Optional
 	Conditions []*ackv1alpha1.Condition `json:"conditions"`
+	// +kubebuilder:validation:Optional
+	Replacement *IdentityProviderConfigReplacement `json:"replacement,omitempty"`
 	// The status of the OIDC identity provider.
 	// +kubebuilder:validation:Optional
 	Status *string `json:"status,omitempty"`
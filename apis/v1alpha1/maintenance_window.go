@@ -0,0 +1,62 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// MaintenanceWindowSpec defines a recurring window of time during which the
+// controller is allowed to run disruptive operations, such as version
+// upgrades, on the resources referencing it.
+type MaintenanceWindowSpec struct {
+	// Duration is how long the window stays open after each opening, as a Go
+	// duration string such as "4h" or "90m".
+	// +kubebuilder:validation:Required
+	Duration *string `json:"duration"`
+	// Schedule is a cron expression, in the standard five field format, for
+	// the openings of the window. For instance "0 2 * * SAT" opens the window
+	// every Saturday at 2 AM.
+	// +kubebuilder:validation:Required
+	Schedule *string `json:"schedule"`
+	// TimeZone is the IANA time zone the schedule is evaluated in, for
+	// instance "Europe/Paris". Defaults to UTC.
+	TimeZone *string `json:"timeZone,omitempty"`
+}
+
+// MaintenanceWindow is the Schema for the MaintenanceWindows API. It has no
+// counterpart in EKS, Cluster and Nodegroup resources reference it by name
+// with the MaintenanceWindowNameAnnotation annotation.
+// +kubebuilder:object:root=true
+// +kubebuilder:printcolumn:name="SCHEDULE",type=string,priority=0,JSONPath=`.spec.schedule`
+// +kubebuilder:printcolumn:name="DURATION",type=string,priority=0,JSONPath=`.spec.duration`
+// +kubebuilder:printcolumn:name="TIMEZONE",type=string,priority=0,JSONPath=`.spec.timeZone`
+// +kubebuilder:printcolumn:name="Age",type="date",priority=0,JSONPath=".metadata.creationTimestamp"
+type MaintenanceWindow struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Spec              MaintenanceWindowSpec `json:"spec,omitempty"`
+}
+
+// MaintenanceWindowList contains a list of MaintenanceWindow
+// +kubebuilder:object:root=true
+type MaintenanceWindowList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []MaintenanceWindow `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&MaintenanceWindow{}, &MaintenanceWindowList{})
+}
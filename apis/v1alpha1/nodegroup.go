@@ -32,7 +32,6 @@ type NodegroupSpec struct {
 	// aws-auth ConfigMap. For more information about using launch templates with
 	// Amazon EKS, see Customizing managed nodes with launch templates (https://docs.aws.amazon.com/eks/latest/userguide/launch-templates.html)
 	// in the Amazon EKS User Guide.
-	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Value is immutable once set"
 	AMIType *string `json:"amiType,omitempty"`
 	// The capacity type for your node group.
 	CapacityType *string `json:"capacityType,omitempty"`
@@ -50,7 +49,6 @@ type NodegroupSpec struct {
 	// launch templates with Amazon EKS, see Customizing managed nodes with launch
 	// templates (https://docs.aws.amazon.com/eks/latest/userguide/launch-templates.html)
 	// in the Amazon EKS User Guide.
-	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Value is immutable once set"
 	DiskSize *int64 `json:"diskSize,omitempty"`
 	// Specify the instance types for a node group. If you specify a GPU instance
 	// type, make sure to also specify an applicable GPU AMI type with the amiType
@@ -64,7 +62,6 @@ type NodegroupSpec struct {
 	// information, see Managed node group capacity types (https://docs.aws.amazon.com/eks/latest/userguide/managed-node-groups.html#managed-node-group-capacity-types)
 	// and Customizing managed nodes with launch templates (https://docs.aws.amazon.com/eks/latest/userguide/launch-templates.html)
 	// in the Amazon EKS User Guide.
-	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Value is immutable once set"
 	InstanceTypes []*string `json:"instanceTypes,omitempty"`
 	// The Kubernetes labels to apply to the nodes in the node group when they are
 	// created.
@@ -82,6 +79,8 @@ type NodegroupSpec struct {
 	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Value is immutable once set"
 	// +kubebuilder:validation:Required
 	Name *string `json:"name"`
+	// The node auto repair configuration for the node group.
+	NodeRepairConfig *NodeRepairConfig `json:"nodeRepairConfig,omitempty"`
 	// The Amazon Resource Name (ARN) of the IAM role to associate with your node
 	// group. The Amazon EKS worker node kubelet daemon makes calls to Amazon Web
 	// Services APIs on your behalf. Nodes receive permissions for these API calls
@@ -95,7 +94,6 @@ type NodegroupSpec struct {
 	// information about using launch templates with Amazon EKS, see Customizing
 	// managed nodes with launch templates (https://docs.aws.amazon.com/eks/latest/userguide/launch-templates.html)
 	// in the Amazon EKS User Guide.
-	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Value is immutable once set"
 	NodeRole    *string                                  `json:"nodeRole,omitempty"`
 	NodeRoleRef *ackv1alpha1.AWSResourceReferenceWrapper `json:"nodeRoleRef,omitempty"`
 	// The AMI version of the Amazon EKS optimized AMI to use with your node group.
@@ -119,19 +117,19 @@ type NodegroupSpec struct {
 	// For more information about using launch templates with Amazon EKS, see Customizing
 	// managed nodes with launch templates (https://docs.aws.amazon.com/eks/latest/userguide/launch-templates.html)
 	// in the Amazon EKS User Guide.
-	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Value is immutable once set"
-	RemoteAccess *RemoteAccessConfig `json:"remoteAccess,omitempty"`
+	RemoteAccess        *RemoteAccessConfig `json:"remoteAccess,omitempty"`
+	ReplacementStrategy *string             `json:"replacementStrategy,omitempty"`
 	// The scaling configuration details for the Auto Scaling group that is created
 	// for your node group.
-	ScalingConfig *NodegroupScalingConfig                    `json:"scalingConfig,omitempty"`
-	SubnetRefs    []*ackv1alpha1.AWSResourceReferenceWrapper `json:"subnetRefs,omitempty"`
+	ScalingConfig    *NodegroupScalingConfig                    `json:"scalingConfig,omitempty"`
+	ScalingSchedules []*NodegroupScalingSchedule                `json:"scalingSchedules,omitempty"`
+	SubnetRefs       []*ackv1alpha1.AWSResourceReferenceWrapper `json:"subnetRefs,omitempty"`
 	// The subnets to use for the Auto Scaling group that is created for your node
 	// group. If you specify launchTemplate, then don't specify SubnetId (https://docs.aws.amazon.com/AWSEC2/latest/APIReference/API_CreateNetworkInterface.html)
 	// in your launch template, or the node group deployment will fail. For more
 	// information about using launch templates with Amazon EKS, see Customizing
 	// managed nodes with launch templates (https://docs.aws.amazon.com/eks/latest/userguide/launch-templates.html)
 	// in the Amazon EKS User Guide.
-	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Value is immutable once set"
 	Subnets []*string `json:"subnets,omitempty"`
 	// Metadata that assists with categorization and organization. Each tag consists
 	// of a key and an optional value. You define both. Tags don't propagate to
@@ -150,6 +148,10 @@ type NodegroupSpec struct {
 	// managed nodes with launch templates (https://docs.aws.amazon.com/eks/latest/userguide/launch-templates.html)
 	// in the Amazon EKS User Guide.
 	Version *string `json:"version,omitempty"`
+	// The warm pool configuration for the node group. Warm pools maintain
+	// pre-initialized EC2 instances alongside the Auto Scaling group of the node
+	// group.
+	WarmPoolConfig *WarmPoolConfig `json:"warmPoolConfig,omitempty"`
 }
 
 // NodegroupStatus defines the observed state of Nodegroup
@@ -165,11 +167,17 @@ type NodegroupStatus struct {
 	// resource
 	// +kubebuilder:validation:Optional
 	Conditions []*ackv1alpha1.Condition `json:"conditions"`
+	// +kubebuilder:validation:Optional
+	ActiveScalingSchedule *string `json:"activeScalingSchedule,omitempty"`
 	// The Unix epoch timestamp at object creation.
 	// +kubebuilder:validation:Optional
 	CreatedAt *metav1.Time `json:"createdAt,omitempty"`
 	// +kubebuilder:validation:Optional
+	CurrentNodegroupName *string `json:"currentNodegroupName,omitempty"`
+	// +kubebuilder:validation:Optional
 	DesiredSize *int64 `json:"desiredSize,omitempty"`
+	// +kubebuilder:validation:Optional
+	DryRunPlan []*string `json:"dryRunPlan,omitempty"`
 	// The health status of the node group. If there are issues with your node group's
 	// health, they are listed here.
 	// +kubebuilder:validation:Optional
@@ -177,6 +185,12 @@ type NodegroupStatus struct {
 	// The Unix epoch timestamp for the last modification to the object.
 	// +kubebuilder:validation:Optional
 	ModifiedAt *metav1.Time `json:"modifiedAt,omitempty"`
+	// +kubebuilder:validation:Optional
+	NextScalingScheduleEvaluation *string `json:"nextScalingScheduleEvaluation,omitempty"`
+	// +kubebuilder:validation:Optional
+	Replacement *NodegroupReplacement `json:"replacement,omitempty"`
+	// +kubebuilder:validation:Optional
+	ResolvedLaunchTemplateVersion *string `json:"resolvedLaunchTemplateVersion,omitempty"`
 	// The resources associated with the node group, such as Auto Scaling groups
 	// and security groups for remote access.
 	// +kubebuilder:validation:Optional
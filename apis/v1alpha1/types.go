@@ -544,7 +544,10 @@ type ComputeConfigResponse struct {
 
 // The configuration sent to a cluster for configuration.
 type ConnectorConfigRequest struct {
-	RoleARN *string `json:"roleARN,omitempty"`
+	Provider *string `json:"provider,omitempty"`
+	RoleARN  *string `json:"roleARN,omitempty"`
+	// Reference field for RoleARN
+	RoleRef *ackv1alpha1.AWSResourceReferenceWrapper `json:"roleRef,omitempty"`
 }
 
 // The full description of your connected cluster.
@@ -595,10 +598,11 @@ type CreateAccessConfigRequest struct {
 // The summary information about deprecated resource usage for an insight check
 // in the UPGRADE_READINESS category.
 type DeprecationDetail struct {
-	ReplacedWith                   *string `json:"replacedWith,omitempty"`
-	StartServingReplacementVersion *string `json:"startServingReplacementVersion,omitempty"`
-	StopServingVersion             *string `json:"stopServingVersion,omitempty"`
-	Usage                          *string `json:"usage,omitempty"`
+	ClientStats                    []*ClientStat `json:"clientStats,omitempty"`
+	ReplacedWith                   *string       `json:"replacedWith,omitempty"`
+	StartServingReplacementVersion *string       `json:"startServingReplacementVersion,omitempty"`
+	StopServingVersion             *string       `json:"stopServingVersion,omitempty"`
+	Usage                          *string       `json:"usage,omitempty"`
 }
 
 // Constraints for a duration parameter.
@@ -612,52 +616,13 @@ type DurationParameterConfig struct {
 	DefaultValue *string `json:"defaultValue,omitempty"`
 }
 
-// An EKS Anywhere subscription authorizing the customer to support for licensed
-// clusters and access to EKS Anywhere Curated Packages.
-type EKSAnywhereSubscription struct {
-	ARN             *string      `json:"arn,omitempty"`
-	AutoRenew       *bool        `json:"autoRenew,omitempty"`
-	CreatedAt       *metav1.Time `json:"createdAt,omitempty"`
-	EffectiveDate   *metav1.Time `json:"effectiveDate,omitempty"`
-	ExpirationDate  *metav1.Time `json:"expirationDate,omitempty"`
-	ID              *string      `json:"id,omitempty"`
-	LicenseARNs     []*string    `json:"licenseARNs,omitempty"`
-	LicenseQuantity *int64       `json:"licenseQuantity,omitempty"`
-	Status          *string      `json:"status,omitempty"`
-	// The metadata that you apply to a resource to help you categorize and organize
-	// them. Each tag consists of a key and an optional value. You define them.
-	//
-	// The following basic restrictions apply to tags:
-	//
-	//    * Maximum number of tags per resource – 50
-	//
-	//    * For each resource, each tag key must be unique, and each tag key can
-	//    have only one value.
-	//
-	//    * Maximum key length – 128 Unicode characters in UTF-8
-	//
-	//    * Maximum value length – 256 Unicode characters in UTF-8
-	//
-	//    * If your tagging schema is used across multiple services and resources,
-	//    remember that other services may have restrictions on allowed characters.
-	//    Generally allowed characters are: letters, numbers, and spaces representable
-	//    in UTF-8, and the following characters: + - = . _ : / @.
-	//
-	//    * Tag keys and values are case-sensitive.
-	//
-	//    * Do not use aws:, AWS:, or any upper or lowercase combination of such
-	//    as a prefix for either keys or values as it is reserved for Amazon Web
-	//    Services use. You cannot edit or delete tag keys or values with this prefix.
-	//    Tags with this prefix do not count against your tags per resource limit.
-	Tags map[string]*string `json:"tags,omitempty"`
-}
-
 // An object representing the term duration and term unit type of your subscription.
 // This determines the term length of your subscription. Valid values are MONTHS
 // for term unit and 12 or 36 for term duration, indicating a 12 month or 36
 // month subscription.
 type EKSAnywhereSubscriptionTerm struct {
-	Duration *int64 `json:"duration,omitempty"`
+	Duration *int64  `json:"duration,omitempty"`
+	Unit     *string `json:"unit,omitempty"`
 }
 
 // Indicates the current configuration of the load balancing capability on your
@@ -777,39 +742,62 @@ type IdentityProviderConfig_SDK struct {
 // A check that provides recommendations to remedy potential upgrade-impacting
 // issues.
 type Insight struct {
-	Description        *string      `json:"description,omitempty"`
-	ID                 *string      `json:"id,omitempty"`
-	KubernetesVersion  *string      `json:"kubernetesVersion,omitempty"`
-	LastRefreshTime    *metav1.Time `json:"lastRefreshTime,omitempty"`
-	LastTransitionTime *metav1.Time `json:"lastTransitionTime,omitempty"`
-	Name               *string      `json:"name,omitempty"`
-	Recommendation     *string      `json:"recommendation,omitempty"`
+	AdditionalInfo map[string]*string `json:"additionalInfo,omitempty"`
+	Category       *string            `json:"category,omitempty"`
+	// Summary information that relates to the category of the insight. Currently
+	// only returned with certain insights having category UPGRADE_READINESS.
+	CategorySpecificSummary *InsightCategorySpecificSummary `json:"categorySpecificSummary,omitempty"`
+	Description             *string                         `json:"description,omitempty"`
+	ID                      *string                         `json:"id,omitempty"`
+	// The status of the insight.
+	InsightStatus      *InsightStatus           `json:"insightStatus,omitempty"`
+	KubernetesVersion  *string                  `json:"kubernetesVersion,omitempty"`
+	LastRefreshTime    *metav1.Time             `json:"lastRefreshTime,omitempty"`
+	LastTransitionTime *metav1.Time             `json:"lastTransitionTime,omitempty"`
+	Name               *string                  `json:"name,omitempty"`
+	Recommendation     *string                  `json:"recommendation,omitempty"`
+	Resources          []*InsightResourceDetail `json:"resources,omitempty"`
+}
+
+// Summary information that relates to the category of the insight. Currently
+// only returned with certain insights having category UPGRADE_READINESS.
+type InsightCategorySpecificSummary struct {
+	AddonCompatibilityDetails []*AddonCompatibilityDetail `json:"addonCompatibilityDetails,omitempty"`
+	DeprecationDetails        []*DeprecationDetail        `json:"deprecationDetails,omitempty"`
 }
 
 // Returns information about the resource being evaluated.
 type InsightResourceDetail struct {
-	ARN                   *string `json:"arn,omitempty"`
-	KubernetesResourceURI *string `json:"kubernetesResourceURI,omitempty"`
+	ARN *string `json:"arn,omitempty"`
+	// The status of the insight.
+	InsightStatus         *InsightStatus `json:"insightStatus,omitempty"`
+	KubernetesResourceURI *string        `json:"kubernetesResourceURI,omitempty"`
 }
 
 // The status of the insight.
 type InsightStatus struct {
 	Reason *string `json:"reason,omitempty"`
+	Status *string `json:"status,omitempty"`
 }
 
 // The summarized description of the insight.
 type InsightSummary struct {
-	Description        *string      `json:"description,omitempty"`
-	ID                 *string      `json:"id,omitempty"`
-	KubernetesVersion  *string      `json:"kubernetesVersion,omitempty"`
-	LastRefreshTime    *metav1.Time `json:"lastRefreshTime,omitempty"`
-	LastTransitionTime *metav1.Time `json:"lastTransitionTime,omitempty"`
-	Name               *string      `json:"name,omitempty"`
+	Category    *string `json:"category,omitempty"`
+	Description *string `json:"description,omitempty"`
+	ID          *string `json:"id,omitempty"`
+	// The status of the insight.
+	InsightStatus      *InsightStatus `json:"insightStatus,omitempty"`
+	KubernetesVersion  *string        `json:"kubernetesVersion,omitempty"`
+	LastRefreshTime    *metav1.Time   `json:"lastRefreshTime,omitempty"`
+	LastTransitionTime *metav1.Time   `json:"lastTransitionTime,omitempty"`
+	Name               *string        `json:"name,omitempty"`
 }
 
 // The criteria to use for the insights.
 type InsightsFilter struct {
+	Categories         []*string `json:"categories,omitempty"`
 	KubernetesVersions []*string `json:"kubernetesVersions,omitempty"`
+	Statuses           []*string `json:"statuses,omitempty"`
 }
 
 // An integer range constraint specifying minimum and maximum allowed values.
@@ -937,11 +925,12 @@ type MarketplaceInformation struct {
 
 // The node auto repair configuration for the node group.
 type NodeRepairConfig struct {
-	Enabled                             *bool  `json:"enabled,omitempty"`
-	MaxParallelNodesRepairedCount       *int64 `json:"maxParallelNodesRepairedCount,omitempty"`
-	MaxParallelNodesRepairedPercentage  *int64 `json:"maxParallelNodesRepairedPercentage,omitempty"`
-	MaxUnhealthyNodeThresholdCount      *int64 `json:"maxUnhealthyNodeThresholdCount,omitempty"`
-	MaxUnhealthyNodeThresholdPercentage *int64 `json:"maxUnhealthyNodeThresholdPercentage,omitempty"`
+	Enabled                             *bool                        `json:"enabled,omitempty"`
+	MaxParallelNodesRepairedCount       *int64                       `json:"maxParallelNodesRepairedCount,omitempty"`
+	MaxParallelNodesRepairedPercentage  *int64                       `json:"maxParallelNodesRepairedPercentage,omitempty"`
+	MaxUnhealthyNodeThresholdCount      *int64                       `json:"maxUnhealthyNodeThresholdCount,omitempty"`
+	MaxUnhealthyNodeThresholdPercentage *int64                       `json:"maxUnhealthyNodeThresholdPercentage,omitempty"`
+	NodeRepairConfigOverrides           []*NodeRepairConfigOverrides `json:"nodeRepairConfigOverrides,omitempty"`
 }
 
 // Specify granular overrides for specific repair actions. These overrides control
@@ -951,6 +940,7 @@ type NodeRepairConfigOverrides struct {
 	MinRepairWaitTimeMins   *int64  `json:"minRepairWaitTimeMins,omitempty"`
 	NodeMonitoringCondition *string `json:"nodeMonitoringCondition,omitempty"`
 	NodeUnhealthyReason     *string `json:"nodeUnhealthyReason,omitempty"`
+	RepairAction            *string `json:"repairAction,omitempty"`
 }
 
 // The NodeResourcesFit plugin configuration for the Kubernetes scheduler.
@@ -1017,10 +1007,12 @@ type Nodegroup_SDK struct {
 	// in the request, but not both.
 	LaunchTemplate *LaunchTemplateSpecification `json:"launchTemplate,omitempty"`
 	ModifiedAt     *metav1.Time                 `json:"modifiedAt,omitempty"`
-	NodeRole       *string                      `json:"nodeRole,omitempty"`
-	NodegroupARN   *string                      `json:"nodegroupARN,omitempty"`
-	NodegroupName  *string                      `json:"nodegroupName,omitempty"`
-	ReleaseVersion *string                      `json:"releaseVersion,omitempty"`
+	// The node auto repair configuration for the node group.
+	NodeRepairConfig *NodeRepairConfig `json:"nodeRepairConfig,omitempty"`
+	NodeRole         *string           `json:"nodeRole,omitempty"`
+	NodegroupARN     *string           `json:"nodegroupARN,omitempty"`
+	NodegroupName    *string           `json:"nodegroupName,omitempty"`
+	ReleaseVersion   *string           `json:"releaseVersion,omitempty"`
 	// An object representing the remote access configuration for the managed node
 	// group.
 	RemoteAccess *RemoteAccessConfig `json:"remoteAccess,omitempty"`
@@ -1066,6 +1058,8 @@ type Nodegroup_SDK struct {
 	// choose the maximum unavailable and the update strategy.
 	UpdateConfig *NodegroupUpdateConfig `json:"updateConfig,omitempty"`
 	Version      *string                `json:"version,omitempty"`
+	// The warm pool configuration for the node group.
+	WarmPoolConfig *WarmPoolConfig `json:"warmPoolConfig,omitempty"`
 }
 
 // An object representing the OpenID Connect (https://openid.net/connect/) (OIDC)
@@ -1121,9 +1115,10 @@ type OIDCIdentityProviderConfig struct {
 // users for your cluster from an OIDC identity provider (https://docs.aws.amazon.com/eks/latest/userguide/authenticate-oidc-identity-provider.html)
 // in the Amazon EKS User Guide.
 type OIDCIdentityProviderConfigRequest struct {
-	ClientID                   *string            `json:"clientID,omitempty"`
-	GroupsClaim                *string            `json:"groupsClaim,omitempty"`
-	GroupsPrefix               *string            `json:"groupsPrefix,omitempty"`
+	ClientID     *string `json:"clientID,omitempty"`
+	GroupsClaim  *string `json:"groupsClaim,omitempty"`
+	GroupsPrefix *string `json:"groupsPrefix,omitempty"`
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Value is immutable once set"
 	IdentityProviderConfigName *string            `json:"identityProviderConfigName,omitempty"`
 	IssuerURL                  *string            `json:"issuerURL,omitempty"`
 	RequiredClaims             map[string]*string `json:"requiredClaims,omitempty"`
@@ -1401,7 +1396,7 @@ type Update struct {
 	ID           *string        `json:"id,omitempty"`
 	Params       []*UpdateParam `json:"params,omitempty"`
 	Status       *string        `json:"status,omitempty"`
-	Type         *string        `json:"type_,omitempty"`
+	Type         *string        `json:"type,omitempty"`
 }
 
 // The access configuration information for the cluster.
@@ -1436,7 +1431,7 @@ type UpdateLabelsPayload struct {
 
 // An object representing the details of an update request.
 type UpdateParam struct {
-	Type  *string `json:"type_,omitempty"`
+	Type  *string `json:"type,omitempty"`
 	Value *string `json:"value,omitempty"`
 }
 
@@ -1502,10 +1497,11 @@ type VPCConfigResponse struct {
 // initialization process and can be kept in a Stopped, Running, or Hibernated
 // state.
 type WarmPoolConfig struct {
-	Enabled                  *bool  `json:"enabled,omitempty"`
-	MaxGroupPreparedCapacity *int64 `json:"maxGroupPreparedCapacity,omitempty"`
-	MinSize                  *int64 `json:"minSize,omitempty"`
-	ReuseOnScaleIn           *bool  `json:"reuseOnScaleIn,omitempty"`
+	Enabled                  *bool   `json:"enabled,omitempty"`
+	MaxGroupPreparedCapacity *int64  `json:"maxGroupPreparedCapacity,omitempty"`
+	MinSize                  *int64  `json:"minSize,omitempty"`
+	PoolState                *string `json:"poolState,omitempty"`
+	ReuseOnScaleIn           *bool   `json:"reuseOnScaleIn,omitempty"`
 }
 
 // The configuration for zonal shift for the cluster.
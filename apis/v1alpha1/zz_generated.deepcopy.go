@@ -21,7 +21,7 @@ package v1alpha1
 
 import (
 	corev1alpha1 "github.com/aws-controllers-k8s/runtime/apis/core/v1alpha1"
-	runtime "k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime"
 )
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
@@ -209,6 +209,17 @@ func (in *AccessEntryStatus) DeepCopyInto(out *AccessEntryStatus) {
 		in, out := &in.CreatedAt, &out.CreatedAt
 		*out = (*in).DeepCopy()
 	}
+	if in.DryRunPlan != nil {
+		in, out := &in.DryRunPlan, &out.DryRunPlan
+		*out = make([]*string, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(string)
+				**out = **in
+			}
+		}
+	}
 	if in.ModifiedAt != nil {
 		in, out := &in.ModifiedAt, &out.ModifiedAt
 		*out = (*in).DeepCopy()
@@ -414,6 +425,31 @@ func (in *AddonCompatibilityDetail) DeepCopy() *AddonCompatibilityDetail {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AddonConfigurationValuesSource) DeepCopyInto(out *AddonConfigurationValuesSource) {
+	*out = *in
+	if in.ConfigMapKeyRef != nil {
+		in, out := &in.ConfigMapKeyRef, &out.ConfigMapKeyRef
+		*out = new(KeyReference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SecretKeyRef != nil {
+		in, out := &in.SecretKeyRef, &out.SecretKeyRef
+		*out = new(KeyReference)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AddonConfigurationValuesSource.
+func (in *AddonConfigurationValuesSource) DeepCopy() *AddonConfigurationValuesSource {
+	if in == nil {
+		return nil
+	}
+	out := new(AddonConfigurationValuesSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AddonHealth) DeepCopyInto(out *AddonHealth) {
 	*out = *in
@@ -637,11 +673,21 @@ func (in *AddonSpec) DeepCopyInto(out *AddonSpec) {
 		*out = new(corev1alpha1.AWSResourceReferenceWrapper)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Configuration != nil {
+		in, out := &in.Configuration, &out.Configuration
+		*out = new(runtime.RawExtension)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.ConfigurationValues != nil {
 		in, out := &in.ConfigurationValues, &out.ConfigurationValues
 		*out = new(string)
 		**out = **in
 	}
+	if in.ConfigurationValuesFrom != nil {
+		in, out := &in.ConfigurationValuesFrom, &out.ConfigurationValuesFrom
+		*out = new(AddonConfigurationValuesSource)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Name != nil {
 		in, out := &in.Name, &out.Name
 		*out = new(string)
@@ -724,6 +770,17 @@ func (in *AddonStatus) DeepCopyInto(out *AddonStatus) {
 		in, out := &in.CreatedAt, &out.CreatedAt
 		*out = (*in).DeepCopy()
 	}
+	if in.DryRunPlan != nil {
+		in, out := &in.DryRunPlan, &out.DryRunPlan
+		*out = make([]*string, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(string)
+				**out = **in
+			}
+		}
+	}
 	if in.Health != nil {
 		in, out := &in.Health, &out.Health
 		*out = new(AddonHealth)
@@ -748,6 +805,11 @@ func (in *AddonStatus) DeepCopyInto(out *AddonStatus) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.ResolvedAddonVersion != nil {
+		in, out := &in.ResolvedAddonVersion, &out.ResolvedAddonVersion
+		*out = new(string)
+		**out = **in
+	}
 	if in.Status != nil {
 		in, out := &in.Status, &out.Status
 		*out = new(string)
@@ -1514,6 +1576,17 @@ func (in *CapabilityStatus) DeepCopyInto(out *CapabilityStatus) {
 		in, out := &in.CreatedAt, &out.CreatedAt
 		*out = (*in).DeepCopy()
 	}
+	if in.DryRunPlan != nil {
+		in, out := &in.DryRunPlan, &out.DryRunPlan
+		*out = make([]*string, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(string)
+				**out = **in
+			}
+		}
+	}
 	if in.Health != nil {
 		in, out := &in.Health, &out.Health
 		*out = new(CapabilityHealth)
@@ -1852,6 +1925,156 @@ func (in *ClusterList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterRegistration) DeepCopyInto(out *ClusterRegistration) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterRegistration.
+func (in *ClusterRegistration) DeepCopy() *ClusterRegistration {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterRegistration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ClusterRegistration) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterRegistrationList) DeepCopyInto(out *ClusterRegistrationList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ClusterRegistration, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterRegistrationList.
+func (in *ClusterRegistrationList) DeepCopy() *ClusterRegistrationList {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterRegistrationList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ClusterRegistrationList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterRegistrationSpec) DeepCopyInto(out *ClusterRegistrationSpec) {
+	*out = *in
+	if in.ActivationCodeSecretName != nil {
+		in, out := &in.ActivationCodeSecretName, &out.ActivationCodeSecretName
+		*out = new(string)
+		**out = **in
+	}
+	if in.ConnectorConfig != nil {
+		in, out := &in.ConnectorConfig, &out.ConnectorConfig
+		*out = new(ConnectorConfigRequest)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
+		**out = **in
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make(map[string]*string, len(*in))
+		for key, val := range *in {
+			var outVal *string
+			if val == nil {
+				(*out)[key] = nil
+			} else {
+				inVal := (*in)[key]
+				in, out := &inVal, &outVal
+				*out = new(string)
+				**out = **in
+			}
+			(*out)[key] = outVal
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterRegistrationSpec.
+func (in *ClusterRegistrationSpec) DeepCopy() *ClusterRegistrationSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterRegistrationSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterRegistrationStatus) DeepCopyInto(out *ClusterRegistrationStatus) {
+	*out = *in
+	if in.ACKResourceMetadata != nil {
+		in, out := &in.ACKResourceMetadata, &out.ACKResourceMetadata
+		*out = new(corev1alpha1.ResourceMetadata)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]*corev1alpha1.Condition, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(corev1alpha1.Condition)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.ConnectorConfig != nil {
+		in, out := &in.ConnectorConfig, &out.ConnectorConfig
+		*out = new(ConnectorConfigResponse)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CreatedAt != nil {
+		in, out := &in.CreatedAt, &out.CreatedAt
+		*out = (*in).DeepCopy()
+	}
+	if in.Status != nil {
+		in, out := &in.Status, &out.Status
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterRegistrationStatus.
+func (in *ClusterRegistrationStatus) DeepCopy() *ClusterRegistrationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterRegistrationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ClusterSpec) DeepCopyInto(out *ClusterSpec) {
 	*out = *in
@@ -1880,6 +2103,11 @@ func (in *ClusterSpec) DeepCopyInto(out *ClusterSpec) {
 		*out = new(ControlPlaneScalingConfig)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.DeletionPolicy != nil {
+		in, out := &in.DeletionPolicy, &out.DeletionPolicy
+		*out = new(string)
+		**out = **in
+	}
 	if in.DeletionProtection != nil {
 		in, out := &in.DeletionProtection, &out.DeletionProtection
 		*out = new(bool)
@@ -1911,6 +2139,11 @@ func (in *ClusterSpec) DeepCopyInto(out *ClusterSpec) {
 		*out = new(KubeSchedulerConfigRequest)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.KubeconfigSecret != nil {
+		in, out := &in.KubeconfigSecret, &out.KubeconfigSecret
+		*out = new(KubeconfigSecret)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.KubernetesNetworkConfig != nil {
 		in, out := &in.KubernetesNetworkConfig, &out.KubernetesNetworkConfig
 		*out = new(KubernetesNetworkConfigRequest)
@@ -1972,6 +2205,11 @@ func (in *ClusterSpec) DeepCopyInto(out *ClusterSpec) {
 			(*out)[key] = outVal
 		}
 	}
+	if in.UpgradeCascade != nil {
+		in, out := &in.UpgradeCascade, &out.UpgradeCascade
+		*out = new(UpgradeCascade)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.UpgradePolicy != nil {
 		in, out := &in.UpgradePolicy, &out.UpgradePolicy
 		*out = new(UpgradePolicyRequest)
@@ -2037,6 +2275,28 @@ func (in *ClusterStatus) DeepCopyInto(out *ClusterStatus) {
 		in, out := &in.CreatedAt, &out.CreatedAt
 		*out = (*in).DeepCopy()
 	}
+	if in.DeletionCascadeProgress != nil {
+		in, out := &in.DeletionCascadeProgress, &out.DeletionCascadeProgress
+		*out = make([]*DeletionCascadeChild, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(DeletionCascadeChild)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.DryRunPlan != nil {
+		in, out := &in.DryRunPlan, &out.DryRunPlan
+		*out = make([]*string, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(string)
+				**out = **in
+			}
+		}
+	}
 	if in.Endpoint != nil {
 		in, out := &in.Endpoint, &out.Endpoint
 		*out = new(string)
@@ -2057,6 +2317,11 @@ func (in *ClusterStatus) DeepCopyInto(out *ClusterStatus) {
 		*out = new(Identity)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.KubeconfigSecretName != nil {
+		in, out := &in.KubeconfigSecretName, &out.KubeconfigSecretName
+		*out = new(string)
+		**out = **in
+	}
 	if in.PlatformVersion != nil {
 		in, out := &in.PlatformVersion, &out.PlatformVersion
 		*out = new(string)
@@ -2067,6 +2332,55 @@ func (in *ClusterStatus) DeepCopyInto(out *ClusterStatus) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.UpdatePlan != nil {
+		in, out := &in.UpdatePlan, &out.UpdatePlan
+		*out = make([]*string, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(string)
+				**out = **in
+			}
+		}
+	}
+	if in.Updates != nil {
+		in, out := &in.Updates, &out.Updates
+		*out = make([]*Update, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(Update)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.UpgradeCascadeProgress != nil {
+		in, out := &in.UpgradeCascadeProgress, &out.UpgradeCascadeProgress
+		*out = make([]*UpgradeCascadeChild, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(UpgradeCascadeChild)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.UpgradeInsights != nil {
+		in, out := &in.UpgradeInsights, &out.UpgradeInsights
+		*out = make([]*UpgradeInsight, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(UpgradeInsight)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.VersionSupport != nil {
+		in, out := &in.VersionSupport, &out.VersionSupport
+		*out = new(VersionSupport)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterStatus.
@@ -2428,11 +2742,21 @@ func (in *ComputeConfigResponse) DeepCopy() *ComputeConfigResponse {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ConnectorConfigRequest) DeepCopyInto(out *ConnectorConfigRequest) {
 	*out = *in
+	if in.Provider != nil {
+		in, out := &in.Provider, &out.Provider
+		*out = new(string)
+		**out = **in
+	}
 	if in.RoleARN != nil {
 		in, out := &in.RoleARN, &out.RoleARN
 		*out = new(string)
 		**out = **in
 	}
+	if in.RoleRef != nil {
+		in, out := &in.RoleRef, &out.RoleRef
+		*out = new(corev1alpha1.AWSResourceReferenceWrapper)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConnectorConfigRequest.
@@ -2605,20 +2929,61 @@ func (in *CreateAccessConfigRequest) DeepCopy() *CreateAccessConfigRequest {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DeprecationDetail) DeepCopyInto(out *DeprecationDetail) {
+func (in *DeletionCascadeChild) DeepCopyInto(out *DeletionCascadeChild) {
 	*out = *in
-	if in.ReplacedWith != nil {
-		in, out := &in.ReplacedWith, &out.ReplacedWith
+	if in.Kind != nil {
+		in, out := &in.Kind, &out.Kind
 		*out = new(string)
 		**out = **in
 	}
-	if in.StartServingReplacementVersion != nil {
-		in, out := &in.StartServingReplacementVersion, &out.StartServingReplacementVersion
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
 		*out = new(string)
 		**out = **in
 	}
-	if in.StopServingVersion != nil {
-		in, out := &in.StopServingVersion, &out.StopServingVersion
+	if in.State != nil {
+		in, out := &in.State, &out.State
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeletionCascadeChild.
+func (in *DeletionCascadeChild) DeepCopy() *DeletionCascadeChild {
+	if in == nil {
+		return nil
+	}
+	out := new(DeletionCascadeChild)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeprecationDetail) DeepCopyInto(out *DeprecationDetail) {
+	*out = *in
+	if in.ClientStats != nil {
+		in, out := &in.ClientStats, &out.ClientStats
+		*out = make([]*ClientStat, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(ClientStat)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.ReplacedWith != nil {
+		in, out := &in.ReplacedWith, &out.ReplacedWith
+		*out = new(string)
+		**out = **in
+	}
+	if in.StartServingReplacementVersion != nil {
+		in, out := &in.StartServingReplacementVersion, &out.StartServingReplacementVersion
+		*out = new(string)
+		**out = **in
+	}
+	if in.StopServingVersion != nil {
+		in, out := &in.StopServingVersion, &out.StopServingVersion
 		*out = new(string)
 		**out = **in
 	}
@@ -2687,16 +3052,142 @@ func (in *DurationParameterConfig) DeepCopy() *DurationParameterConfig {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *EKSAnywhereSubscription) DeepCopyInto(out *EKSAnywhereSubscription) {
 	*out = *in
-	if in.ARN != nil {
-		in, out := &in.ARN, &out.ARN
-		*out = new(string)
-		**out = **in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EKSAnywhereSubscription.
+func (in *EKSAnywhereSubscription) DeepCopy() *EKSAnywhereSubscription {
+	if in == nil {
+		return nil
 	}
+	out := new(EKSAnywhereSubscription)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *EKSAnywhereSubscription) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EKSAnywhereSubscriptionList) DeepCopyInto(out *EKSAnywhereSubscriptionList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]EKSAnywhereSubscription, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EKSAnywhereSubscriptionList.
+func (in *EKSAnywhereSubscriptionList) DeepCopy() *EKSAnywhereSubscriptionList {
+	if in == nil {
+		return nil
+	}
+	out := new(EKSAnywhereSubscriptionList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *EKSAnywhereSubscriptionList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EKSAnywhereSubscriptionSpec) DeepCopyInto(out *EKSAnywhereSubscriptionSpec) {
+	*out = *in
 	if in.AutoRenew != nil {
 		in, out := &in.AutoRenew, &out.AutoRenew
 		*out = new(bool)
 		**out = **in
 	}
+	if in.LicenseQuantity != nil {
+		in, out := &in.LicenseQuantity, &out.LicenseQuantity
+		*out = new(int64)
+		**out = **in
+	}
+	if in.LicenseTokenSecretName != nil {
+		in, out := &in.LicenseTokenSecretName, &out.LicenseTokenSecretName
+		*out = new(string)
+		**out = **in
+	}
+	if in.LicenseType != nil {
+		in, out := &in.LicenseType, &out.LicenseType
+		*out = new(string)
+		**out = **in
+	}
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
+		**out = **in
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make(map[string]*string, len(*in))
+		for key, val := range *in {
+			var outVal *string
+			if val == nil {
+				(*out)[key] = nil
+			} else {
+				inVal := (*in)[key]
+				in, out := &inVal, &outVal
+				*out = new(string)
+				**out = **in
+			}
+			(*out)[key] = outVal
+		}
+	}
+	if in.Term != nil {
+		in, out := &in.Term, &out.Term
+		*out = new(EKSAnywhereSubscriptionTerm)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EKSAnywhereSubscriptionSpec.
+func (in *EKSAnywhereSubscriptionSpec) DeepCopy() *EKSAnywhereSubscriptionSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(EKSAnywhereSubscriptionSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EKSAnywhereSubscriptionStatus) DeepCopyInto(out *EKSAnywhereSubscriptionStatus) {
+	*out = *in
+	if in.ACKResourceMetadata != nil {
+		in, out := &in.ACKResourceMetadata, &out.ACKResourceMetadata
+		*out = new(corev1alpha1.ResourceMetadata)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]*corev1alpha1.Condition, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(corev1alpha1.Condition)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
 	if in.CreatedAt != nil {
 		in, out := &in.CreatedAt, &out.CreatedAt
 		*out = (*in).DeepCopy()
@@ -2725,40 +3216,30 @@ func (in *EKSAnywhereSubscription) DeepCopyInto(out *EKSAnywhereSubscription) {
 			}
 		}
 	}
-	if in.LicenseQuantity != nil {
-		in, out := &in.LicenseQuantity, &out.LicenseQuantity
-		*out = new(int64)
-		**out = **in
+	if in.LicenseIDs != nil {
+		in, out := &in.LicenseIDs, &out.LicenseIDs
+		*out = make([]*string, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(string)
+				**out = **in
+			}
+		}
 	}
 	if in.Status != nil {
 		in, out := &in.Status, &out.Status
 		*out = new(string)
 		**out = **in
 	}
-	if in.Tags != nil {
-		in, out := &in.Tags, &out.Tags
-		*out = make(map[string]*string, len(*in))
-		for key, val := range *in {
-			var outVal *string
-			if val == nil {
-				(*out)[key] = nil
-			} else {
-				inVal := (*in)[key]
-				in, out := &inVal, &outVal
-				*out = new(string)
-				**out = **in
-			}
-			(*out)[key] = outVal
-		}
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EKSAnywhereSubscription.
-func (in *EKSAnywhereSubscription) DeepCopy() *EKSAnywhereSubscription {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EKSAnywhereSubscriptionStatus.
+func (in *EKSAnywhereSubscriptionStatus) DeepCopy() *EKSAnywhereSubscriptionStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(EKSAnywhereSubscription)
+	out := new(EKSAnywhereSubscriptionStatus)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -2771,6 +3252,11 @@ func (in *EKSAnywhereSubscriptionTerm) DeepCopyInto(out *EKSAnywhereSubscription
 		*out = new(int64)
 		**out = **in
 	}
+	if in.Unit != nil {
+		in, out := &in.Unit, &out.Unit
+		*out = new(string)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EKSAnywhereSubscriptionTerm.
@@ -2991,6 +3477,40 @@ func (in *FargateProfileList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FargateProfileReplacement) DeepCopyInto(out *FargateProfileReplacement) {
+	*out = *in
+	if in.FargateProfileName != nil {
+		in, out := &in.FargateProfileName, &out.FargateProfileName
+		*out = new(string)
+		**out = **in
+	}
+	if in.Phase != nil {
+		in, out := &in.Phase, &out.Phase
+		*out = new(string)
+		**out = **in
+	}
+	if in.PreviousFargateProfileName != nil {
+		in, out := &in.PreviousFargateProfileName, &out.PreviousFargateProfileName
+		*out = new(string)
+		**out = **in
+	}
+	if in.StartedAt != nil {
+		in, out := &in.StartedAt, &out.StartedAt
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FargateProfileReplacement.
+func (in *FargateProfileReplacement) DeepCopy() *FargateProfileReplacement {
+	if in == nil {
+		return nil
+	}
+	out := new(FargateProfileReplacement)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *FargateProfileSelector) DeepCopyInto(out *FargateProfileSelector) {
 	*out = *in
@@ -3060,6 +3580,11 @@ func (in *FargateProfileSpec) DeepCopyInto(out *FargateProfileSpec) {
 		*out = new(corev1alpha1.AWSResourceReferenceWrapper)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ReplacementStrategy != nil {
+		in, out := &in.ReplacementStrategy, &out.ReplacementStrategy
+		*out = new(string)
+		**out = **in
+	}
 	if in.Selectors != nil {
 		in, out := &in.Selectors, &out.Selectors
 		*out = make([]*FargateProfileSelector, len(*in))
@@ -3144,11 +3669,21 @@ func (in *FargateProfileStatus) DeepCopyInto(out *FargateProfileStatus) {
 		in, out := &in.CreatedAt, &out.CreatedAt
 		*out = (*in).DeepCopy()
 	}
+	if in.CurrentFargateProfileName != nil {
+		in, out := &in.CurrentFargateProfileName, &out.CurrentFargateProfileName
+		*out = new(string)
+		**out = **in
+	}
 	if in.Health != nil {
 		in, out := &in.Health, &out.Health
 		*out = new(FargateProfileHealth)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Replacement != nil {
+		in, out := &in.Replacement, &out.Replacement
+		*out = new(FargateProfileReplacement)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Status != nil {
 		in, out := &in.Status, &out.Status
 		*out = new(string)
@@ -3372,6 +3907,40 @@ func (in *IdentityProviderConfigList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IdentityProviderConfigReplacement) DeepCopyInto(out *IdentityProviderConfigReplacement) {
+	*out = *in
+	if in.Phase != nil {
+		in, out := &in.Phase, &out.Phase
+		*out = new(string)
+		**out = **in
+	}
+	if in.StartedAt != nil {
+		in, out := &in.StartedAt, &out.StartedAt
+		*out = (*in).DeepCopy()
+	}
+	if in.UpdateID != nil {
+		in, out := &in.UpdateID, &out.UpdateID
+		*out = new(string)
+		**out = **in
+	}
+	if in.UpdateStatus != nil {
+		in, out := &in.UpdateStatus, &out.UpdateStatus
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IdentityProviderConfigReplacement.
+func (in *IdentityProviderConfigReplacement) DeepCopy() *IdentityProviderConfigReplacement {
+	if in == nil {
+		return nil
+	}
+	out := new(IdentityProviderConfigReplacement)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *IdentityProviderConfigResponse) DeepCopyInto(out *IdentityProviderConfigResponse) {
 	*out = *in
@@ -3410,6 +3979,11 @@ func (in *IdentityProviderConfigSpec) DeepCopyInto(out *IdentityProviderConfigSp
 		*out = new(OIDCIdentityProviderConfigRequest)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ReplacementStrategy != nil {
+		in, out := &in.ReplacementStrategy, &out.ReplacementStrategy
+		*out = new(string)
+		**out = **in
+	}
 	if in.Tags != nil {
 		in, out := &in.Tags, &out.Tags
 		*out = make(map[string]*string, len(*in))
@@ -3457,6 +4031,11 @@ func (in *IdentityProviderConfigStatus) DeepCopyInto(out *IdentityProviderConfig
 			}
 		}
 	}
+	if in.Replacement != nil {
+		in, out := &in.Replacement, &out.Replacement
+		*out = new(IdentityProviderConfigReplacement)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Status != nil {
 		in, out := &in.Status, &out.Status
 		*out = new(string)
@@ -3502,6 +4081,32 @@ func (in *IdentityProviderConfig_SDK) DeepCopy() *IdentityProviderConfig_SDK {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Insight) DeepCopyInto(out *Insight) {
 	*out = *in
+	if in.AdditionalInfo != nil {
+		in, out := &in.AdditionalInfo, &out.AdditionalInfo
+		*out = make(map[string]*string, len(*in))
+		for key, val := range *in {
+			var outVal *string
+			if val == nil {
+				(*out)[key] = nil
+			} else {
+				inVal := (*in)[key]
+				in, out := &inVal, &outVal
+				*out = new(string)
+				**out = **in
+			}
+			(*out)[key] = outVal
+		}
+	}
+	if in.Category != nil {
+		in, out := &in.Category, &out.Category
+		*out = new(string)
+		**out = **in
+	}
+	if in.CategorySpecificSummary != nil {
+		in, out := &in.CategorySpecificSummary, &out.CategorySpecificSummary
+		*out = new(InsightCategorySpecificSummary)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Description != nil {
 		in, out := &in.Description, &out.Description
 		*out = new(string)
@@ -3512,6 +4117,11 @@ func (in *Insight) DeepCopyInto(out *Insight) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.InsightStatus != nil {
+		in, out := &in.InsightStatus, &out.InsightStatus
+		*out = new(InsightStatus)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.KubernetesVersion != nil {
 		in, out := &in.KubernetesVersion, &out.KubernetesVersion
 		*out = new(string)
@@ -3535,6 +4145,17 @@ func (in *Insight) DeepCopyInto(out *Insight) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = make([]*InsightResourceDetail, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(InsightResourceDetail)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Insight.
@@ -3547,6 +4168,43 @@ func (in *Insight) DeepCopy() *Insight {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InsightCategorySpecificSummary) DeepCopyInto(out *InsightCategorySpecificSummary) {
+	*out = *in
+	if in.AddonCompatibilityDetails != nil {
+		in, out := &in.AddonCompatibilityDetails, &out.AddonCompatibilityDetails
+		*out = make([]*AddonCompatibilityDetail, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(AddonCompatibilityDetail)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.DeprecationDetails != nil {
+		in, out := &in.DeprecationDetails, &out.DeprecationDetails
+		*out = make([]*DeprecationDetail, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(DeprecationDetail)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InsightCategorySpecificSummary.
+func (in *InsightCategorySpecificSummary) DeepCopy() *InsightCategorySpecificSummary {
+	if in == nil {
+		return nil
+	}
+	out := new(InsightCategorySpecificSummary)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *InsightResourceDetail) DeepCopyInto(out *InsightResourceDetail) {
 	*out = *in
@@ -3555,6 +4213,11 @@ func (in *InsightResourceDetail) DeepCopyInto(out *InsightResourceDetail) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.InsightStatus != nil {
+		in, out := &in.InsightStatus, &out.InsightStatus
+		*out = new(InsightStatus)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.KubernetesResourceURI != nil {
 		in, out := &in.KubernetesResourceURI, &out.KubernetesResourceURI
 		*out = new(string)
@@ -3580,6 +4243,11 @@ func (in *InsightStatus) DeepCopyInto(out *InsightStatus) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.Status != nil {
+		in, out := &in.Status, &out.Status
+		*out = new(string)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InsightStatus.
@@ -3595,6 +4263,11 @@ func (in *InsightStatus) DeepCopy() *InsightStatus {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *InsightSummary) DeepCopyInto(out *InsightSummary) {
 	*out = *in
+	if in.Category != nil {
+		in, out := &in.Category, &out.Category
+		*out = new(string)
+		**out = **in
+	}
 	if in.Description != nil {
 		in, out := &in.Description, &out.Description
 		*out = new(string)
@@ -3605,6 +4278,11 @@ func (in *InsightSummary) DeepCopyInto(out *InsightSummary) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.InsightStatus != nil {
+		in, out := &in.InsightStatus, &out.InsightStatus
+		*out = new(InsightStatus)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.KubernetesVersion != nil {
 		in, out := &in.KubernetesVersion, &out.KubernetesVersion
 		*out = new(string)
@@ -3638,6 +4316,17 @@ func (in *InsightSummary) DeepCopy() *InsightSummary {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *InsightsFilter) DeepCopyInto(out *InsightsFilter) {
 	*out = *in
+	if in.Categories != nil {
+		in, out := &in.Categories, &out.Categories
+		*out = make([]*string, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(string)
+				**out = **in
+			}
+		}
+	}
 	if in.KubernetesVersions != nil {
 		in, out := &in.KubernetesVersions, &out.KubernetesVersions
 		*out = make([]*string, len(*in))
@@ -3649,6 +4338,17 @@ func (in *InsightsFilter) DeepCopyInto(out *InsightsFilter) {
 			}
 		}
 	}
+	if in.Statuses != nil {
+		in, out := &in.Statuses, &out.Statuses
+		*out = make([]*string, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(string)
+				**out = **in
+			}
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InsightsFilter.
@@ -3722,6 +4422,31 @@ func (in *Issue) DeepCopy() *Issue {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KeyReference) DeepCopyInto(out *KeyReference) {
+	*out = *in
+	if in.Key != nil {
+		in, out := &in.Key, &out.Key
+		*out = new(string)
+		**out = **in
+	}
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KeyReference.
+func (in *KeyReference) DeepCopy() *KeyReference {
+	if in == nil {
+		return nil
+	}
+	out := new(KeyReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *KubeAPIServerConfigRequest) DeepCopyInto(out *KubeAPIServerConfigRequest) {
 	*out = *in
@@ -3852,6 +4577,36 @@ func (in *KubeSchedulerConfigResponse) DeepCopy() *KubeSchedulerConfigResponse {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubeconfigSecret) DeepCopyInto(out *KubeconfigSecret) {
+	*out = *in
+	if in.Key != nil {
+		in, out := &in.Key, &out.Key
+		*out = new(string)
+		**out = **in
+	}
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
+		**out = **in
+	}
+	if in.RoleARN != nil {
+		in, out := &in.RoleARN, &out.RoleARN
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubeconfigSecret.
+func (in *KubeconfigSecret) DeepCopy() *KubeconfigSecret {
+	if in == nil {
+		return nil
+	}
+	out := new(KubeconfigSecret)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *KubernetesNetworkConfigRequest) DeepCopyInto(out *KubernetesNetworkConfigRequest) {
 	*out = *in
@@ -4019,12 +4774,100 @@ func (in *Logging) DeepCopyInto(out *Logging) {
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Logging.
-func (in *Logging) DeepCopy() *Logging {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Logging.
+func (in *Logging) DeepCopy() *Logging {
+	if in == nil {
+		return nil
+	}
+	out := new(Logging)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MaintenanceWindow) DeepCopyInto(out *MaintenanceWindow) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MaintenanceWindow.
+func (in *MaintenanceWindow) DeepCopy() *MaintenanceWindow {
+	if in == nil {
+		return nil
+	}
+	out := new(MaintenanceWindow)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *MaintenanceWindow) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MaintenanceWindowList) DeepCopyInto(out *MaintenanceWindowList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]MaintenanceWindow, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MaintenanceWindowList.
+func (in *MaintenanceWindowList) DeepCopy() *MaintenanceWindowList {
+	if in == nil {
+		return nil
+	}
+	out := new(MaintenanceWindowList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *MaintenanceWindowList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MaintenanceWindowSpec) DeepCopyInto(out *MaintenanceWindowSpec) {
+	*out = *in
+	if in.Duration != nil {
+		in, out := &in.Duration, &out.Duration
+		*out = new(string)
+		**out = **in
+	}
+	if in.Schedule != nil {
+		in, out := &in.Schedule, &out.Schedule
+		*out = new(string)
+		**out = **in
+	}
+	if in.TimeZone != nil {
+		in, out := &in.TimeZone, &out.TimeZone
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MaintenanceWindowSpec.
+func (in *MaintenanceWindowSpec) DeepCopy() *MaintenanceWindowSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(Logging)
+	out := new(MaintenanceWindowSpec)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -4082,6 +4925,17 @@ func (in *NodeRepairConfig) DeepCopyInto(out *NodeRepairConfig) {
 		*out = new(int64)
 		**out = **in
 	}
+	if in.NodeRepairConfigOverrides != nil {
+		in, out := &in.NodeRepairConfigOverrides, &out.NodeRepairConfigOverrides
+		*out = make([]*NodeRepairConfigOverrides, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(NodeRepairConfigOverrides)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeRepairConfig.
@@ -4112,6 +4966,11 @@ func (in *NodeRepairConfigOverrides) DeepCopyInto(out *NodeRepairConfigOverrides
 		*out = new(string)
 		**out = **in
 	}
+	if in.RepairAction != nil {
+		in, out := &in.RepairAction, &out.RepairAction
+		*out = new(string)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeRepairConfigOverrides.
@@ -4229,6 +5088,40 @@ func (in *NodegroupList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodegroupReplacement) DeepCopyInto(out *NodegroupReplacement) {
+	*out = *in
+	if in.NodegroupName != nil {
+		in, out := &in.NodegroupName, &out.NodegroupName
+		*out = new(string)
+		**out = **in
+	}
+	if in.Phase != nil {
+		in, out := &in.Phase, &out.Phase
+		*out = new(string)
+		**out = **in
+	}
+	if in.PreviousNodegroupName != nil {
+		in, out := &in.PreviousNodegroupName, &out.PreviousNodegroupName
+		*out = new(string)
+		**out = **in
+	}
+	if in.StartedAt != nil {
+		in, out := &in.StartedAt, &out.StartedAt
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodegroupReplacement.
+func (in *NodegroupReplacement) DeepCopy() *NodegroupReplacement {
+	if in == nil {
+		return nil
+	}
+	out := new(NodegroupReplacement)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NodegroupResources) DeepCopyInto(out *NodegroupResources) {
 	*out = *in
@@ -4290,6 +5183,56 @@ func (in *NodegroupScalingConfig) DeepCopy() *NodegroupScalingConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodegroupScalingSchedule) DeepCopyInto(out *NodegroupScalingSchedule) {
+	*out = *in
+	if in.DesiredSize != nil {
+		in, out := &in.DesiredSize, &out.DesiredSize
+		*out = new(int64)
+		**out = **in
+	}
+	if in.Duration != nil {
+		in, out := &in.Duration, &out.Duration
+		*out = new(string)
+		**out = **in
+	}
+	if in.MaxSize != nil {
+		in, out := &in.MaxSize, &out.MaxSize
+		*out = new(int64)
+		**out = **in
+	}
+	if in.MinSize != nil {
+		in, out := &in.MinSize, &out.MinSize
+		*out = new(int64)
+		**out = **in
+	}
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
+		**out = **in
+	}
+	if in.Schedule != nil {
+		in, out := &in.Schedule, &out.Schedule
+		*out = new(string)
+		**out = **in
+	}
+	if in.TimeZone != nil {
+		in, out := &in.TimeZone, &out.TimeZone
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodegroupScalingSchedule.
+func (in *NodegroupScalingSchedule) DeepCopy() *NodegroupScalingSchedule {
+	if in == nil {
+		return nil
+	}
+	out := new(NodegroupScalingSchedule)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NodegroupSpec) DeepCopyInto(out *NodegroupSpec) {
 	*out = *in
@@ -4360,6 +5303,11 @@ func (in *NodegroupSpec) DeepCopyInto(out *NodegroupSpec) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.NodeRepairConfig != nil {
+		in, out := &in.NodeRepairConfig, &out.NodeRepairConfig
+		*out = new(NodeRepairConfig)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.NodeRole != nil {
 		in, out := &in.NodeRole, &out.NodeRole
 		*out = new(string)
@@ -4380,11 +5328,27 @@ func (in *NodegroupSpec) DeepCopyInto(out *NodegroupSpec) {
 		*out = new(RemoteAccessConfig)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ReplacementStrategy != nil {
+		in, out := &in.ReplacementStrategy, &out.ReplacementStrategy
+		*out = new(string)
+		**out = **in
+	}
 	if in.ScalingConfig != nil {
 		in, out := &in.ScalingConfig, &out.ScalingConfig
 		*out = new(NodegroupScalingConfig)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ScalingSchedules != nil {
+		in, out := &in.ScalingSchedules, &out.ScalingSchedules
+		*out = make([]*NodegroupScalingSchedule, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(NodegroupScalingSchedule)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
 	if in.SubnetRefs != nil {
 		in, out := &in.SubnetRefs, &out.SubnetRefs
 		*out = make([]*corev1alpha1.AWSResourceReferenceWrapper, len(*in))
@@ -4444,6 +5408,11 @@ func (in *NodegroupSpec) DeepCopyInto(out *NodegroupSpec) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.WarmPoolConfig != nil {
+		in, out := &in.WarmPoolConfig, &out.WarmPoolConfig
+		*out = new(WarmPoolConfig)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodegroupSpec.
@@ -4475,15 +5444,36 @@ func (in *NodegroupStatus) DeepCopyInto(out *NodegroupStatus) {
 			}
 		}
 	}
+	if in.ActiveScalingSchedule != nil {
+		in, out := &in.ActiveScalingSchedule, &out.ActiveScalingSchedule
+		*out = new(string)
+		**out = **in
+	}
 	if in.CreatedAt != nil {
 		in, out := &in.CreatedAt, &out.CreatedAt
 		*out = (*in).DeepCopy()
 	}
+	if in.CurrentNodegroupName != nil {
+		in, out := &in.CurrentNodegroupName, &out.CurrentNodegroupName
+		*out = new(string)
+		**out = **in
+	}
 	if in.DesiredSize != nil {
 		in, out := &in.DesiredSize, &out.DesiredSize
 		*out = new(int64)
 		**out = **in
 	}
+	if in.DryRunPlan != nil {
+		in, out := &in.DryRunPlan, &out.DryRunPlan
+		*out = make([]*string, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(string)
+				**out = **in
+			}
+		}
+	}
 	if in.Health != nil {
 		in, out := &in.Health, &out.Health
 		*out = new(NodegroupHealth)
@@ -4493,6 +5483,21 @@ func (in *NodegroupStatus) DeepCopyInto(out *NodegroupStatus) {
 		in, out := &in.ModifiedAt, &out.ModifiedAt
 		*out = (*in).DeepCopy()
 	}
+	if in.NextScalingScheduleEvaluation != nil {
+		in, out := &in.NextScalingScheduleEvaluation, &out.NextScalingScheduleEvaluation
+		*out = new(string)
+		**out = **in
+	}
+	if in.Replacement != nil {
+		in, out := &in.Replacement, &out.Replacement
+		*out = new(NodegroupReplacement)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ResolvedLaunchTemplateVersion != nil {
+		in, out := &in.ResolvedLaunchTemplateVersion, &out.ResolvedLaunchTemplateVersion
+		*out = new(string)
+		**out = **in
+	}
 	if in.Resources != nil {
 		in, out := &in.Resources, &out.Resources
 		*out = new(NodegroupResources)
@@ -4608,6 +5613,11 @@ func (in *Nodegroup_SDK) DeepCopyInto(out *Nodegroup_SDK) {
 		in, out := &in.ModifiedAt, &out.ModifiedAt
 		*out = (*in).DeepCopy()
 	}
+	if in.NodeRepairConfig != nil {
+		in, out := &in.NodeRepairConfig, &out.NodeRepairConfig
+		*out = new(NodeRepairConfig)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.NodeRole != nil {
 		in, out := &in.NodeRole, &out.NodeRole
 		*out = new(string)
@@ -4696,6 +5706,11 @@ func (in *Nodegroup_SDK) DeepCopyInto(out *Nodegroup_SDK) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.WarmPoolConfig != nil {
+		in, out := &in.WarmPoolConfig, &out.WarmPoolConfig
+		*out = new(WarmPoolConfig)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Nodegroup_SDK.
@@ -5991,6 +7006,126 @@ func (in *UpdateTaintsPayload) DeepCopy() *UpdateTaintsPayload {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UpgradeCascade) DeepCopyInto(out *UpgradeCascade) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.MaxSkew != nil {
+		in, out := &in.MaxSkew, &out.MaxSkew
+		*out = new(int64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UpgradeCascade.
+func (in *UpgradeCascade) DeepCopy() *UpgradeCascade {
+	if in == nil {
+		return nil
+	}
+	out := new(UpgradeCascade)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UpgradeCascadeChild) DeepCopyInto(out *UpgradeCascadeChild) {
+	*out = *in
+	if in.Kind != nil {
+		in, out := &in.Kind, &out.Kind
+		*out = new(string)
+		**out = **in
+	}
+	if in.Message != nil {
+		in, out := &in.Message, &out.Message
+		*out = new(string)
+		**out = **in
+	}
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
+		**out = **in
+	}
+	if in.State != nil {
+		in, out := &in.State, &out.State
+		*out = new(string)
+		**out = **in
+	}
+	if in.TargetVersion != nil {
+		in, out := &in.TargetVersion, &out.TargetVersion
+		*out = new(string)
+		**out = **in
+	}
+	if in.UpdateID != nil {
+		in, out := &in.UpdateID, &out.UpdateID
+		*out = new(string)
+		**out = **in
+	}
+	if in.Version != nil {
+		in, out := &in.Version, &out.Version
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UpgradeCascadeChild.
+func (in *UpgradeCascadeChild) DeepCopy() *UpgradeCascadeChild {
+	if in == nil {
+		return nil
+	}
+	out := new(UpgradeCascadeChild)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UpgradeInsight) DeepCopyInto(out *UpgradeInsight) {
+	*out = *in
+	if in.ID != nil {
+		in, out := &in.ID, &out.ID
+		*out = new(string)
+		**out = **in
+	}
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
+		**out = **in
+	}
+	if in.Reason != nil {
+		in, out := &in.Reason, &out.Reason
+		*out = new(string)
+		**out = **in
+	}
+	if in.Recommendation != nil {
+		in, out := &in.Recommendation, &out.Recommendation
+		*out = new(string)
+		**out = **in
+	}
+	if in.ResourceCount != nil {
+		in, out := &in.ResourceCount, &out.ResourceCount
+		*out = new(int64)
+		**out = **in
+	}
+	if in.Status != nil {
+		in, out := &in.Status, &out.Status
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UpgradeInsight.
+func (in *UpgradeInsight) DeepCopy() *UpgradeInsight {
+	if in == nil {
+		return nil
+	}
+	out := new(UpgradeInsight)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *UpgradePolicyRequest) DeepCopyInto(out *UpgradePolicyRequest) {
 	*out = *in
@@ -6179,6 +7314,39 @@ func (in *VPCConfigResponse) DeepCopy() *VPCConfigResponse {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VersionSupport) DeepCopyInto(out *VersionSupport) {
+	*out = *in
+	if in.EndOfExtendedSupportDate != nil {
+		in, out := &in.EndOfExtendedSupportDate, &out.EndOfExtendedSupportDate
+		*out = (*in).DeepCopy()
+	}
+	if in.EndOfStandardSupportDate != nil {
+		in, out := &in.EndOfStandardSupportDate, &out.EndOfStandardSupportDate
+		*out = (*in).DeepCopy()
+	}
+	if in.Version != nil {
+		in, out := &in.Version, &out.Version
+		*out = new(string)
+		**out = **in
+	}
+	if in.VersionStatus != nil {
+		in, out := &in.VersionStatus, &out.VersionStatus
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VersionSupport.
+func (in *VersionSupport) DeepCopy() *VersionSupport {
+	if in == nil {
+		return nil
+	}
+	out := new(VersionSupport)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *WarmPoolConfig) DeepCopyInto(out *WarmPoolConfig) {
 	*out = *in
@@ -6197,6 +7365,11 @@ func (in *WarmPoolConfig) DeepCopyInto(out *WarmPoolConfig) {
 		*out = new(int64)
 		**out = **in
 	}
+	if in.PoolState != nil {
+		in, out := &in.PoolState, &out.PoolState
+		*out = new(string)
+		**out = **in
+	}
 	if in.ReuseOnScaleIn != nil {
 		in, out := &in.ReuseOnScaleIn, &out.ReuseOnScaleIn
 		*out = new(bool)
@@ -48,6 +48,8 @@ import (
 	_ "github.com/aws-controllers-k8s/eks-controller/pkg/resource/addon"
 	_ "github.com/aws-controllers-k8s/eks-controller/pkg/resource/capability"
 	_ "github.com/aws-controllers-k8s/eks-controller/pkg/resource/cluster"
+	_ "github.com/aws-controllers-k8s/eks-controller/pkg/resource/cluster_registration"
+	_ "github.com/aws-controllers-k8s/eks-controller/pkg/resource/eks_anywhere_subscription"
 	_ "github.com/aws-controllers-k8s/eks-controller/pkg/resource/fargate_profile"
 	_ "github.com/aws-controllers-k8s/eks-controller/pkg/resource/identity_provider_config"
 	_ "github.com/aws-controllers-k8s/eks-controller/pkg/resource/nodegroup"
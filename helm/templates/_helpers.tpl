@@ -55,12 +55,18 @@ rules:
   - ""
   resources:
   - configmaps
-  - secrets
   verbs:
   - get
   - list
   - patch
   - watch
+- apiGroups:
+  - ""
+  resources:
+  - events
+  verbs:
+  - create
+  - patch
 - apiGroups:
   - ""
   resources:
@@ -69,6 +75,18 @@ rules:
   - get
   - list
   - watch
+- apiGroups:
+  - ""
+  resources:
+  - secrets
+  verbs:
+  - create
+  - delete
+  - get
+  - list
+  - patch
+  - update
+  - watch
 - apiGroups:
   - ec2.services.k8s.aws
   resources:
@@ -85,9 +103,12 @@ rules:
   - accessentries
   - addons
   - capabilities
+  - clusterregistrations
   - clusters
+  - eksanywheresubscriptions
   - fargateprofiles
   - identityproviderconfigs
+  - maintenancewindows
   - nodegroups
   - podidentityassociations
   verbs:
@@ -104,7 +125,9 @@ rules:
   - accessentries/status
   - addons/status
   - capabilities/status
+  - clusterregistrations/status
   - clusters/status
+  - eksanywheresubscriptions/status
   - fargateprofiles/status
   - identityproviderconfigs/status
   - nodegroups/status
@@ -0,0 +1,70 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+// Package condition contains helpers for managing the controller specific
+// (non ACK.*) conditions on a resource.
+package condition
+
+import (
+	ackv1alpha1 "github.com/aws-controllers-k8s/runtime/apis/core/v1alpha1"
+	ackcondition "github.com/aws-controllers-k8s/runtime/pkg/condition"
+	acktypes "github.com/aws-controllers-k8s/runtime/pkg/types"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// Set sets the resource's Condition of the supplied type to the supplied
+// status, optional message and reason. If the resource doesn't have a
+// Condition of that type yet, one is added. The LastTransitionTime is only
+// updated when the status of the Condition changes.
+func Set(
+	subject acktypes.ConditionManager,
+	condType ackv1alpha1.ConditionType,
+	status corev1.ConditionStatus,
+	message *string,
+	reason *string,
+) {
+	allConds := subject.Conditions()
+	c := ackcondition.FirstOfType(subject, condType)
+	if c == nil {
+		c = &ackv1alpha1.Condition{
+			Type: condType,
+		}
+		allConds = append(allConds, c)
+	}
+	if c.LastTransitionTime == nil || c.Status != status {
+		now := metav1.Now()
+		c.LastTransitionTime = &now
+	}
+	c.Status = status
+	c.Message = message
+	c.Reason = reason
+	subject.ReplaceConditions(allConds)
+}
+
+// Remove removes all Conditions of the supplied type from the resource.
+func Remove(
+	subject acktypes.ConditionManager,
+	condType ackv1alpha1.ConditionType,
+) {
+	allConds := subject.Conditions()
+	newConds := make([]*ackv1alpha1.Condition, 0, len(allConds))
+	for _, c := range allConds {
+		if c.Type != condType {
+			newConds = append(newConds, c)
+		}
+	}
+	if len(newConds) != len(allConds) {
+		subject.ReplaceConditions(newConds)
+	}
+}
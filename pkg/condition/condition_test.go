@@ -0,0 +1,61 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package condition
+
+import (
+	"testing"
+
+	ackv1alpha1 "github.com/aws-controllers-k8s/runtime/apis/core/v1alpha1"
+	ackcondition "github.com/aws-controllers-k8s/runtime/pkg/condition"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+)
+
+type subject struct {
+	conditions []*ackv1alpha1.Condition
+}
+
+func (s *subject) Conditions() []*ackv1alpha1.Condition {
+	return s.conditions
+}
+
+func (s *subject) ReplaceConditions(conds []*ackv1alpha1.Condition) {
+	s.conditions = conds
+}
+
+func TestSet(t *testing.T) {
+	s := &subject{}
+	Set(s, "Degraded", corev1.ConditionTrue, aws.String("first"), nil)
+	c := ackcondition.FirstOfType(s, "Degraded")
+	require.NotNil(t, c)
+	transition := c.LastTransitionTime
+	require.NotNil(t, transition)
+
+	// An unchanged status keeps the transition time.
+	Set(s, "Degraded", corev1.ConditionTrue, aws.String("second"), aws.String("Reason"))
+	c = ackcondition.FirstOfType(s, "Degraded")
+	assert.Same(t, transition, c.LastTransitionTime)
+	assert.Equal(t, "second", *c.Message)
+	assert.Len(t, s.conditions, 1)
+
+	Set(s, "Degraded", corev1.ConditionFalse, nil, nil)
+	c = ackcondition.FirstOfType(s, "Degraded")
+	assert.NotSame(t, transition, c.LastTransitionTime)
+	assert.Equal(t, corev1.ConditionFalse, c.Status)
+
+	Remove(s, "Degraded")
+	assert.Empty(t, s.conditions)
+}
@@ -0,0 +1,241 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+// Package dryrun lets resource managers report the mutating EKS API calls an
+// update would make, without making them.
+//
+// The update code paths run unchanged against a copy of the EKS client
+// returned by Plan.Client. Read-only calls go through, mutating calls are
+// recorded along with their input and fail with ErrNotSent instead of being
+// sent.
+package dryrun
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+
+	ackcondition "github.com/aws-controllers-k8s/runtime/pkg/condition"
+	acktypes "github.com/aws-controllers-k8s/runtime/pkg/types"
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	svcsdk "github.com/aws/aws-sdk-go-v2/service/eks"
+	"github.com/aws/smithy-go/middleware"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	svcapitypes "github.com/aws-controllers-k8s/eks-controller/apis/v1alpha1"
+	"github.com/aws-controllers-k8s/eks-controller/pkg/condition"
+	"github.com/aws-controllers-k8s/eks-controller/pkg/tags"
+)
+
+// ErrNotSent is returned by the mutating calls of a dry-run client.
+var ErrNotSent = errors.New("dry run, call not sent")
+
+// ignoredInputFields are left out of the recorded inputs. Client request
+// tokens are generated on every call and would make the plan change on
+// every reconcile.
+var ignoredInputFields = map[string]bool{
+	"ClientRequestToken": true,
+}
+
+// Enabled returns true if the dry-run annotation of the supplied custom
+// resource is set to "true".
+func Enabled(obj metav1.Object) bool {
+	v, ok := obj.GetAnnotations()[svcapitypes.DryRunAnnotation]
+	if !ok {
+		return false
+	}
+	enabled, err := strconv.ParseBool(v)
+	return err == nil && enabled
+}
+
+// IsNotSent returns true if the supplied error, or any error it wraps, is
+// ErrNotSent.
+func IsNotSent(err error) bool {
+	return errors.Is(err, ErrNotSent)
+}
+
+// MetricsRecorder records the outcome of the EKS API calls.
+type MetricsRecorder = tags.MetricsRecorder
+
+// Plan records the mutating calls made through its client.
+type Plan struct {
+	mu    sync.Mutex
+	calls []*string
+}
+
+// NewPlan returns an empty plan.
+func NewPlan() *Plan {
+	return &Plan{}
+}
+
+// Client returns a copy of the supplied client whose mutating calls are
+// recorded in the plan instead of being sent.
+func (p *Plan) Client(client *svcsdk.Client) *svcsdk.Client {
+	return svcsdk.New(client.Options(), func(o *svcsdk.Options) {
+		o.APIOptions = append(o.APIOptions, p.addMiddleware)
+	})
+}
+
+// Metrics returns a MetricsRecorder forwarding the calls made through the
+// plan client to the supplied one. The calls intercepted by the plan were
+// never sent and are not recorded.
+func (p *Plan) Metrics(mr MetricsRecorder) MetricsRecorder {
+	return planMetrics{mr}
+}
+
+// planMetrics drops the calls failing with ErrNotSent.
+type planMetrics struct {
+	MetricsRecorder
+}
+
+// RecordAPICall records the supplied call unless it was intercepted.
+func (m planMetrics) RecordAPICall(opType string, opID string, err error) {
+	if IsNotSent(err) {
+		return
+	}
+	m.MetricsRecorder.RecordAPICall(opType, opID, err)
+}
+
+// SyncTags plans the TagResource and UntagResource calls tags.SyncTags
+// would make to bring the existing Tags of the supplied resource to the
+// desired ones. The supplied client must be a plan client: both calls are
+// recorded, where tags.SyncTags stops at the first call not sent.
+func SyncTags(
+	ctx context.Context,
+	client *svcsdk.Client,
+	mr MetricsRecorder,
+	resourceARN string,
+	desiredTags map[string]string,
+	existingTags map[string]string,
+) error {
+	toAdd, toDelete := tags.Diff(desiredTags, existingTags)
+	if len(toAdd) > 0 {
+		if err := tags.AddTags(ctx, client, mr, resourceARN, toAdd); err != nil && !IsNotSent(err) {
+			return err
+		}
+	}
+	if len(toDelete) > 0 {
+		if err := tags.RemoveTags(ctx, client, mr, resourceARN, toDelete); err != nil && !IsNotSent(err) {
+			return err
+		}
+	}
+	return nil
+}
+
+// addMiddleware registers the middleware intercepting the mutating calls.
+// It runs after the operation name is set in the context and before the
+// request is serialized.
+func (p *Plan) addMiddleware(stack *middleware.Stack) error {
+	return stack.Initialize.Add(middleware.InitializeMiddlewareFunc(
+		"DryRun",
+		func(
+			ctx context.Context,
+			in middleware.InitializeInput,
+			next middleware.InitializeHandler,
+		) (middleware.InitializeOutput, middleware.Metadata, error) {
+			operation := awsmiddleware.GetOperationName(ctx)
+			if readOnly(operation) {
+				return next.HandleInitialize(ctx, in)
+			}
+			if err := p.record(operation, in.Parameters); err != nil {
+				return middleware.InitializeOutput{}, middleware.Metadata{}, err
+			}
+			return middleware.InitializeOutput{}, middleware.Metadata{}, ErrNotSent
+		},
+	), middleware.After)
+}
+
+// readOnly returns true if the supplied EKS operation doesn't mutate any
+// resource.
+func readOnly(operation string) bool {
+	return strings.HasPrefix(operation, "Describe") || strings.HasPrefix(operation, "List")
+}
+
+// record adds a call to the plan, in the form "<operation> <input>" where
+// input is the JSON representation of the call input without its unset
+// fields.
+func (p *Plan) record(operation string, input interface{}) error {
+	b, err := json.Marshal(input)
+	if err != nil {
+		return fmt.Errorf("failed to record dry run call %s: %w", operation, err)
+	}
+	var v interface{}
+	if err := json.Unmarshal(b, &v); err != nil {
+		return fmt.Errorf("failed to record dry run call %s: %w", operation, err)
+	}
+	if b, err = json.Marshal(prune(v)); err != nil {
+		return fmt.Errorf("failed to record dry run call %s: %w", operation, err)
+	}
+	call := operation + " " + string(b)
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.calls = append(p.calls, &call)
+	return nil
+}
+
+// prune removes the null values from the supplied JSON document, along with
+// the ignored fields.
+func prune(v interface{}) interface{} {
+	switch t := v.(type) {
+	case map[string]interface{}:
+		for k, e := range t {
+			if e == nil || ignoredInputFields[k] {
+				delete(t, k)
+				continue
+			}
+			t[k] = prune(e)
+		}
+	case []interface{}:
+		for i, e := range t {
+			t[i] = prune(e)
+		}
+	}
+	return v
+}
+
+// Calls returns the recorded calls, in the order they were made.
+func (p *Plan) Calls() []*string {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if len(p.calls) == 0 {
+		return nil
+	}
+	return append([]*string{}, p.calls...)
+}
+
+// Publish stores the recorded calls in the supplied status field and sets
+// the DryRun condition of the supplied resource, marking it as not synced
+// since the planned calls were not sent.
+func (p *Plan) Publish(subject acktypes.ConditionManager, status *[]*string) {
+	calls := p.Calls()
+	*status = calls
+	msg := fmt.Sprintf("Dry run, %d mutating call(s) planned and not sent", len(calls))
+	condition.Set(subject, svcapitypes.ConditionTypeDryRun, corev1.ConditionTrue, &msg, nil)
+	if len(calls) > 0 {
+		ackcondition.SetSynced(subject, corev1.ConditionFalse, &msg, nil)
+	}
+}
+
+// Reset removes the plan stored in the supplied status field and the DryRun
+// condition from the supplied resource. The plan of a previous update is
+// stale once the resource is read again.
+func Reset(subject acktypes.ConditionManager, status *[]*string) {
+	*status = nil
+	condition.Remove(subject, svcapitypes.ConditionTypeDryRun)
+}
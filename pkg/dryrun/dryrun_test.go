@@ -0,0 +1,178 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package dryrun
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	ackv1alpha1 "github.com/aws-controllers-k8s/runtime/apis/core/v1alpha1"
+	ackcondition "github.com/aws-controllers-k8s/runtime/pkg/condition"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	svcsdk "github.com/aws/aws-sdk-go-v2/service/eks"
+	svcsdktypes "github.com/aws/aws-sdk-go-v2/service/eks/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	svcapitypes "github.com/aws-controllers-k8s/eks-controller/apis/v1alpha1"
+)
+
+type conditions struct {
+	conds []*ackv1alpha1.Condition
+}
+
+func (c *conditions) Conditions() []*ackv1alpha1.Condition {
+	return c.conds
+}
+
+func (c *conditions) ReplaceConditions(conds []*ackv1alpha1.Condition) {
+	c.conds = conds
+}
+
+// httpClient fails every request it is given, and counts them.
+type httpClient struct {
+	requests int
+}
+
+func (c *httpClient) Do(*http.Request) (*http.Response, error) {
+	c.requests++
+	return nil, errors.New("no network in tests")
+}
+
+func TestEnabled(t *testing.T) {
+	for value, want := range map[string]bool{
+		"true":  true,
+		"True":  true,
+		"false": false,
+		"yes":   false,
+		"":      false,
+	} {
+		obj := &metav1.ObjectMeta{Annotations: map[string]string{svcapitypes.DryRunAnnotation: value}}
+		assert.Equal(t, want, Enabled(obj), value)
+	}
+	assert.False(t, Enabled(&metav1.ObjectMeta{}))
+}
+
+func TestPlanClient(t *testing.T) {
+	hc := &httpClient{}
+	client := svcsdk.New(svcsdk.Options{
+		Region:           "us-west-2",
+		Credentials:      aws.AnonymousCredentials{},
+		HTTPClient:       hc,
+		RetryMaxAttempts: 1,
+	})
+	plan := NewPlan()
+	dry := plan.Client(client)
+
+	_, err := dry.UpdateNodegroupConfig(context.TODO(), &svcsdk.UpdateNodegroupConfigInput{
+		ClusterName:   aws.String("my-cluster"),
+		NodegroupName: aws.String("my-nodegroup"),
+		ScalingConfig: &svcsdktypes.NodegroupScalingConfig{MinSize: aws.Int32(1), MaxSize: aws.Int32(3)},
+	})
+	assert.True(t, IsNotSent(err))
+	_, err = dry.UntagResource(context.TODO(), &svcsdk.UntagResourceInput{
+		ResourceArn: aws.String("arn"),
+		TagKeys:     []string{"team"},
+	})
+	assert.True(t, IsNotSent(err))
+	assert.Equal(t, 0, hc.requests)
+
+	// Read-only calls are sent.
+	_, err = dry.DescribeCluster(context.TODO(), &svcsdk.DescribeClusterInput{Name: aws.String("my-cluster")})
+	assert.Error(t, err)
+	assert.False(t, IsNotSent(err))
+	assert.Positive(t, hc.requests)
+
+	calls := plan.Calls()
+	require.Len(t, calls, 2)
+	assert.Equal(t,
+		`UpdateNodegroupConfig {"ClusterName":"my-cluster","NodegroupName":"my-nodegroup","ScalingConfig":{"MaxSize":3,"MinSize":1}}`,
+		*calls[0],
+	)
+	assert.Equal(t, `UntagResource {"ResourceArn":"arn","TagKeys":["team"]}`, *calls[1])
+
+	// The original client is left untouched.
+	_, err = client.UpdateNodegroupConfig(context.TODO(), &svcsdk.UpdateNodegroupConfigInput{
+		ClusterName:   aws.String("my-cluster"),
+		NodegroupName: aws.String("my-nodegroup"),
+	})
+	assert.False(t, IsNotSent(err))
+	assert.Len(t, plan.Calls(), 2)
+}
+
+func TestPublish(t *testing.T) {
+	subject := &conditions{}
+	var status []*string
+	NewPlan().Publish(subject, &status)
+	assert.Nil(t, status)
+	cond := ackcondition.FirstOfType(subject, svcapitypes.ConditionTypeDryRun)
+	require.NotNil(t, cond)
+	assert.Equal(t, corev1.ConditionTrue, cond.Status)
+	assert.Nil(t, ackcondition.Synced(subject))
+
+	plan := NewPlan()
+	require.NoError(t, plan.record("TagResource", &svcsdk.TagResourceInput{ResourceArn: aws.String("arn")}))
+	plan.Publish(subject, &status)
+	require.Len(t, status, 1)
+	assert.Equal(t, `TagResource {"ResourceArn":"arn"}`, *status[0])
+	synced := ackcondition.Synced(subject)
+	require.NotNil(t, synced)
+	assert.Equal(t, corev1.ConditionFalse, synced.Status)
+	assert.Contains(t, *synced.Message, "1 mutating call(s)")
+
+	Reset(subject, &status)
+	assert.Nil(t, status)
+	assert.Nil(t, ackcondition.FirstOfType(subject, svcapitypes.ConditionTypeDryRun))
+}
+
+// metrics counts the recorded calls.
+type metrics struct {
+	calls []string
+}
+
+func (m *metrics) RecordAPICall(opType string, opID string, err error) {
+	m.calls = append(m.calls, opID)
+}
+
+func TestSyncTags(t *testing.T) {
+	hc := &httpClient{}
+	plan := NewPlan()
+	dry := plan.Client(svcsdk.New(svcsdk.Options{
+		Region:           "us-west-2",
+		Credentials:      aws.AnonymousCredentials{},
+		HTTPClient:       hc,
+		RetryMaxAttempts: 1,
+	}))
+	mr := &metrics{}
+
+	require.NoError(t, SyncTags(
+		context.TODO(), dry, plan.Metrics(mr), "arn",
+		map[string]string{"team": "b"},
+		map[string]string{"team": "a", "env": "dev"},
+	))
+	calls := plan.Calls()
+	require.Len(t, calls, 2)
+	assert.Equal(t, `TagResource {"ResourceArn":"arn","Tags":{"team":"b"}}`, *calls[0])
+	assert.Equal(t, `UntagResource {"ResourceArn":"arn","TagKeys":["env"]}`, *calls[1])
+	assert.Equal(t, 0, hc.requests)
+	// The calls not sent are not recorded in the metrics.
+	assert.Empty(t, mr.calls)
+
+	plan.Metrics(mr).RecordAPICall("READ_ONE", "DescribeCluster", errors.New("boom"))
+	assert.Equal(t, []string{"DescribeCluster"}, mr.calls)
+}
@@ -0,0 +1,280 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+// Package health maps the health issues EKS reports for clusters, node
+// groups, add-ons, Fargate profiles and capabilities to conditions of their
+// custom resources.
+//
+// While a resource has health issues, its Degraded condition is True, with the
+// issue code as reason, and each issue code gets a condition of its own, whose
+// type is the issue code and whose message lists the affected resources. The
+// conditions are removed once EKS no longer reports the issues. A Kubernetes
+// Event is emitted whenever an issue is raised or resolved.
+package health
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	ackv1alpha1 "github.com/aws-controllers-k8s/runtime/apis/core/v1alpha1"
+	ackcondition "github.com/aws-controllers-k8s/runtime/pkg/condition"
+	ackrtlog "github.com/aws-controllers-k8s/runtime/pkg/runtime/log"
+	acktypes "github.com/aws-controllers-k8s/runtime/pkg/types"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	corev1 "k8s.io/api/core/v1"
+	ctrlrtclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	svcapitypes "github.com/aws-controllers-k8s/eks-controller/apis/v1alpha1"
+	"github.com/aws-controllers-k8s/eks-controller/pkg/condition"
+	"github.com/aws-controllers-k8s/eks-controller/pkg/kube"
+)
+
+const (
+	// IssueConditionReason is the reason of the per-issue conditions. It
+	// tells them apart from the other conditions of a resource.
+	IssueConditionReason = "HealthIssue"
+	// ReasonMultipleIssues is the reason of the Degraded condition of a
+	// resource with issues of several codes.
+	ReasonMultipleIssues = "MultipleIssues"
+	// EventReasonIssueResolved is the reason of the Event emitted when an
+	// issue is no longer reported. The Event emitted when an issue is raised
+	// has the issue code as reason.
+	EventReasonIssueResolved = "HealthIssueResolved"
+)
+
+// Issue is a health issue of an EKS resource.
+type Issue struct {
+	// Code is the issue code, such as AsgInstanceLaunchFailures.
+	Code string
+	// Message describes the issue.
+	Message string
+	// ResourceIDs are the IDs of the resources affected by the issue.
+	ResourceIDs []string
+}
+
+// ClusterIssues returns the issues of the supplied cluster health.
+func ClusterIssues(h *svcapitypes.ClusterHealth) []Issue {
+	if h == nil {
+		return nil
+	}
+	issues := make([]Issue, 0, len(h.Issues))
+	for _, i := range h.Issues {
+		if i != nil {
+			issues = append(issues, newIssue(i.Code, i.Message, i.ResourceIDs))
+		}
+	}
+	return issues
+}
+
+// NodegroupIssues returns the issues of the supplied node group health.
+func NodegroupIssues(h *svcapitypes.NodegroupHealth) []Issue {
+	if h == nil {
+		return nil
+	}
+	issues := make([]Issue, 0, len(h.Issues))
+	for _, i := range h.Issues {
+		if i != nil {
+			issues = append(issues, newIssue(i.Code, i.Message, i.ResourceIDs))
+		}
+	}
+	return issues
+}
+
+// AddonIssues returns the issues of the supplied add-on health.
+func AddonIssues(h *svcapitypes.AddonHealth) []Issue {
+	if h == nil {
+		return nil
+	}
+	issues := make([]Issue, 0, len(h.Issues))
+	for _, i := range h.Issues {
+		if i != nil {
+			issues = append(issues, newIssue(i.Code, i.Message, i.ResourceIDs))
+		}
+	}
+	return issues
+}
+
+// FargateProfileIssues returns the issues of the supplied Fargate profile
+// health.
+func FargateProfileIssues(h *svcapitypes.FargateProfileHealth) []Issue {
+	if h == nil {
+		return nil
+	}
+	issues := make([]Issue, 0, len(h.Issues))
+	for _, i := range h.Issues {
+		if i != nil {
+			issues = append(issues, newIssue(i.Code, i.Message, i.ResourceIDs))
+		}
+	}
+	return issues
+}
+
+// CapabilityIssues returns the issues of the supplied capability health.
+// Capability issues have no affected resources.
+func CapabilityIssues(h *svcapitypes.CapabilityHealth) []Issue {
+	if h == nil {
+		return nil
+	}
+	issues := make([]Issue, 0, len(h.Issues))
+	for _, i := range h.Issues {
+		if i != nil {
+			issues = append(issues, newIssue(i.Code, i.Message, nil))
+		}
+	}
+	return issues
+}
+
+func newIssue(code, message *string, resourceIDs []*string) Issue {
+	issue := Issue{
+		Code:    aws.ToString(code),
+		Message: aws.ToString(message),
+	}
+	if len(resourceIDs) > 0 {
+		issue.ResourceIDs = aws.ToStringSlice(resourceIDs)
+	}
+	return issue
+}
+
+// SyncConditions sets the Degraded and per-issue conditions of the supplied
+// subject from the supplied issues, and removes the conditions of the issues
+// that are gone. It emits an Event about the supplied object for every issue
+// raised or resolved. Conditions that don't change are left untouched, so
+// that their last transition time is kept.
+func SyncConditions(
+	ctx context.Context,
+	subject acktypes.ConditionManager,
+	object ctrlrtclient.Object,
+	issues []Issue,
+) {
+	merged := mergeIssues(issues)
+	codes := make([]string, 0, len(merged))
+	for code := range merged {
+		codes = append(codes, code)
+	}
+	sort.Strings(codes)
+
+	for _, c := range subject.Conditions() {
+		if c.Reason == nil || *c.Reason != IssueConditionReason {
+			continue
+		}
+		code := string(c.Type)
+		if _, found := merged[code]; found {
+			continue
+		}
+		condition.Remove(subject, c.Type)
+		recordEvent(ctx, object, corev1.EventTypeNormal, EventReasonIssueResolved,
+			fmt.Sprintf("Health issue %s is resolved", code))
+	}
+
+	summaries := make([]string, 0, len(codes))
+	for _, code := range codes {
+		issue := merged[code]
+		message := formatIssue(issue)
+		summaries = append(summaries, code+": "+message)
+		condType := ackv1alpha1.ConditionType(code)
+		if ackcondition.FirstOfType(subject, condType) == nil {
+			recordEvent(ctx, object, corev1.EventTypeWarning, code, message)
+		}
+		setIfChanged(subject, condType, message, IssueConditionReason)
+	}
+
+	if len(codes) == 0 {
+		condition.Remove(subject, svcapitypes.ConditionTypeDegraded)
+		return
+	}
+	reason := codes[0]
+	if len(codes) > 1 {
+		reason = ReasonMultipleIssues
+	}
+	setIfChanged(subject, svcapitypes.ConditionTypeDegraded, strings.Join(summaries, "; "), reason)
+}
+
+// mergeIssues merges the supplied issues by code. Issues without a code are
+// ignored.
+func mergeIssues(issues []Issue) map[string]Issue {
+	merged := map[string]Issue{}
+	for _, issue := range issues {
+		if issue.Code == "" {
+			continue
+		}
+		m, found := merged[issue.Code]
+		if !found {
+			m = Issue{Code: issue.Code}
+		}
+		if issue.Message != "" && !strings.Contains(m.Message, issue.Message) {
+			if m.Message != "" {
+				m.Message += "; "
+			}
+			m.Message += issue.Message
+		}
+		for _, id := range issue.ResourceIDs {
+			if !slices.Contains(m.ResourceIDs, id) {
+				m.ResourceIDs = append(m.ResourceIDs, id)
+			}
+		}
+		merged[issue.Code] = m
+	}
+	for code, m := range merged {
+		sort.Strings(m.ResourceIDs)
+		merged[code] = m
+	}
+	return merged
+}
+
+// formatIssue returns the message of the supplied issue followed by the
+// resources it affects.
+func formatIssue(issue Issue) string {
+	message := issue.Message
+	if message == "" {
+		message = issue.Code
+	}
+	if len(issue.ResourceIDs) > 0 {
+		message += " (affected resources: " + strings.Join(issue.ResourceIDs, ", ") + ")"
+	}
+	return message
+}
+
+// setIfChanged sets the supplied condition to True, unless it is already True
+// with the same message and reason.
+func setIfChanged(
+	subject acktypes.ConditionManager,
+	condType ackv1alpha1.ConditionType,
+	message string,
+	reason string,
+) {
+	c := ackcondition.FirstOfType(subject, condType)
+	if c != nil && c.Status == corev1.ConditionTrue &&
+		aws.ToString(c.Message) == message && aws.ToString(c.Reason) == reason {
+		return
+	}
+	condition.Set(subject, condType, corev1.ConditionTrue, &message, &reason)
+}
+
+// recordEvent records an Event about the supplied object. Events are best
+// effort, failing to record one doesn't fail the reconciliation.
+func recordEvent(
+	ctx context.Context,
+	object ctrlrtclient.Object,
+	eventType string,
+	reason string,
+	message string,
+) {
+	if err := kube.RecordEvent(ctx, object, eventType, reason, message); err != nil {
+		ackrtlog.FromContext(ctx).Debug(
+			"unable to record event", "reason", reason, "error", err.Error(),
+		)
+	}
+}
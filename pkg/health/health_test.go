@@ -0,0 +1,163 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package health
+
+import (
+	"context"
+	"testing"
+
+	ackv1alpha1 "github.com/aws-controllers-k8s/runtime/apis/core/v1alpha1"
+	ackcondition "github.com/aws-controllers-k8s/runtime/pkg/condition"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	ctrlrtclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	svcapitypes "github.com/aws-controllers-k8s/eks-controller/apis/v1alpha1"
+	"github.com/aws-controllers-k8s/eks-controller/pkg/kube"
+)
+
+// nodegroup is a condition manager over a Nodegroup custom resource.
+type nodegroup struct {
+	*svcapitypes.Nodegroup
+}
+
+func (n nodegroup) Conditions() []*ackv1alpha1.Condition {
+	return n.Status.Conditions
+}
+
+func (n nodegroup) ReplaceConditions(conds []*ackv1alpha1.Condition) {
+	n.Status.Conditions = conds
+}
+
+func newClient(t *testing.T) ctrlrtclient.Client {
+	scheme := runtime.NewScheme()
+	require.NoError(t, clientgoscheme.AddToScheme(scheme))
+	require.NoError(t, svcapitypes.AddToScheme(scheme))
+	kc := fake.NewClientBuilder().WithScheme(scheme).Build()
+	kube.SetClient(kc)
+	return kc
+}
+
+func eventReasons(t *testing.T, kc ctrlrtclient.Client) []string {
+	events := &corev1.EventList{}
+	require.NoError(t, kc.List(context.Background(), events))
+	reasons := []string{}
+	for _, e := range events.Items {
+		assert.Equal(t, "Nodegroup", e.InvolvedObject.Kind)
+		assert.Equal(t, "workers", e.InvolvedObject.Name)
+		reasons = append(reasons, e.Type+"/"+e.Reason)
+	}
+	return reasons
+}
+
+func TestNodegroupIssues(t *testing.T) {
+	assert.Nil(t, NodegroupIssues(nil))
+	assert.Equal(t, []Issue{{
+		Code:        "AsgInstanceLaunchFailures",
+		Message:     "Instances failed to launch",
+		ResourceIDs: []string{"eks-workers-asg"},
+	}}, NodegroupIssues(&svcapitypes.NodegroupHealth{Issues: []*svcapitypes.Issue{{
+		Code:        aws.String("AsgInstanceLaunchFailures"),
+		Message:     aws.String("Instances failed to launch"),
+		ResourceIDs: []*string{aws.String("eks-workers-asg")},
+	}}}))
+	assert.Equal(t, []Issue{{Code: "AccessDenied", Message: "denied"}},
+		CapabilityIssues(&svcapitypes.CapabilityHealth{Issues: []*svcapitypes.CapabilityIssue{{
+			Code:    aws.String("AccessDenied"),
+			Message: aws.String("denied"),
+		}}}))
+}
+
+func TestSyncConditions(t *testing.T) {
+	kc := newClient(t)
+	ctx := context.Background()
+	subject := nodegroup{&svcapitypes.Nodegroup{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "infra", Name: "workers"},
+	}}
+	reason := func(condType ackv1alpha1.ConditionType) string {
+		c := ackcondition.FirstOfType(subject, condType)
+		if c == nil {
+			return ""
+		}
+		return aws.ToString(c.Reason)
+	}
+	message := func(condType ackv1alpha1.ConditionType) string {
+		c := ackcondition.FirstOfType(subject, condType)
+		require.NotNil(t, c)
+		return aws.ToString(c.Message)
+	}
+
+	// A healthy resource has no Degraded condition.
+	SyncConditions(ctx, subject, subject.Nodegroup, nil)
+	assert.Empty(t, subject.Conditions())
+	assert.Empty(t, eventReasons(t, kc))
+
+	// An issue raises the Degraded condition and a condition of its own.
+	launchFailures := Issue{
+		Code:        "AsgInstanceLaunchFailures",
+		Message:     "Instances failed to launch",
+		ResourceIDs: []string{"asg-b"},
+	}
+	SyncConditions(ctx, subject, subject.Nodegroup, []Issue{
+		launchFailures,
+		{Code: "AsgInstanceLaunchFailures", Message: "Instances failed to launch", ResourceIDs: []string{"asg-a"}},
+	})
+	assert.Equal(t, "AsgInstanceLaunchFailures", reason(svcapitypes.ConditionTypeDegraded))
+	assert.Equal(t, IssueConditionReason, reason("AsgInstanceLaunchFailures"))
+	assert.Equal(t, "Instances failed to launch (affected resources: asg-a, asg-b)", message("AsgInstanceLaunchFailures"))
+	assert.Equal(t,
+		"AsgInstanceLaunchFailures: Instances failed to launch (affected resources: asg-a, asg-b)",
+		message(svcapitypes.ConditionTypeDegraded),
+	)
+	assert.ElementsMatch(t, []string{"Warning/AsgInstanceLaunchFailures"}, eventReasons(t, kc))
+
+	// An unchanged issue keeps its conditions and emits no Event.
+	transition := ackcondition.FirstOfType(subject, "AsgInstanceLaunchFailures").LastTransitionTime
+	SyncConditions(ctx, subject, subject.Nodegroup, []Issue{
+		{Code: "AsgInstanceLaunchFailures", Message: "Instances failed to launch", ResourceIDs: []string{"asg-a", "asg-b"}},
+	})
+	assert.Same(t, transition, ackcondition.FirstOfType(subject, "AsgInstanceLaunchFailures").LastTransitionTime)
+	assert.Len(t, eventReasons(t, kc), 1)
+
+	// Several issue codes.
+	SyncConditions(ctx, subject, subject.Nodegroup, []Issue{
+		launchFailures,
+		{Code: "Ec2SubnetInvalidConfiguration", Message: "Subnet has no route", ResourceIDs: []string{"subnet-1"}},
+	})
+	assert.Equal(t, ReasonMultipleIssues, reason(svcapitypes.ConditionTypeDegraded))
+	assert.Equal(t, IssueConditionReason, reason("Ec2SubnetInvalidConfiguration"))
+	assert.ElementsMatch(t, []string{
+		"Warning/AsgInstanceLaunchFailures",
+		"Warning/Ec2SubnetInvalidConfiguration",
+	}, eventReasons(t, kc))
+
+	// A resolved issue is cleared.
+	SyncConditions(ctx, subject, subject.Nodegroup, []Issue{launchFailures})
+	assert.Equal(t, "AsgInstanceLaunchFailures", reason(svcapitypes.ConditionTypeDegraded))
+	assert.Nil(t, ackcondition.FirstOfType(subject, "Ec2SubnetInvalidConfiguration"))
+	assert.Contains(t, eventReasons(t, kc), "Normal/"+EventReasonIssueResolved)
+
+	// Other conditions are left untouched when the resource recovers.
+	ackcondition.SetSynced(subject, corev1.ConditionTrue, nil, nil)
+	SyncConditions(ctx, subject, subject.Nodegroup, nil)
+	require.Len(t, subject.Conditions(), 1)
+	assert.Equal(t, ackv1alpha1.ConditionTypeResourceSynced, subject.Conditions()[0].Type)
+	assert.Len(t, eventReasons(t, kc), 4)
+}
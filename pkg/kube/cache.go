@@ -0,0 +1,147 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package kube
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"sync"
+	"syscall"
+	"time"
+
+	ackcfg "github.com/aws-controllers-k8s/runtime/pkg/config"
+	ctrlrt "sigs.k8s.io/controller-runtime"
+	ctrlrtcache "sigs.k8s.io/controller-runtime/pkg/cache"
+	ctrlrtclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// cacheSyncTimeout bounds how long a read from the cache returned by
+// ClusterCache waits for the informer of the type read to sync, so that a
+// cache which can't sync fails the reconcile instead of stalling it.
+const cacheSyncTimeout = 30 * time.Second
+
+var (
+	cacheOnce   sync.Once
+	cacheReader ctrlrtclient.Reader
+	cacheErr    error
+)
+
+// ClusterCache returns a reader of the informer cache holding the custom
+// resources that reference a Cluster, indexed on ClusterIndexField. Like the
+// cache of the controller manager, it only watches the namespaces the
+// controller is configured to watch, which the RBAC rules of the controller
+// allow. The cache is built and started on the first call, and stopped on the
+// termination signals that stop the controller manager.
+func ClusterCache(cfg ackcfg.Config) (ctrlrtclient.Reader, error) {
+	cacheOnce.Do(func() {
+		cacheReader, cacheErr = newClusterCache(cfg)
+	})
+	return cacheReader, cacheErr
+}
+
+// newClusterCache builds and starts the cache returned by ClusterCache.
+func newClusterCache(cfg ackcfg.Config) (ctrlrtclient.Reader, error) {
+	restCfg, err := ctrlrt.GetConfig()
+	if err != nil {
+		return nil, err
+	}
+	scheme, err := newScheme()
+	if err != nil {
+		return nil, err
+	}
+	namespaces, err := cfg.GetWatchNamespaces()
+	if err != nil {
+		return nil, err
+	}
+	opts := ctrlrtcache.Options{Scheme: scheme}
+	if len(namespaces) > 0 {
+		opts.DefaultNamespaces = map[string]ctrlrtcache.Config{}
+		for _, ns := range namespaces {
+			opts.DefaultNamespaces[ns] = ctrlrtcache.Config{}
+		}
+	}
+	cache, err := ctrlrtcache.New(restCfg, opts)
+	if err != nil {
+		return nil, err
+	}
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	for _, obj := range ClusterChildren() {
+		if err := cache.IndexField(ctx, obj, ClusterIndexField, ClusterIndexValues); err != nil {
+			stop()
+			return nil, err
+		}
+	}
+	go func() {
+		defer stop()
+		if err := cache.Start(ctx); err != nil {
+			ctrlrt.Log.WithName("kube").Error(err, "cluster cache stopped")
+		}
+	}()
+	return &syncTimeoutReader{Reader: cache}, nil
+}
+
+// SetClusterCache overrides the reader returned by ClusterCache. It is meant
+// to be used by tests, with a fake client.
+func SetClusterCache(r ctrlrtclient.Reader) {
+	cacheOnce.Do(func() {})
+	cacheReader = r
+	cacheErr = nil
+}
+
+// syncTimeoutReader bounds the reads of an informer cache by
+// cacheSyncTimeout. The informer of a type is started, and synced, by the
+// first read of that type.
+type syncTimeoutReader struct {
+	ctrlrtclient.Reader
+}
+
+// Get implements ctrlrtclient.Reader.
+func (r *syncTimeoutReader) Get(
+	ctx context.Context,
+	key ctrlrtclient.ObjectKey,
+	obj ctrlrtclient.Object,
+	opts ...ctrlrtclient.GetOption,
+) error {
+	ctx, cancel := context.WithTimeout(ctx, cacheSyncTimeout)
+	defer cancel()
+	if err := r.Reader.Get(ctx, key, obj, opts...); err != nil {
+		return syncTimeoutError(ctx, err)
+	}
+	return nil
+}
+
+// List implements ctrlrtclient.Reader.
+func (r *syncTimeoutReader) List(
+	ctx context.Context,
+	list ctrlrtclient.ObjectList,
+	opts ...ctrlrtclient.ListOption,
+) error {
+	ctx, cancel := context.WithTimeout(ctx, cacheSyncTimeout)
+	defer cancel()
+	if err := r.Reader.List(ctx, list, opts...); err != nil {
+		return syncTimeoutError(ctx, err)
+	}
+	return nil
+}
+
+// syncTimeoutError explains err when it is caused by the informer cache not
+// syncing in time.
+func syncTimeoutError(ctx context.Context, err error) error {
+	if ctx.Err() == context.DeadlineExceeded {
+		return fmt.Errorf("cluster cache not synced after %s, check the RBAC rules of the controller: %w", cacheSyncTimeout, err)
+	}
+	return err
+}
@@ -0,0 +1,77 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+// Package kube provides the Kubernetes API client used by the resource
+// managers for the few operations the ACK runtime doesn't expose, such as
+// looking up other custom resources of the controller or managing Secrets.
+package kube
+
+import (
+	"sync"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	ctrlrt "sigs.k8s.io/controller-runtime"
+	ctrlrtclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	svcapitypes "github.com/aws-controllers-k8s/eks-controller/apis/v1alpha1"
+)
+
+var (
+	clientOnce sync.Once
+	client     ctrlrtclient.Client
+	clientErr  error
+)
+
+// Client returns a Kubernetes API client able to read and write the core
+// Kubernetes types and the custom resources of this controller. The client
+// is built lazily, from the same configuration as the controller manager, and
+// is not backed by the manager's cache. Lists of custom resources go through
+// ClusterCache instead.
+func Client() (ctrlrtclient.Client, error) {
+	clientOnce.Do(func() {
+		cfg, err := ctrlrt.GetConfig()
+		if err != nil {
+			clientErr = err
+			return
+		}
+		scheme, err := newScheme()
+		if err != nil {
+			clientErr = err
+			return
+		}
+		client, clientErr = ctrlrtclient.New(cfg, ctrlrtclient.Options{Scheme: scheme})
+	})
+	return client, clientErr
+}
+
+// newScheme returns a scheme holding the core Kubernetes types and the custom
+// resources of this controller.
+func newScheme() (*runtime.Scheme, error) {
+	scheme := runtime.NewScheme()
+	if err := clientgoscheme.AddToScheme(scheme); err != nil {
+		return nil, err
+	}
+	if err := svcapitypes.AddToScheme(scheme); err != nil {
+		return nil, err
+	}
+	return scheme, nil
+}
+
+// SetClient overrides the client returned by Client. It is meant to be used
+// by tests, with a fake client.
+func SetClient(c ctrlrtclient.Client) {
+	clientOnce.Do(func() {})
+	client = c
+	clientErr = nil
+}
@@ -0,0 +1,186 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package kube
+
+import (
+	"context"
+
+	ackv1alpha1 "github.com/aws-controllers-k8s/runtime/apis/core/v1alpha1"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrlrtclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	svcapitypes "github.com/aws-controllers-k8s/eks-controller/apis/v1alpha1"
+)
+
+// ClusterIndexField is the field index of the custom resources belonging to
+// an EKS cluster, see ClusterIndexValues.
+const ClusterIndexField = "clusterIndex"
+
+// ClusterChildren returns an object of each custom resource kind belonging to
+// an EKS cluster, which are indexed on ClusterIndexField.
+func ClusterChildren() []ctrlrtclient.Object {
+	return []ctrlrtclient.Object{
+		&svcapitypes.AccessEntry{},
+		&svcapitypes.Addon{},
+		&svcapitypes.FargateProfile{},
+		&svcapitypes.Nodegroup{},
+		&svcapitypes.PodIdentityAssociation{},
+	}
+}
+
+// clusterOf returns the clusterName and clusterRef fields and the resource
+// metadata of the supplied custom resource, and false if it is not of a kind
+// belonging to a cluster.
+func clusterOf(obj ctrlrtclient.Object) (
+	*string, *ackv1alpha1.AWSResourceReferenceWrapper, *ackv1alpha1.ResourceMetadata, bool,
+) {
+	switch o := obj.(type) {
+	case *svcapitypes.AccessEntry:
+		return o.Spec.ClusterName, o.Spec.ClusterRef, o.Status.ACKResourceMetadata, true
+	case *svcapitypes.Addon:
+		return o.Spec.ClusterName, o.Spec.ClusterRef, o.Status.ACKResourceMetadata, true
+	case *svcapitypes.FargateProfile:
+		return o.Spec.ClusterName, o.Spec.ClusterRef, o.Status.ACKResourceMetadata, true
+	case *svcapitypes.Nodegroup:
+		return o.Spec.ClusterName, o.Spec.ClusterRef, o.Status.ACKResourceMetadata, true
+	case *svcapitypes.PodIdentityAssociation:
+		return o.Spec.ClusterName, o.Spec.ClusterRef, o.Status.ACKResourceMetadata, true
+	}
+	return nil, nil, nil, false
+}
+
+// ClusterIndexKey returns the key under which a custom resource living in the
+// supplied namespace is indexed on ClusterIndexField: the name of its EKS
+// cluster, or the namespaced name of the Cluster custom resource its
+// clusterRef points to. It returns an empty string if the resource has
+// neither.
+func ClusterIndexKey(
+	namespace string,
+	clusterName *string,
+	clusterRef *ackv1alpha1.AWSResourceReferenceWrapper,
+) string {
+	if clusterName != nil {
+		return "name/" + *clusterName
+	}
+	if clusterRef == nil || clusterRef.From == nil || clusterRef.From.Name == nil {
+		return ""
+	}
+	if clusterRef.From.Namespace != nil && *clusterRef.From.Namespace != "" {
+		namespace = *clusterRef.From.Namespace
+	}
+	return "ref/" + namespace + "/" + *clusterRef.From.Name
+}
+
+// ClusterIndexValues returns the ClusterIndexField values of the supplied
+// custom resource. It is meant to be used as the index function of the field.
+func ClusterIndexValues(obj ctrlrtclient.Object) []string {
+	clusterName, clusterRef, _, ok := clusterOf(obj)
+	if !ok {
+		return nil
+	}
+	if key := ClusterIndexKey(obj.GetNamespace(), clusterName, clusterRef); key != "" {
+		return []string{key}
+	}
+	return nil
+}
+
+// ListClusterChildren returns the custom resources of the kind of the
+// supplied list which belong to the supplied Cluster, as per
+// ReferencesCluster. They are looked up on ClusterIndexField.
+func ListClusterChildren(
+	ctx context.Context,
+	kc ctrlrtclient.Reader,
+	cluster *svcapitypes.Cluster,
+	newList func() ctrlrtclient.ObjectList,
+) ([]ctrlrtclient.Object, error) {
+	keys := []string{ClusterIndexKey(cluster.Namespace, nil, &ackv1alpha1.AWSResourceReferenceWrapper{
+		From: &ackv1alpha1.AWSResourceReference{Name: &cluster.Name},
+	})}
+	if cluster.Spec.Name != nil {
+		keys = append(keys, ClusterIndexKey("", cluster.Spec.Name, nil))
+	}
+	objs := []ctrlrtclient.Object{}
+	for _, key := range keys {
+		list := newList()
+		if err := kc.List(ctx, list, ctrlrtclient.MatchingFields{ClusterIndexField: key}); err != nil {
+			return nil, err
+		}
+		items, err := meta.ExtractList(list)
+		if err != nil {
+			return nil, err
+		}
+		for _, item := range items {
+			obj, ok := item.(ctrlrtclient.Object)
+			if !ok {
+				continue
+			}
+			if ReferencesCluster(cluster, obj) {
+				objs = append(objs, obj)
+			}
+		}
+	}
+	return objs, nil
+}
+
+// ReferencesCluster returns true if the supplied custom resource belongs to
+// the supplied Cluster, either because its clusterRef points to the Cluster
+// custom resource, or because its clusterName is the name of the EKS cluster
+// and it lives in the same AWS account and region, see SameAccountAndRegion.
+func ReferencesCluster(cluster *svcapitypes.Cluster, obj ctrlrtclient.Object) bool {
+	clusterName, clusterRef, metadata, ok := clusterOf(obj)
+	if !ok {
+		return false
+	}
+	if clusterName != nil && cluster.Spec.Name != nil {
+		return *clusterName == *cluster.Spec.Name &&
+			SameAccountAndRegion(cluster.Status.ACKResourceMetadata, obj, metadata)
+	}
+	if clusterRef == nil || clusterRef.From == nil || clusterRef.From.Name == nil {
+		return false
+	}
+	refNamespace := obj.GetNamespace()
+	if clusterRef.From.Namespace != nil && *clusterRef.From.Namespace != "" {
+		refNamespace = *clusterRef.From.Namespace
+	}
+	return *clusterRef.From.Name == cluster.Name && refNamespace == cluster.Namespace
+}
+
+// SameAccountAndRegion returns true if the supplied custom resource, with
+// the supplied resource metadata, lives in the AWS account and region of the
+// supplied reference metadata. The account and region of the resource are
+// read from its metadata, which the controller sets once it reconciled the
+// resource, or from its owner account ID and region annotations. It returns
+// false when any of them is unknown.
+func SameAccountAndRegion(
+	reference *ackv1alpha1.ResourceMetadata,
+	obj metav1.Object,
+	metadata *ackv1alpha1.ResourceMetadata,
+) bool {
+	if reference == nil || aws.ToString((*string)(reference.OwnerAccountID)) == "" ||
+		aws.ToString((*string)(reference.Region)) == "" {
+		return false
+	}
+	annotations := obj.GetAnnotations()
+	accountID := annotations[ackv1alpha1.AnnotationOwnerAccountID]
+	region := annotations[ackv1alpha1.AnnotationRegion]
+	if metadata != nil && metadata.OwnerAccountID != nil {
+		accountID = string(*metadata.OwnerAccountID)
+	}
+	if metadata != nil && metadata.Region != nil {
+		region = string(*metadata.Region)
+	}
+	return accountID == string(*reference.OwnerAccountID) && region == string(*reference.Region)
+}
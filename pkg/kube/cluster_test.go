@@ -0,0 +1,142 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package kube
+
+import (
+	"context"
+	"testing"
+
+	ackv1alpha1 "github.com/aws-controllers-k8s/runtime/apis/core/v1alpha1"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrlrtclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	svcapitypes "github.com/aws-controllers-k8s/eks-controller/apis/v1alpha1"
+)
+
+// clusterMetadata returns the resource metadata of a resource living in the
+// supplied AWS account and region.
+func clusterMetadata(accountID, region string) *ackv1alpha1.ResourceMetadata {
+	id := ackv1alpha1.AWSAccountID(accountID)
+	r := ackv1alpha1.AWSRegion(region)
+	return &ackv1alpha1.ResourceMetadata{OwnerAccountID: &id, Region: &r}
+}
+
+func TestReferencesCluster(t *testing.T) {
+	cluster := &svcapitypes.Cluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "my-cluster-cr",
+			Namespace: "infra",
+		},
+		Spec: svcapitypes.ClusterSpec{
+			Name: aws.String("my-cluster"),
+		},
+		Status: svcapitypes.ClusterStatus{
+			ACKResourceMetadata: clusterMetadata("111122223333", "us-west-2"),
+		},
+	}
+	ref := func(name string, namespace *string) *ackv1alpha1.AWSResourceReferenceWrapper {
+		return &ackv1alpha1.AWSResourceReferenceWrapper{
+			From: &ackv1alpha1.AWSResourceReference{
+				Name:      aws.String(name),
+				Namespace: namespace,
+			},
+		}
+	}
+	sameAccount := clusterMetadata("111122223333", "us-west-2")
+	annotations := map[string]string{
+		ackv1alpha1.AnnotationOwnerAccountID: "111122223333",
+		ackv1alpha1.AnnotationRegion:         "us-west-2",
+	}
+
+	tests := []struct {
+		name        string
+		namespace   string
+		clusterName *string
+		clusterRef  *ackv1alpha1.AWSResourceReferenceWrapper
+		metadata    *ackv1alpha1.ResourceMetadata
+		annotations map[string]string
+		want        bool
+	}{
+		{"no cluster", "infra", nil, nil, sameAccount, nil, false},
+		{"matching cluster name", "apps", aws.String("my-cluster"), nil, sameAccount, nil, true},
+		{"matching cluster name and annotations", "apps", aws.String("my-cluster"), nil, nil, annotations, true},
+		{"matching cluster name in another account", "apps", aws.String("my-cluster"), nil, clusterMetadata("444455556666", "us-west-2"), nil, false},
+		{"matching cluster name in another region", "apps", aws.String("my-cluster"), nil, clusterMetadata("111122223333", "eu-west-1"), annotations, false},
+		{"matching cluster name in an unknown account", "apps", aws.String("my-cluster"), nil, nil, nil, false},
+		{"other cluster name", "infra", aws.String("other"), nil, sameAccount, nil, false},
+		{"reference in the same namespace", "infra", nil, ref("my-cluster-cr", nil), nil, nil, true},
+		{"reference from another namespace", "apps", nil, ref("my-cluster-cr", nil), nil, nil, false},
+		{"cross namespace reference", "apps", nil, ref("my-cluster-cr", aws.String("infra")), nil, nil, true},
+		{"reference to another cluster", "infra", nil, ref("other", nil), nil, nil, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ng := &svcapitypes.Nodegroup{ObjectMeta: metav1.ObjectMeta{
+				Name: "ng", Namespace: tt.namespace, Annotations: tt.annotations,
+			}}
+			ng.Spec.ClusterName = tt.clusterName
+			ng.Spec.ClusterRef = tt.clusterRef
+			ng.Status.ACKResourceMetadata = tt.metadata
+			assert.Equal(t, tt.want, ReferencesCluster(cluster, ng))
+		})
+	}
+}
+
+func TestListClusterChildren(t *testing.T) {
+	cluster := &svcapitypes.Cluster{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-cluster-cr", Namespace: "infra"},
+		Spec:       svcapitypes.ClusterSpec{Name: aws.String("my-cluster")},
+		Status: svcapitypes.ClusterStatus{
+			ACKResourceMetadata: clusterMetadata("111122223333", "us-west-2"),
+		},
+	}
+	newNodegroup := func(name, namespace string, clusterName *string, clusterRef *string) *svcapitypes.Nodegroup {
+		ng := &svcapitypes.Nodegroup{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace}}
+		ng.Spec.ClusterName = clusterName
+		ng.Status.ACKResourceMetadata = clusterMetadata("111122223333", "us-west-2")
+		if clusterRef != nil {
+			ng.Spec.ClusterRef = &ackv1alpha1.AWSResourceReferenceWrapper{
+				From: &ackv1alpha1.AWSResourceReference{Name: clusterRef},
+			}
+		}
+		return ng
+	}
+	scheme := runtime.NewScheme()
+	require.NoError(t, svcapitypes.AddToScheme(scheme))
+	builder := fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+		newNodegroup("by-name", "apps", aws.String("my-cluster"), nil),
+		newNodegroup("by-ref", "infra", nil, aws.String("my-cluster-cr")),
+		newNodegroup("ref-from-another-namespace", "apps", nil, aws.String("my-cluster-cr")),
+		newNodegroup("other-cluster", "infra", aws.String("other"), nil),
+		newNodegroup("no-cluster", "infra", nil, nil),
+	)
+	for _, obj := range ClusterChildren() {
+		builder = builder.WithIndex(obj, ClusterIndexField, ClusterIndexValues)
+	}
+
+	objs, err := ListClusterChildren(context.TODO(), builder.Build(), cluster, func() ctrlrtclient.ObjectList {
+		return &svcapitypes.NodegroupList{}
+	})
+	require.NoError(t, err)
+	names := []string{}
+	for _, obj := range objs {
+		names = append(names, obj.GetName())
+	}
+	assert.ElementsMatch(t, []string{"by-name", "by-ref"}, names)
+}
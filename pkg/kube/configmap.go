@@ -0,0 +1,47 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package kube
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// ConfigMapValue returns the value of the supplied key of the ConfigMap with
+// the supplied namespace and name.
+func ConfigMapValue(
+	ctx context.Context,
+	namespace string,
+	name string,
+	key string,
+) (string, error) {
+	kc, err := Client()
+	if err != nil {
+		return "", err
+	}
+	cm := &corev1.ConfigMap{}
+	if err := kc.Get(ctx, types.NamespacedName{Namespace: namespace, Name: name}, cm); err != nil {
+		return "", err
+	}
+	if value, ok := cm.Data[key]; ok {
+		return value, nil
+	}
+	if value, ok := cm.BinaryData[key]; ok {
+		return string(value), nil
+	}
+	return "", fmt.Errorf("key %q not found in ConfigMap %s/%s", key, namespace, name)
+}
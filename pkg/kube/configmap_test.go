@@ -0,0 +1,52 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package kube
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestConfigMapValue(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, clientgoscheme.AddToScheme(scheme))
+	cm := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "infra", Name: "coredns-config"},
+		Data:       map[string]string{"values.yaml": "replicaCount: 3"},
+		BinaryData: map[string][]byte{"values.json": []byte(`{"replicaCount": 3}`)},
+	}
+	SetClient(fake.NewClientBuilder().WithScheme(scheme).WithObjects(cm).Build())
+
+	ctx := context.Background()
+	value, err := ConfigMapValue(ctx, "infra", "coredns-config", "values.yaml")
+	require.NoError(t, err)
+	assert.Equal(t, "replicaCount: 3", value)
+
+	value, err = ConfigMapValue(ctx, "infra", "coredns-config", "values.json")
+	require.NoError(t, err)
+	assert.Equal(t, `{"replicaCount": 3}`, value)
+
+	_, err = ConfigMapValue(ctx, "infra", "coredns-config", "missing")
+	assert.Error(t, err)
+	_, err = ConfigMapValue(ctx, "apps", "coredns-config", "values.yaml")
+	assert.Error(t, err)
+}
@@ -0,0 +1,74 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package kube
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrlrtclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+)
+
+// +kubebuilder:rbac:groups="",resources=events,verbs=create;patch
+
+// eventReportingController is the name the controller reports its Events
+// with.
+const eventReportingController = "ack-eks-controller"
+
+// RecordEvent creates a Kubernetes Event of the supplied type, reason and
+// message about the supplied object.
+func RecordEvent(
+	ctx context.Context,
+	object ctrlrtclient.Object,
+	eventType string,
+	reason string,
+	message string,
+) error {
+	kc, err := Client()
+	if err != nil {
+		return err
+	}
+	gvk, err := apiutil.GVKForObject(object, kc.Scheme())
+	if err != nil {
+		return err
+	}
+	now := metav1.NewTime(time.Now())
+	event := &corev1.Event{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      fmt.Sprintf("%v.%x", object.GetName(), now.UnixNano()),
+			Namespace: object.GetNamespace(),
+		},
+		InvolvedObject: corev1.ObjectReference{
+			APIVersion:      gvk.GroupVersion().String(),
+			Kind:            gvk.Kind,
+			Name:            object.GetName(),
+			Namespace:       object.GetNamespace(),
+			UID:             object.GetUID(),
+			ResourceVersion: object.GetResourceVersion(),
+		},
+		Type:                eventType,
+		Reason:              reason,
+		Message:             message,
+		FirstTimestamp:      now,
+		LastTimestamp:       now,
+		Count:               1,
+		Source:              corev1.EventSource{Component: eventReportingController},
+		ReportingController: eventReportingController,
+	}
+	return kc.Create(ctx, event)
+}
@@ -0,0 +1,122 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package kube
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+
+	acktypes "github.com/aws-controllers-k8s/runtime/pkg/types"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrlrtclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	svcapitypes "github.com/aws-controllers-k8s/eks-controller/apis/v1alpha1"
+	"github.com/aws-controllers-k8s/eks-controller/pkg/condition"
+)
+
+// +kubebuilder:rbac:groups="",resources=secrets,verbs=get;list;watch;create;update;patch;delete
+
+// ErrSecretNotControlled is returned when a Secret to write already exists
+// and is controlled by another resource, or by none.
+var ErrSecretNotControlled = errors.New("secret is not controlled by the resource")
+
+// ApplySecret creates or updates the Secret with the supplied name, in the
+// namespace of owner, so that it holds exactly the supplied data. The Secret
+// is controlled by owner, and is therefore garbage collected along with it.
+// An existing Secret that isn't controlled by owner is never overwritten.
+func ApplySecret(
+	ctx context.Context,
+	owner ctrlrtclient.Object,
+	name string,
+	data map[string][]byte,
+) error {
+	kc, err := Client()
+	if err != nil {
+		return err
+	}
+	secret := &corev1.Secret{}
+	err = kc.Get(ctx, types.NamespacedName{Namespace: owner.GetNamespace(), Name: name}, secret)
+	if apierrors.IsNotFound(err) {
+		secret = &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: owner.GetNamespace(),
+				Name:      name,
+			},
+			Type: corev1.SecretTypeOpaque,
+			Data: data,
+		}
+		if err := controllerutil.SetControllerReference(owner, secret, kc.Scheme()); err != nil {
+			return err
+		}
+		return kc.Create(ctx, secret)
+	}
+	if err != nil {
+		return err
+	}
+	if !metav1.IsControlledBy(secret, owner) {
+		return fmt.Errorf("%w: %s/%s, %s", ErrSecretNotControlled, secret.Namespace, secret.Name, owner.GetName())
+	}
+	if reflect.DeepEqual(secret.Data, data) {
+		return nil
+	}
+	secret.Data = data
+	return kc.Update(ctx, secret)
+}
+
+// SetSecretSyncCondition records the outcome of writing the Secrets of
+// subject: the SecretSyncFailed condition carries the supplied error, or is
+// removed if it is nil.
+func SetSecretSyncCondition(subject acktypes.ConditionManager, err error) {
+	if err == nil {
+		condition.Remove(subject, svcapitypes.ConditionTypeSecretSyncFailed)
+		return
+	}
+	msg := err.Error()
+	reason := "WriteFailed"
+	if errors.Is(err, ErrSecretNotControlled) {
+		reason = "SecretNotControlled"
+	}
+	condition.Set(subject, svcapitypes.ConditionTypeSecretSyncFailed, corev1.ConditionTrue, &msg, &reason)
+}
+
+// DeleteSecret deletes the Secret with the supplied name, in the namespace of
+// owner, if it exists and is controlled by owner.
+func DeleteSecret(
+	ctx context.Context,
+	owner ctrlrtclient.Object,
+	name string,
+) error {
+	kc, err := Client()
+	if err != nil {
+		return err
+	}
+	secret := &corev1.Secret{}
+	err = kc.Get(ctx, types.NamespacedName{Namespace: owner.GetNamespace(), Name: name}, secret)
+	if apierrors.IsNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if !metav1.IsControlledBy(secret, owner) {
+		return nil
+	}
+	return ctrlrtclient.IgnoreNotFound(kc.Delete(ctx, secret))
+}
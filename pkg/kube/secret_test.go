@@ -0,0 +1,69 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package kube
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	svcapitypes "github.com/aws-controllers-k8s/eks-controller/apis/v1alpha1"
+)
+
+func TestApplyAndDeleteSecret(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, clientgoscheme.AddToScheme(scheme))
+	require.NoError(t, svcapitypes.AddToScheme(scheme))
+	foreign := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "infra", Name: "foreign"},
+	}
+	kc := fake.NewClientBuilder().WithScheme(scheme).WithObjects(foreign).Build()
+	SetClient(kc)
+
+	ctx := context.Background()
+	owner := &svcapitypes.Cluster{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "infra", Name: "my-cluster", UID: "uid-1"},
+		Spec:       svcapitypes.ClusterSpec{Name: aws.String("my-cluster")},
+	}
+	key := types.NamespacedName{Namespace: "infra", Name: "my-cluster-kubeconfig"}
+
+	require.NoError(t, ApplySecret(ctx, owner, key.Name, map[string][]byte{"kubeconfig": []byte("v1")}))
+	secret := &corev1.Secret{}
+	require.NoError(t, kc.Get(ctx, key, secret))
+	assert.Equal(t, []byte("v1"), secret.Data["kubeconfig"])
+	assert.True(t, metav1.IsControlledBy(secret, owner))
+
+	require.NoError(t, ApplySecret(ctx, owner, key.Name, map[string][]byte{"kubeconfig": []byte("v2")}))
+	require.NoError(t, kc.Get(ctx, key, secret))
+	assert.Equal(t, []byte("v2"), secret.Data["kubeconfig"])
+
+	err := ApplySecret(ctx, owner, "foreign", map[string][]byte{"kubeconfig": []byte("v1")})
+	assert.ErrorIs(t, err, ErrSecretNotControlled)
+
+	require.NoError(t, DeleteSecret(ctx, owner, "foreign"))
+	assert.NoError(t, kc.Get(ctx, types.NamespacedName{Namespace: "infra", Name: "foreign"}, secret))
+
+	require.NoError(t, DeleteSecret(ctx, owner, key.Name))
+	assert.Error(t, kc.Get(ctx, key, secret))
+	assert.NoError(t, DeleteSecret(ctx, owner, key.Name))
+}
@@ -0,0 +1,174 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+// Package maintenance holds disruptive operations on Cluster and Nodegroup
+// resources until their maintenance window opens.
+package maintenance
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+	// The controller image may not ship the IANA time zone database.
+	_ "time/tzdata"
+
+	ackerr "github.com/aws-controllers-k8s/runtime/pkg/errors"
+	ackrequeue "github.com/aws-controllers-k8s/runtime/pkg/requeue"
+	acktypes "github.com/aws-controllers-k8s/runtime/pkg/types"
+	"github.com/robfig/cron/v3"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	svcapitypes "github.com/aws-controllers-k8s/eks-controller/apis/v1alpha1"
+	"github.com/aws-controllers-k8s/eks-controller/pkg/condition"
+	"github.com/aws-controllers-k8s/eks-controller/pkg/kube"
+)
+
+// +kubebuilder:rbac:groups=eks.services.k8s.aws,resources=maintenancewindows,verbs=get;list;watch
+
+// now is the clock used to evaluate the windows, replaced in tests.
+var now = time.Now
+
+// Window is a parsed maintenance window.
+type Window struct {
+	schedule cron.Schedule
+	duration time.Duration
+	location *time.Location
+}
+
+// Parse returns the maintenance window described by the supplied spec.
+func Parse(spec *svcapitypes.MaintenanceWindowSpec) (*Window, error) {
+	if spec == nil || spec.Schedule == nil || spec.Duration == nil {
+		return nil, fmt.Errorf("maintenance window requires a schedule and a duration")
+	}
+	schedule, err := cron.ParseStandard(*spec.Schedule)
+	if err != nil {
+		return nil, fmt.Errorf("invalid maintenance window schedule: %w", err)
+	}
+	if _, ok := schedule.(cron.ConstantDelaySchedule); ok {
+		return nil, fmt.Errorf("invalid maintenance window schedule %q: @every is not supported", *spec.Schedule)
+	}
+	duration, err := time.ParseDuration(*spec.Duration)
+	if err != nil {
+		return nil, fmt.Errorf("invalid maintenance window duration: %w", err)
+	}
+	if duration <= 0 {
+		return nil, fmt.Errorf("invalid maintenance window duration %q: must be positive", *spec.Duration)
+	}
+	location := time.UTC
+	if spec.TimeZone != nil && *spec.TimeZone != "" {
+		location, err = time.LoadLocation(*spec.TimeZone)
+		if err != nil {
+			return nil, fmt.Errorf("invalid maintenance window time zone: %w", err)
+		}
+	}
+	return &Window{schedule: schedule, duration: duration, location: location}, nil
+}
+
+// Next returns whether the window is open at the supplied time. If it is, the
+// returned time is when the window closes, otherwise it is when the window
+// opens next. The returned time is zero if the window never opens.
+func (w *Window) Next(t time.Time) (bool, time.Time) {
+	t = t.In(w.location)
+	// The window is open if it opened less than a duration ago.
+	opening := w.schedule.Next(t.Add(-w.duration))
+	if opening.IsZero() {
+		return false, opening
+	}
+	if !opening.After(t) {
+		return true, opening.Add(w.duration)
+	}
+	return false, opening
+}
+
+// ForObject returns the maintenance window of the supplied custom resource,
+// or nil if it has none. The window is either a MaintenanceWindow custom
+// resource, referenced by the MaintenanceWindowNameAnnotation annotation, or
+// the spec inlined in the MaintenanceWindowAnnotation annotation.
+func ForObject(ctx context.Context, obj metav1.Object) (*Window, error) {
+	annotations := obj.GetAnnotations()
+	if name, ok := annotations[svcapitypes.MaintenanceWindowNameAnnotation]; ok && name != "" {
+		kc, err := kube.Client()
+		if err != nil {
+			return nil, err
+		}
+		mw := &svcapitypes.MaintenanceWindow{}
+		key := types.NamespacedName{Namespace: obj.GetNamespace(), Name: name}
+		if err := kc.Get(ctx, key, mw); err != nil {
+			return nil, fmt.Errorf("failed to get MaintenanceWindow %s: %w", key, err)
+		}
+		w, err := Parse(&mw.Spec)
+		if err != nil {
+			return nil, ackerr.NewTerminalError(fmt.Errorf("MaintenanceWindow %s: %w", key, err))
+		}
+		return w, nil
+	}
+	if value, ok := annotations[svcapitypes.MaintenanceWindowAnnotation]; ok && value != "" {
+		spec := &svcapitypes.MaintenanceWindowSpec{}
+		if err := json.Unmarshal([]byte(value), spec); err != nil {
+			return nil, ackerr.NewTerminalError(
+				fmt.Errorf("invalid %s annotation: %w", svcapitypes.MaintenanceWindowAnnotation, err),
+			)
+		}
+		w, err := Parse(spec)
+		if err != nil {
+			return nil, ackerr.NewTerminalError(
+				fmt.Errorf("invalid %s annotation: %w", svcapitypes.MaintenanceWindowAnnotation, err),
+			)
+		}
+		return w, nil
+	}
+	return nil, nil
+}
+
+// Check returns nil if a disruptive operation may run on the supplied custom
+// resource now, that is if it has no maintenance window or its window is
+// open. Otherwise it sets the WaitingForMaintenanceWindow condition on
+// subject, describing the operation, and returns a requeue error timed to
+// the next opening of the window.
+func Check(
+	ctx context.Context,
+	obj metav1.Object,
+	subject acktypes.ConditionManager,
+	operation string,
+) error {
+	w, err := ForObject(ctx, obj)
+	if err != nil {
+		return err
+	}
+	if w == nil {
+		condition.Remove(subject, svcapitypes.ConditionTypeWaitingForMaintenanceWindow)
+		return nil
+	}
+	t := now()
+	open, next := w.Next(t)
+	if open {
+		condition.Remove(subject, svcapitypes.ConditionTypeWaitingForMaintenanceWindow)
+		return nil
+	}
+	if next.IsZero() {
+		return ackerr.NewTerminalError(fmt.Errorf("maintenance window never opens"))
+	}
+	msg := fmt.Sprintf("%s held until the maintenance window opens at %s", operation, next.Format(time.RFC3339))
+	reason := "OutsideMaintenanceWindow"
+	condition.Set(subject, svcapitypes.ConditionTypeWaitingForMaintenanceWindow, corev1.ConditionTrue, &msg, &reason)
+	return ackrequeue.NeededAfter(fmt.Errorf("%s", msg), next.Sub(t))
+}
+
+// Clear removes the WaitingForMaintenanceWindow condition from subject. It is
+// called once no disruptive operation of the custom resource is held anymore.
+func Clear(subject acktypes.ConditionManager) {
+	condition.Remove(subject, svcapitypes.ConditionTypeWaitingForMaintenanceWindow)
+}
@@ -0,0 +1,214 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package maintenance
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	ackv1alpha1 "github.com/aws-controllers-k8s/runtime/apis/core/v1alpha1"
+	ackcondition "github.com/aws-controllers-k8s/runtime/pkg/condition"
+	ackerr "github.com/aws-controllers-k8s/runtime/pkg/errors"
+	ackrequeue "github.com/aws-controllers-k8s/runtime/pkg/requeue"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	svcapitypes "github.com/aws-controllers-k8s/eks-controller/apis/v1alpha1"
+	"github.com/aws-controllers-k8s/eks-controller/pkg/kube"
+)
+
+type conditions struct {
+	conds []*ackv1alpha1.Condition
+}
+
+func (c *conditions) Conditions() []*ackv1alpha1.Condition {
+	return c.conds
+}
+
+func (c *conditions) ReplaceConditions(conds []*ackv1alpha1.Condition) {
+	c.conds = conds
+}
+
+func TestParse(t *testing.T) {
+	_, err := Parse(nil)
+	assert.Error(t, err)
+	_, err = Parse(&svcapitypes.MaintenanceWindowSpec{Schedule: aws.String("0 2 * * *")})
+	assert.Error(t, err)
+	_, err = Parse(&svcapitypes.MaintenanceWindowSpec{Schedule: aws.String("0 2 * *"), Duration: aws.String("1h")})
+	assert.Error(t, err)
+	_, err = Parse(&svcapitypes.MaintenanceWindowSpec{Schedule: aws.String("0 2 * * *"), Duration: aws.String("-1h")})
+	assert.Error(t, err)
+	_, err = Parse(&svcapitypes.MaintenanceWindowSpec{Schedule: aws.String("@every 1h"), Duration: aws.String("1h")})
+	assert.Error(t, err)
+	_, err = Parse(&svcapitypes.MaintenanceWindowSpec{
+		Schedule: aws.String("0 2 * * *"), Duration: aws.String("1h"), TimeZone: aws.String("Mars/Olympus"),
+	})
+	assert.Error(t, err)
+}
+
+func TestWindowNextSchedules(t *testing.T) {
+	newYork, err := time.LoadLocation("America/New_York")
+	require.NoError(t, err)
+	// Thursday 2024-02-29 10:17 UTC
+	from := time.Date(2024, 2, 29, 10, 17, 30, 0, time.UTC)
+	tests := []struct {
+		name     string
+		schedule string
+		timeZone string
+		at       time.Time
+		want     time.Time
+	}{
+		{"step", "*/15 * * * *", "", from, time.Date(2024, 2, 29, 10, 30, 0, 0, time.UTC)},
+		{"step from a value", "5/20 * * * *", "", from, time.Date(2024, 2, 29, 10, 25, 0, 0, time.UTC)},
+		{"day of week names", "30 22 * * mon-fri", "", from, time.Date(2024, 2, 29, 22, 30, 0, 0, time.UTC)},
+		// Day of month and day of week are OR-ed when both are restricted.
+		{"day of month or day of week", "0 0 13 * FRI", "", from, time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)},
+		{"leap day", "0 0 29 2 *", "", from.Add(24 * time.Hour), time.Date(2028, 2, 29, 0, 0, 0, 0, time.UTC)},
+		{"descriptor", "@monthly", "", from, time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)},
+		{"never", "0 0 30 2 *", "", from, time.Time{}},
+		// 2:30 doesn't exist on the day DST starts, that opening is skipped.
+		{"DST start", "30 2 * * *", "America/New_York", time.Date(2024, 3, 10, 0, 0, 0, 0, newYork),
+			time.Date(2024, 3, 11, 2, 30, 0, 0, newYork)},
+		{"DST end", "30 2 * * *", "America/New_York", time.Date(2024, 11, 3, 1, 45, 0, 0, newYork),
+			time.Date(2024, 11, 3, 2, 30, 0, 0, newYork)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w, err := Parse(&svcapitypes.MaintenanceWindowSpec{
+				Schedule: aws.String(tt.schedule),
+				Duration: aws.String("1m"),
+				TimeZone: aws.String(tt.timeZone),
+			})
+			require.NoError(t, err)
+			open, next := w.Next(tt.at)
+			assert.False(t, open)
+			assert.True(t, tt.want.Equal(next), "want %s, got %s", tt.want, next)
+		})
+	}
+}
+
+func TestWindowNext(t *testing.T) {
+	w, err := Parse(&svcapitypes.MaintenanceWindowSpec{
+		Schedule: aws.String("0 2 * * SAT"),
+		Duration: aws.String("4h"),
+		TimeZone: aws.String("Europe/Paris"),
+	})
+	require.NoError(t, err)
+	paris, err := time.LoadLocation("Europe/Paris")
+	require.NoError(t, err)
+
+	tests := []struct {
+		name     string
+		at       time.Time
+		wantOpen bool
+		want     time.Time
+	}{
+		{
+			name: "before the window",
+			at:   time.Date(2024, 6, 7, 12, 0, 0, 0, paris),
+			want: time.Date(2024, 6, 8, 2, 0, 0, 0, paris),
+		},
+		{
+			name:     "at the opening",
+			at:       time.Date(2024, 6, 8, 2, 0, 0, 0, paris),
+			wantOpen: true,
+			want:     time.Date(2024, 6, 8, 6, 0, 0, 0, paris),
+		},
+		{
+			name:     "inside the window, in another time zone",
+			at:       time.Date(2024, 6, 8, 3, 30, 0, 0, time.UTC),
+			wantOpen: true,
+			want:     time.Date(2024, 6, 8, 6, 0, 0, 0, paris),
+		},
+		{
+			name: "at the closing",
+			at:   time.Date(2024, 6, 8, 6, 0, 0, 0, paris),
+			want: time.Date(2024, 6, 15, 2, 0, 0, 0, paris),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			open, next := w.Next(tt.at)
+			assert.Equal(t, tt.wantOpen, open)
+			assert.True(t, tt.want.Equal(next), "want %s, got %s", tt.want, next)
+		})
+	}
+}
+
+func TestCheck(t *testing.T) {
+	defer func() { now = time.Now }()
+	now = func() time.Time { return time.Date(2024, 6, 7, 23, 0, 0, 0, time.UTC) }
+
+	scheme := runtime.NewScheme()
+	require.NoError(t, svcapitypes.AddToScheme(scheme))
+	mw := &svcapitypes.MaintenanceWindow{
+		ObjectMeta: metav1.ObjectMeta{Name: "nightly", Namespace: "default"},
+		Spec: svcapitypes.MaintenanceWindowSpec{
+			Schedule: aws.String("0 22 * * *"),
+			Duration: aws.String("2h"),
+		},
+	}
+	kube.SetClient(fake.NewClientBuilder().WithScheme(scheme).WithObjects(mw).Build())
+
+	newObject := func(annotations map[string]string) *svcapitypes.Cluster {
+		return &svcapitypes.Cluster{ObjectMeta: metav1.ObjectMeta{
+			Name: "my-cluster", Namespace: "default", Annotations: annotations,
+		}}
+	}
+
+	t.Run("no window", func(t *testing.T) {
+		subject := &conditions{}
+		assert.NoError(t, Check(context.TODO(), newObject(nil), subject, "Upgrade"))
+		assert.Empty(t, subject.conds)
+	})
+
+	t.Run("open window referenced by name", func(t *testing.T) {
+		subject := &conditions{}
+		obj := newObject(map[string]string{svcapitypes.MaintenanceWindowNameAnnotation: "nightly"})
+		assert.NoError(t, Check(context.TODO(), obj, subject, "Upgrade"))
+		assert.Nil(t, ackcondition.FirstOfType(subject, svcapitypes.ConditionTypeWaitingForMaintenanceWindow))
+	})
+
+	t.Run("closed inline window", func(t *testing.T) {
+		subject := &conditions{}
+		obj := newObject(map[string]string{
+			svcapitypes.MaintenanceWindowAnnotation: `{"schedule": "0 2 * * *", "duration": "1h"}`,
+		})
+		err := Check(context.TODO(), obj, subject, "Upgrade")
+		var requeueErr *ackrequeue.RequeueNeededAfter
+		require.True(t, errors.As(err, &requeueErr))
+		assert.Equal(t, 3*time.Hour, requeueErr.Duration())
+		cond := ackcondition.FirstOfType(subject, svcapitypes.ConditionTypeWaitingForMaintenanceWindow)
+		require.NotNil(t, cond)
+		assert.Contains(t, *cond.Message, "2024-06-08T02:00:00Z")
+	})
+
+	t.Run("invalid annotation", func(t *testing.T) {
+		obj := newObject(map[string]string{svcapitypes.MaintenanceWindowAnnotation: "nightly"})
+		err := Check(context.TODO(), obj, &conditions{}, "Upgrade")
+		var terminalErr *ackerr.TerminalError
+		assert.True(t, errors.As(err, &terminalErr))
+	})
+
+	t.Run("missing MaintenanceWindow", func(t *testing.T) {
+		obj := newObject(map[string]string{svcapitypes.MaintenanceWindowNameAnnotation: "weekly"})
+		assert.Error(t, Check(context.TODO(), obj, &conditions{}, "Upgrade"))
+	})
+}
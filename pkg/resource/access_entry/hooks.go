@@ -99,13 +99,8 @@ func (rm *resourceManager) syncAccessPolicies(ctx context.Context, desired, late
 	existingPolicies := latest.ko.Spec.AccessPolicies
 	desiredPolicies := desired.ko.Spec.AccessPolicies
 
-	// Validate that add desired policies have a non-nil policy arn before preceding to
-	// avoid partial updates to access policies when an invalid entry is present.
-	for _, desiredPolicy := range desiredPolicies {
-		if desiredPolicy.PolicyARN == nil {
-			return ackerr.NewTerminalError(errors.New("All Access Policy entries must specify a Policy ARN."))
-		}
-
+	if err = validateAccessPolicies(desiredPolicies); err != nil {
+		return err
 	}
 
 	toAdd, toDelete := computeAccessPoliciesDelta(desiredPolicies, existingPolicies)
@@ -131,6 +126,18 @@ func (rm *resourceManager) syncAccessPolicies(ctx context.Context, desired, late
 	return nil
 }
 
+// validateAccessPolicies returns a terminal error if one of the supplied
+// desired policies has no policy arn. It is called before any change to avoid
+// partial updates to access policies when an invalid entry is present.
+func validateAccessPolicies(desiredPolicies []*v1alpha1.AssociateAccessPolicyInput) error {
+	for _, desiredPolicy := range desiredPolicies {
+		if desiredPolicy.PolicyARN == nil {
+			return ackerr.NewTerminalError(errors.New("All Access Policy entries must specify a Policy ARN."))
+		}
+	}
+	return nil
+}
+
 // associateAccessPolicy adds the supplied AccessPolicy to the supplied
 // AccessEntry resource.
 func (rm *resourceManager) associateAccessPolicy(
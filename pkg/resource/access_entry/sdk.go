@@ -141,6 +141,7 @@ func (rm *resourceManager) sdkFind(
 	if err := rm.setResourceAdditionalFields(ctx, ko); err != nil {
 		return nil, err
 	}
+	clearDryRunPlan(&resource{ko})
 	return &resource{ko}, nil
 }
 
@@ -303,6 +304,9 @@ func (rm *resourceManager) sdkUpdate(
 	// stale create-time ResourceSynced=False condition (community#2967).
 	updatedDesired := rm.concreteResource(desired.DeepCopy())
 	updatedDesired.SetStatus(latest)
+	if dryRunEnabled(desired) {
+		return rm.planUpdate(ctx, desired, latest, delta)
+	}
 	if delta.DifferentAt("Spec.AccessPolicies") {
 		err := rm.syncAccessPolicies(ctx, desired, latest)
 		if err != nil {
@@ -0,0 +1,228 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package addon
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	ackv1alpha1 "github.com/aws-controllers-k8s/runtime/apis/core/v1alpha1"
+	ackcompare "github.com/aws-controllers-k8s/runtime/pkg/compare"
+	ackerr "github.com/aws-controllers-k8s/runtime/pkg/errors"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/yaml"
+
+	"github.com/aws-controllers-k8s/eks-controller/apis/v1alpha1"
+	"github.com/aws-controllers-k8s/eks-controller/pkg/kube"
+)
+
+var errConflictingConfiguration = errors.New(
+	"at most one of configurationValues, configuration and configurationValuesFrom can be set",
+)
+
+// hasStructuredConfiguration returns true if the configuration values of the
+// supplied add-on are set with either configuration or
+// configurationValuesFrom, rather than configurationValues.
+func hasStructuredConfiguration(r *resource) bool {
+	return r.ko.Spec.Configuration != nil || r.ko.Spec.ConfigurationValuesFrom != nil
+}
+
+// desiredConfigurationValues returns the configuration values to send to the
+// EKS API for the supplied add-on: configurationValues as is, configuration
+// encoded as JSON, or the content of the ConfigMap or Secret key selected by
+// configurationValuesFrom.
+func (rm *resourceManager) desiredConfigurationValues(
+	ctx context.Context,
+	r *resource,
+) (*string, error) {
+	spec := r.ko.Spec
+	set := 0
+	for _, isSet := range []bool{
+		spec.ConfigurationValues != nil,
+		spec.Configuration != nil,
+		spec.ConfigurationValuesFrom != nil,
+	} {
+		if isSet {
+			set++
+		}
+	}
+	if set > 1 {
+		return nil, ackerr.NewTerminalError(errConflictingConfiguration)
+	}
+	switch {
+	case spec.Configuration != nil:
+		values, err := encodeConfiguration(spec.Configuration)
+		if err != nil {
+			return nil, ackerr.NewTerminalError(fmt.Errorf("invalid configuration: %v", err))
+		}
+		return &values, nil
+	case spec.ConfigurationValuesFrom != nil:
+		values, err := rm.configurationValuesFromSource(ctx, r.ko.Namespace, spec.ConfigurationValuesFrom)
+		if err != nil {
+			return nil, err
+		}
+		return &values, nil
+	}
+	return spec.ConfigurationValues, nil
+}
+
+// configurationValuesFromSource returns the content of the ConfigMap or Secret
+// key selected by the supplied source, in the supplied namespace.
+func (rm *resourceManager) configurationValuesFromSource(
+	ctx context.Context,
+	namespace string,
+	source *v1alpha1.AddonConfigurationValuesSource,
+) (string, error) {
+	switch {
+	case source.ConfigMapKeyRef != nil && source.SecretKeyRef != nil:
+		return "", ackerr.NewTerminalError(errors.New(
+			"configurationValuesFrom can only set one of configMapKeyRef and secretKeyRef",
+		))
+	case source.ConfigMapKeyRef != nil:
+		ref := source.ConfigMapKeyRef
+		return kube.ConfigMapValue(ctx, namespace, aws.ToString(ref.Name), aws.ToString(ref.Key))
+	case source.SecretKeyRef != nil:
+		ref := source.SecretKeyRef
+		return rm.rr.SecretValueFromReference(ctx, &ackv1alpha1.SecretKeyReference{
+			SecretReference: corev1.SecretReference{Namespace: namespace, Name: aws.ToString(ref.Name)},
+			Key:             aws.ToString(ref.Key),
+		})
+	}
+	return "", ackerr.NewTerminalError(errors.New(
+		"configurationValuesFrom must set one of configMapKeyRef and secretKeyRef",
+	))
+}
+
+// syncConfiguration aligns the configuration values of the latest add-on, as
+// returned by the EKS API, on the way the desired add-on sets them, so that
+// semantically equal documents are not reported as a difference:
+//
+//   - with configuration, the values are decoded into configuration and
+//     configurationValues is cleared,
+//   - with configurationValuesFrom, configurationValues is cleared when it
+//     matches the content of the selected ConfigMap or Secret key, and left
+//     set, to an empty document if needed, when it doesn't.
+//
+// It returns the error reading the ConfigMap or Secret key selected by
+// configurationValuesFrom. The error must not prevent the add-on from being
+// read, and deleted: the caller marks the add-on as not synced instead, so
+// that it is requeued until the values can be read.
+func (rm *resourceManager) syncConfiguration(
+	ctx context.Context,
+	desired *resource,
+	latest *resource,
+) error {
+	latest.ko.Spec.ConfigurationValuesFrom = desired.ko.Spec.ConfigurationValuesFrom
+	if !hasStructuredConfiguration(desired) {
+		return nil
+	}
+	values := aws.ToString(latest.ko.Spec.ConfigurationValues)
+	if desired.ko.Spec.Configuration != nil {
+		latest.ko.Spec.ConfigurationValues = nil
+		latest.ko.Spec.Configuration = decodeConfiguration(values)
+		return nil
+	}
+	source, err := rm.configurationValuesFromSource(ctx, desired.ko.Namespace, desired.ko.Spec.ConfigurationValuesFrom)
+	if err != nil {
+		latest.ko.Spec.ConfigurationValues = nil
+		return fmt.Errorf("unable to read configurationValuesFrom: %w", err)
+	}
+	// EKS returns no configuration values for an empty document.
+	equal, err := ackcompare.DocumentEqual(orEmptyDocument(source), orEmptyDocument(values))
+	if err == nil && equal {
+		latest.ko.Spec.ConfigurationValues = nil
+		return nil
+	}
+	latest.ko.Spec.ConfigurationValues = aws.String(values)
+	return nil
+}
+
+// restoreConfiguration restores, in the supplied add-on returned by the EKS
+// API, the way the desired add-on sets its configuration values.
+func restoreConfiguration(desired *resource, ko *v1alpha1.Addon) {
+	if !hasStructuredConfiguration(desired) {
+		return
+	}
+	ko.Spec.ConfigurationValues = desired.ko.Spec.ConfigurationValues
+	ko.Spec.Configuration = desired.ko.Spec.Configuration
+	ko.Spec.ConfigurationValuesFrom = desired.ko.Spec.ConfigurationValuesFrom
+}
+
+// compareConfiguration adds a difference to the supplied delta if the
+// configuration of the supplied add-ons are not semantically equal.
+func compareConfiguration(delta *ackcompare.Delta, a, b *resource) {
+	if ackcompare.HasNilDifference(a.ko.Spec.Configuration, b.ko.Spec.Configuration) {
+		delta.Add("Spec.Configuration", a.ko.Spec.Configuration, b.ko.Spec.Configuration)
+		return
+	}
+	if a.ko.Spec.Configuration == nil {
+		return
+	}
+	aValues, aErr := encodeConfiguration(a.ko.Spec.Configuration)
+	bValues, bErr := encodeConfiguration(b.ko.Spec.Configuration)
+	if aErr != nil || bErr != nil || aValues != bValues {
+		delta.Add("Spec.Configuration", a.ko.Spec.Configuration, b.ko.Spec.Configuration)
+	}
+}
+
+// encodeConfiguration returns the canonical JSON encoding, with sorted keys,
+// of the supplied configuration.
+func encodeConfiguration(configuration *runtime.RawExtension) (string, error) {
+	var document interface{}
+	if err := json.Unmarshal(configuration.Raw, &document); err != nil {
+		return "", err
+	}
+	if _, ok := document.(map[string]interface{}); !ok {
+		return "", errors.New("configuration must be an object")
+	}
+	data, err := json.Marshal(document)
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+// decodeConfiguration returns the supplied JSON or YAML configuration values
+// as a configuration object. It returns nil if the values are empty or are not
+// an object.
+func decodeConfiguration(values string) *runtime.RawExtension {
+	if strings.TrimSpace(values) == "" {
+		return nil
+	}
+	data, err := yaml.YAMLToJSON([]byte(values))
+	if err != nil {
+		return nil
+	}
+	configuration := &runtime.RawExtension{Raw: data}
+	encoded, err := encodeConfiguration(configuration)
+	if err != nil {
+		return nil
+	}
+	configuration.Raw = []byte(encoded)
+	return configuration
+}
+
+// orEmptyDocument returns the supplied configuration values, or an empty
+// object if they are empty.
+func orEmptyDocument(values string) string {
+	if strings.TrimSpace(values) == "" {
+		return "{}"
+	}
+	return values
+}
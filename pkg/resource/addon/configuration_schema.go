@@ -0,0 +1,227 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package addon
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	ackerr "github.com/aws-controllers-k8s/runtime/pkg/errors"
+	ackrtlog "github.com/aws-controllers-k8s/runtime/pkg/runtime/log"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	svcsdk "github.com/aws/aws-sdk-go-v2/service/eks"
+	"k8s.io/kube-openapi/pkg/validation/spec"
+	"k8s.io/kube-openapi/pkg/validation/strfmt"
+	"k8s.io/kube-openapi/pkg/validation/validate"
+	"sigs.k8s.io/yaml"
+)
+
+// configurationSchemas caches the configuration schemas of add-on versions,
+// keyed by add-on name and version. Published add-on versions are immutable,
+// so a cached schema never needs to be refreshed. A nil schema means the
+// add-on version has no configuration schema to validate against.
+var configurationSchemas = &schemaCache{schemas: map[string]*spec.Schema{}}
+
+type schemaCache struct {
+	sync.Mutex
+	schemas map[string]*spec.Schema
+}
+
+func (c *schemaCache) get(key string) (*spec.Schema, bool) {
+	c.Lock()
+	defer c.Unlock()
+	schema, ok := c.schemas[key]
+	return schema, ok
+}
+
+func (c *schemaCache) set(key string, schema *spec.Schema) {
+	c.Lock()
+	defer c.Unlock()
+	c.schemas[key] = schema
+}
+
+// validateConfigurationValues validates the supplied configuration values of
+// the supplied add-on against the configuration schema of the supplied add-on
+// version. It returns a terminal error listing the JSON path of every value
+// that does not match the schema.
+func (rm *resourceManager) validateConfigurationValues(
+	ctx context.Context,
+	r *resource,
+	version *string,
+	configurationValues *string,
+) (err error) {
+	values := aws.ToString(configurationValues)
+	if strings.TrimSpace(values) == "" || version == nil {
+		return nil
+	}
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.validateConfigurationValues")
+	defer func() { exit(err) }()
+
+	schema, err := rm.configurationSchema(ctx, aws.ToString(r.ko.Spec.Name), *version)
+	if err != nil {
+		return err
+	}
+	if schema == nil {
+		return nil
+	}
+	violations, err := validateAgainstSchema(schema, values)
+	if err != nil {
+		return ackerr.NewTerminalError(fmt.Errorf("invalid configurationValues: %v", err))
+	}
+	if len(violations) > 0 {
+		return ackerr.NewTerminalError(fmt.Errorf(
+			"configurationValues do not match the configuration schema of %s %s: %s",
+			aws.ToString(r.ko.Spec.Name), *version, strings.Join(violations, "; "),
+		))
+	}
+	return nil
+}
+
+// configurationSchema returns the configuration schema of the supplied add-on
+// version, describing it only when it is not cached yet.
+func (rm *resourceManager) configurationSchema(
+	ctx context.Context,
+	name string,
+	version string,
+) (*spec.Schema, error) {
+	key := name + "/" + version
+	if schema, ok := configurationSchemas.get(key); ok {
+		return schema, nil
+	}
+	resp, err := rm.sdkapi.DescribeAddonConfiguration(ctx, &svcsdk.DescribeAddonConfigurationInput{
+		AddonName:    &name,
+		AddonVersion: &version,
+	})
+	rm.metrics.RecordAPICall("READ_ONE", "DescribeAddonConfiguration", err)
+	if err != nil {
+		return nil, err
+	}
+	schema, err := parseConfigurationSchema(aws.ToString(resp.ConfigurationSchema))
+	if err != nil {
+		// A schema we cannot understand should not prevent the add-on from
+		// being configured, EKS validates the values anyway.
+		ackrtlog.FromContext(ctx).Debug(
+			"ignoring unparseable add-on configuration schema",
+			"addon", name, "version", version, "error", err.Error(),
+		)
+		schema = nil
+	}
+	configurationSchemas.set(key, schema)
+	return schema, nil
+}
+
+// parseConfigurationSchema parses the supplied JSON schema, inlining its local
+// "#/definitions/..." references. It returns a nil schema if the supplied
+// schema is empty.
+func parseConfigurationSchema(document string) (*spec.Schema, error) {
+	if strings.TrimSpace(document) == "" {
+		return nil, nil
+	}
+	var raw map[string]interface{}
+	if err := json.Unmarshal([]byte(document), &raw); err != nil {
+		return nil, err
+	}
+	definitions, _ := raw["definitions"].(map[string]interface{})
+	inlined := inlineDefinitions(raw, definitions, map[string]bool{})
+	data, err := json.Marshal(inlined)
+	if err != nil {
+		return nil, err
+	}
+	schema := &spec.Schema{}
+	if err := json.Unmarshal(data, schema); err != nil {
+		return nil, err
+	}
+	return schema, nil
+}
+
+// inlineDefinitions replaces the local "$ref"s of the supplied schema node by
+// the definitions they point at. A recursive reference is replaced by an empty
+// schema, which accepts any value.
+func inlineDefinitions(
+	node interface{},
+	definitions map[string]interface{},
+	visiting map[string]bool,
+) interface{} {
+	switch n := node.(type) {
+	case map[string]interface{}:
+		if ref, ok := n["$ref"].(string); ok && strings.HasPrefix(ref, "#/definitions/") {
+			name := strings.TrimPrefix(ref, "#/definitions/")
+			definition, found := definitions[name]
+			if !found || visiting[name] {
+				return map[string]interface{}{}
+			}
+			visiting[name] = true
+			defer delete(visiting, name)
+			return inlineDefinitions(definition, definitions, visiting)
+		}
+		out := make(map[string]interface{}, len(n))
+		for k, v := range n {
+			if k == "definitions" {
+				continue
+			}
+			out[k] = inlineDefinitions(v, definitions, visiting)
+		}
+		return out
+	case []interface{}:
+		out := make([]interface{}, len(n))
+		for i, v := range n {
+			out[i] = inlineDefinitions(v, definitions, visiting)
+		}
+		return out
+	default:
+		return node
+	}
+}
+
+// validateAgainstSchema validates the supplied JSON or YAML configuration
+// values against the supplied schema. It returns the sorted schema violations,
+// each prefixed with the JSON path of the offending value, or an error if the
+// values are not a valid JSON or YAML document.
+func validateAgainstSchema(schema *spec.Schema, values string) ([]string, error) {
+	data, err := yaml.YAMLToJSON([]byte(values))
+	if err != nil {
+		return nil, err
+	}
+	var document interface{}
+	if err := json.Unmarshal(data, &document); err != nil {
+		return nil, err
+	}
+	result := validate.NewSchemaValidator(schema, nil, "", strfmt.Default).Validate(document)
+	violations := make([]string, 0, len(result.Errors))
+	for _, err := range result.Errors {
+		violations = append(violations, formatViolation(err.Error()))
+	}
+	sort.Strings(violations)
+	return violations, nil
+}
+
+// formatViolation rewrites a validation message such as
+// "resources.limits.cpu in body is a forbidden property" into
+// "$.resources.limits.cpu: is a forbidden property".
+func formatViolation(message string) string {
+	path, reason, found := strings.Cut(message, " in body ")
+	if !found {
+		return message
+	}
+	path = strings.TrimPrefix(path, ".")
+	if path == "" {
+		return "$: " + reason
+	}
+	return "$." + path + ": " + reason
+}
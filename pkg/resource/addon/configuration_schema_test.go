@@ -0,0 +1,142 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package addon
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testConfigurationSchema = `{
+	"$schema": "http://json-schema.org/draft-07/schema#",
+	"type": "object",
+	"additionalProperties": false,
+	"definitions": {
+		"Resources": {
+			"type": "object",
+			"additionalProperties": false,
+			"properties": {
+				"limits": {"$ref": "#/definitions/Quantities"},
+				"requests": {"$ref": "#/definitions/Quantities"}
+			}
+		},
+		"Quantities": {
+			"type": "object",
+			"additionalProperties": false,
+			"properties": {
+				"cpu": {"type": "string"},
+				"memory": {"type": "string"}
+			}
+		},
+		"Node": {
+			"type": "object",
+			"properties": {
+				"child": {"$ref": "#/definitions/Node"}
+			}
+		}
+	},
+	"properties": {
+		"replicaCount": {"type": "integer"},
+		"resources": {"$ref": "#/definitions/Resources"},
+		"tree": {"$ref": "#/definitions/Node"},
+		"tolerations": {
+			"type": "array",
+			"items": {
+				"type": "object",
+				"properties": {"key": {"type": "string"}}
+			}
+		}
+	}
+}`
+
+func TestParseConfigurationSchema(t *testing.T) {
+	schema, err := parseConfigurationSchema("")
+	require.NoError(t, err)
+	assert.Nil(t, schema)
+
+	_, err = parseConfigurationSchema("{not json")
+	assert.Error(t, err)
+
+	schema, err = parseConfigurationSchema(testConfigurationSchema)
+	require.NoError(t, err)
+	require.NotNil(t, schema)
+	limits := schema.Properties["resources"].Properties["limits"]
+	assert.Contains(t, limits.Properties, "cpu")
+	// Recursive references are inlined only once.
+	child := schema.Properties["tree"].Properties["child"]
+	assert.Empty(t, child.Properties)
+}
+
+func TestValidateAgainstSchema(t *testing.T) {
+	schema, err := parseConfigurationSchema(testConfigurationSchema)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name       string
+		values     string
+		violations []string
+		wantErr    bool
+	}{
+		{
+			name:       "valid JSON",
+			values:     `{"replicaCount": 2, "resources": {"limits": {"cpu": "100m"}}}`,
+			violations: []string{},
+		},
+		{
+			name:       "valid YAML",
+			values:     "replicaCount: 2\ntolerations:\n- key: CriticalAddonsOnly\n",
+			violations: []string{},
+		},
+		{
+			name:   "unknown nested property",
+			values: `{"resources": {"limits": {"cpux": "100m"}}}`,
+			violations: []string{
+				"$.resources.limits.cpux: is a forbidden property",
+			},
+		},
+		{
+			name:   "several violations are sorted",
+			values: "tolerations:\n- key: 1\nreplicaCount: two\nrepl: 1\n",
+			violations: []string{
+				"$.repl: is a forbidden property",
+				`$.replicaCount: must be of type integer: "string"`,
+				`$.tolerations[0].key: must be of type string: "number"`,
+			},
+		},
+		{
+			name:    "invalid document",
+			values:  "replicaCount: [",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			violations, err := validateAgainstSchema(schema, tt.values)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.violations, violations)
+		})
+	}
+}
+
+func TestFormatViolation(t *testing.T) {
+	assert.Equal(t, "$.a.b: is a forbidden property", formatViolation("a.b in body is a forbidden property"))
+	assert.Equal(t, "$: must be of type object", formatViolation(" in body must be of type object"))
+	assert.Equal(t, "something else", formatViolation("something else"))
+}
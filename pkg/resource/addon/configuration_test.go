@@ -0,0 +1,282 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package addon
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	ackv1alpha1 "github.com/aws-controllers-k8s/runtime/apis/core/v1alpha1"
+	ackcompare "github.com/aws-controllers-k8s/runtime/pkg/compare"
+	ackerr "github.com/aws-controllers-k8s/runtime/pkg/errors"
+	acktypes "github.com/aws-controllers-k8s/runtime/pkg/types"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/aws-controllers-k8s/eks-controller/apis/v1alpha1"
+	"github.com/aws-controllers-k8s/eks-controller/pkg/kube"
+)
+
+func newConfigurationAddon(spec v1alpha1.AddonSpec) *resource {
+	return &resource{ko: &v1alpha1.Addon{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "infra", Name: "coredns"},
+		Spec:       spec,
+	}}
+}
+
+func setConfigurationClient(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, clientgoscheme.AddToScheme(scheme))
+	kube.SetClient(fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+		&corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "infra", Name: "coredns-values"},
+			Data: map[string]string{
+				"values.yaml": "replicaCount: 3\nautoScaling:\n  enabled: true\n",
+				"empty.yaml":  "{}",
+			},
+		},
+	).Build())
+}
+
+// secretReconciler resolves the Secret references from its secrets, keyed
+// by namespace, name and key.
+type secretReconciler struct {
+	acktypes.Reconciler
+	secrets map[string]string
+}
+
+func (r *secretReconciler) SecretValueFromReference(
+	ctx context.Context,
+	ref *ackv1alpha1.SecretKeyReference,
+) (string, error) {
+	value, ok := r.secrets[ref.Namespace+"/"+ref.Name+"/"+ref.Key]
+	if !ok {
+		return "", ackerr.SecretNotFound
+	}
+	return value, nil
+}
+
+func newConfigurationManager() *resourceManager {
+	return &resourceManager{rr: &secretReconciler{secrets: map[string]string{
+		"infra/coredns-values/values.json": `{"replicaCount": 2}`,
+	}}}
+}
+
+func TestDesiredConfigurationValues(t *testing.T) {
+	setConfigurationClient(t)
+	configMapRef := &v1alpha1.AddonConfigurationValuesSource{
+		ConfigMapKeyRef: &v1alpha1.KeyReference{Name: aws.String("coredns-values"), Key: aws.String("values.yaml")},
+	}
+	secretRef := &v1alpha1.AddonConfigurationValuesSource{
+		SecretKeyRef: &v1alpha1.KeyReference{Name: aws.String("coredns-values"), Key: aws.String("values.json")},
+	}
+
+	tests := []struct {
+		name         string
+		spec         v1alpha1.AddonSpec
+		want         *string
+		wantErr      bool
+		wantTerminal bool
+	}{
+		{
+			name: "no configuration",
+		},
+		{
+			name: "configurationValues",
+			spec: v1alpha1.AddonSpec{ConfigurationValues: aws.String("replicaCount: 3")},
+			want: aws.String("replicaCount: 3"),
+		},
+		{
+			name: "configuration is encoded with sorted keys",
+			spec: v1alpha1.AddonSpec{Configuration: &runtime.RawExtension{Raw: []byte(`{"replicaCount": 3, "autoScaling": {"enabled": true}}`)}},
+			want: aws.String(`{"autoScaling":{"enabled":true},"replicaCount":3}`),
+		},
+		{
+			name: "ConfigMap key",
+			spec: v1alpha1.AddonSpec{ConfigurationValuesFrom: configMapRef},
+			want: aws.String("replicaCount: 3\nautoScaling:\n  enabled: true\n"),
+		},
+		{
+			name: "Secret key",
+			spec: v1alpha1.AddonSpec{ConfigurationValuesFrom: secretRef},
+			want: aws.String(`{"replicaCount": 2}`),
+		},
+		{
+			name: "missing key",
+			spec: v1alpha1.AddonSpec{ConfigurationValuesFrom: &v1alpha1.AddonConfigurationValuesSource{
+				ConfigMapKeyRef: &v1alpha1.KeyReference{Name: aws.String("coredns-values"), Key: aws.String("missing")},
+			}},
+			wantErr: true,
+		},
+		{
+			name: "both references",
+			spec: v1alpha1.AddonSpec{ConfigurationValuesFrom: &v1alpha1.AddonConfigurationValuesSource{
+				ConfigMapKeyRef: configMapRef.ConfigMapKeyRef,
+				SecretKeyRef:    secretRef.SecretKeyRef,
+			}},
+			wantErr:      true,
+			wantTerminal: true,
+		},
+		{
+			name:         "configuration is not an object",
+			spec:         v1alpha1.AddonSpec{Configuration: &runtime.RawExtension{Raw: []byte(`[1, 2]`)}},
+			wantErr:      true,
+			wantTerminal: true,
+		},
+		{
+			name: "conflicting configuration",
+			spec: v1alpha1.AddonSpec{
+				ConfigurationValues:     aws.String("replicaCount: 3"),
+				ConfigurationValuesFrom: configMapRef,
+			},
+			wantErr:      true,
+			wantTerminal: true,
+		},
+	}
+	rm := newConfigurationManager()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := rm.desiredConfigurationValues(context.Background(), newConfigurationAddon(tt.spec))
+			if tt.wantErr {
+				require.Error(t, err)
+				var terminalErr *ackerr.TerminalError
+				assert.Equal(t, tt.wantTerminal, errors.As(err, &terminalErr))
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestSyncConfiguration(t *testing.T) {
+	setConfigurationClient(t)
+	configMapRef := &v1alpha1.AddonConfigurationValuesSource{
+		ConfigMapKeyRef: &v1alpha1.KeyReference{Name: aws.String("coredns-values"), Key: aws.String("values.yaml")},
+	}
+
+	tests := []struct {
+		name              string
+		desired           v1alpha1.AddonSpec
+		latestValues      *string
+		wantValues        *string
+		wantConfiguration *runtime.RawExtension
+		wantErr           bool
+	}{
+		{
+			name:         "configurationValues are left as is",
+			desired:      v1alpha1.AddonSpec{ConfigurationValues: aws.String("replicaCount: 3")},
+			latestValues: aws.String(`{"replicaCount":3}`),
+			wantValues:   aws.String(`{"replicaCount":3}`),
+		},
+		{
+			name:              "configuration is decoded",
+			desired:           v1alpha1.AddonSpec{Configuration: &runtime.RawExtension{Raw: []byte(`{"replicaCount": 3}`)}},
+			latestValues:      aws.String("replicaCount: 3\n"),
+			wantConfiguration: &runtime.RawExtension{Raw: []byte(`{"replicaCount":3}`)},
+		},
+		{
+			name:         "configuration without values",
+			desired:      v1alpha1.AddonSpec{Configuration: &runtime.RawExtension{Raw: []byte(`{"replicaCount": 3}`)}},
+			latestValues: nil,
+		},
+		{
+			name:         "configurationValuesFrom in sync",
+			desired:      v1alpha1.AddonSpec{ConfigurationValuesFrom: configMapRef},
+			latestValues: aws.String(`{"autoScaling":{"enabled":true},"replicaCount":3}`),
+		},
+		{
+			name:         "configurationValuesFrom out of sync",
+			desired:      v1alpha1.AddonSpec{ConfigurationValuesFrom: configMapRef},
+			latestValues: aws.String(`{"replicaCount":2}`),
+			wantValues:   aws.String(`{"replicaCount":2}`),
+		},
+		{
+			name:         "configurationValuesFrom without values",
+			desired:      v1alpha1.AddonSpec{ConfigurationValuesFrom: configMapRef},
+			latestValues: nil,
+			wantValues:   aws.String(""),
+		},
+		{
+			name: "configurationValuesFrom with an empty document",
+			desired: v1alpha1.AddonSpec{ConfigurationValuesFrom: &v1alpha1.AddonConfigurationValuesSource{
+				ConfigMapKeyRef: &v1alpha1.KeyReference{Name: aws.String("coredns-values"), Key: aws.String("empty.yaml")},
+			}},
+			latestValues: nil,
+		},
+		{
+			name: "configurationValuesFrom Secret in sync",
+			desired: v1alpha1.AddonSpec{ConfigurationValuesFrom: &v1alpha1.AddonConfigurationValuesSource{
+				SecretKeyRef: &v1alpha1.KeyReference{Name: aws.String("coredns-values"), Key: aws.String("values.json")},
+			}},
+			latestValues: aws.String("replicaCount: 2\n"),
+		},
+		{
+			name: "unreadable configurationValuesFrom",
+			desired: v1alpha1.AddonSpec{ConfigurationValuesFrom: &v1alpha1.AddonConfigurationValuesSource{
+				SecretKeyRef: &v1alpha1.KeyReference{Name: aws.String("missing"), Key: aws.String("values.yaml")},
+			}},
+			latestValues: aws.String(`{"replicaCount":2}`),
+			wantErr:      true,
+		},
+	}
+	rm := newConfigurationManager()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			desired := newConfigurationAddon(tt.desired)
+			latest := newConfigurationAddon(v1alpha1.AddonSpec{ConfigurationValues: tt.latestValues})
+			err := rm.syncConfiguration(context.Background(), desired, latest)
+			assert.Equal(t, tt.wantErr, err != nil)
+			assert.Equal(t, tt.wantValues, latest.ko.Spec.ConfigurationValues)
+			assert.Equal(t, tt.wantConfiguration, latest.ko.Spec.Configuration)
+			assert.Equal(t, tt.desired.ConfigurationValuesFrom, latest.ko.Spec.ConfigurationValuesFrom)
+
+			delta := newResourceDelta(desired, latest)
+			wantDrift := tt.wantValues != nil && tt.desired.ConfigurationValues == nil ||
+				tt.desired.Configuration != nil && tt.wantConfiguration == nil
+			assert.Equal(t, wantDrift, delta.DifferentAt("Spec.ConfigurationValues") || delta.DifferentAt("Spec.Configuration"))
+		})
+	}
+}
+
+func TestCompareConfiguration(t *testing.T) {
+	configuration := func(raw string) *runtime.RawExtension {
+		return &runtime.RawExtension{Raw: []byte(raw)}
+	}
+	tests := []struct {
+		name string
+		a, b *runtime.RawExtension
+		want bool
+	}{
+		{"both nil", nil, nil, false},
+		{"nil difference", configuration(`{}`), nil, true},
+		{"different key order", configuration(`{"a": 1, "b": {"c": true}}`), configuration(`{"b":{"c":true},"a":1}`), false},
+		{"different values", configuration(`{"a": 1}`), configuration(`{"a": 2}`), true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			delta := ackcompare.NewDelta()
+			compareConfiguration(delta, newConfigurationAddon(v1alpha1.AddonSpec{Configuration: tt.a}),
+				newConfigurationAddon(v1alpha1.AddonSpec{Configuration: tt.b}))
+			assert.Equal(t, tt.want, delta.DifferentAt("Spec.Configuration"))
+		})
+	}
+}
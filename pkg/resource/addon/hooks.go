@@ -232,6 +232,7 @@ func customPreCompare(delta *ackcompare.Delta, desired, latest *resource) {
 	if !equalPodIdentityAssociations(desired.ko.Spec.PodIdentityAssociations, latest.ko.Spec.PodIdentityAssociations) {
 		delta.Add("Spec.PodIdentityAssociations", desired.ko.Spec.PodIdentityAssociations, latest.ko.Spec.PodIdentityAssociations)
 	}
+	compareConfiguration(delta, desired, latest)
 	// Force update if addon is in failed state to attempt recovery
 	if addonHasFailedStatus(latest) {
 		delta.Add("Spec.ForceRecovery", desired.ko.Status.Status, latest.ko.Status.Status)
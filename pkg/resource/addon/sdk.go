@@ -192,10 +192,21 @@ func (rm *resourceManager) sdkFind(
 	if err := rm.setResourceAdditionalFields(ctx, ko, resp.Addon.PodIdentityAssociations); err != nil {
 		return nil, err
 	}
+	if err := rm.syncResolvedAddonVersion(ctx, r, &resource{ko}); err != nil {
+		return nil, err
+	}
+	configurationErr := rm.syncConfiguration(ctx, r, &resource{ko})
+	syncHealthConditions(ctx, &resource{ko})
+	clearDryRunPlan(&resource{ko})
 	if !addonActive(&resource{ko}) {
 		// Setting resource synced condition to false will trigger a requeue of
 		// the resource. No need to return a requeue error here.
 		ackcondition.SetSynced(&resource{ko}, corev1.ConditionFalse, nil, nil)
+	} else if configurationErr != nil {
+		// Requeue the add-on until the values selected by
+		// configurationValuesFrom can be read and compared.
+		msg := configurationErr.Error()
+		ackcondition.SetSynced(&resource{ko}, corev1.ConditionFalse, &msg, nil)
 	} else {
 		ackcondition.SetSynced(&resource{ko}, corev1.ConditionTrue, nil, nil)
 	}
@@ -245,6 +256,21 @@ func (rm *resourceManager) sdkCreate(
 	if err != nil {
 		return nil, err
 	}
+	if isAddonVersionAlias(desired.ko.Spec.AddonVersion) {
+		resolved, err := rm.resolveAddonVersion(ctx, desired)
+		if err != nil {
+			return nil, err
+		}
+		input.AddonVersion = &resolved
+	}
+	configurationValues, err := rm.desiredConfigurationValues(ctx, desired)
+	if err != nil {
+		return nil, err
+	}
+	input.ConfigurationValues = configurationValues
+	if err := rm.validateConfigurationValues(ctx, desired, input.AddonVersion, input.ConfigurationValues); err != nil {
+		return nil, err
+	}
 
 	var resp *svcsdk.CreateAddonOutput
 	_ = resp
@@ -356,6 +382,14 @@ func (rm *resourceManager) sdkCreate(
 	}
 
 	rm.setStatusDefaults(ko)
+	// The version alias is kept in the spec, the version it resolved to is
+	// reported in the status.
+	if isAddonVersionAlias(desired.ko.Spec.AddonVersion) {
+		ko.Status.ResolvedAddonVersion = ko.Spec.AddonVersion
+		ko.Spec.AddonVersion = desired.ko.Spec.AddonVersion
+	}
+	// So are configuration and configurationValuesFrom.
+	restoreConfiguration(desired, ko)
 	// We expect the addon to be in 'CREATING' status since we just issued
 	// the call to create it, but I suppose it doesn't hurt to check here.
 	if addonCreating(&resource{ko}) {
@@ -446,6 +480,10 @@ func (rm *resourceManager) sdkUpdate(
 		return latest, requeueWaitUntilCanModify(latest)
 	}
 
+	if dryRunEnabled(desired) {
+		return rm.planUpdate(ctx, desired, latest, delta)
+	}
+
 	if delta.DifferentAt("Spec.Tags") {
 		err := syncTags(
 			ctx, rm.sdkapi, rm.metrics,
@@ -467,6 +505,25 @@ func (rm *resourceManager) sdkUpdate(
 	if delta.DifferentAt("Spec.PodIdentityAssociations") && len(desired.ko.Spec.PodIdentityAssociations) == 0 {
 		input.PodIdentityAssociations = []svcsdktypes.AddonPodIdentityAssociations{}
 	}
+	input.AddonVersion = updateAddonVersion(desired, latest, delta)
+	configurationValues, err := rm.desiredConfigurationValues(ctx, desired)
+	if err != nil {
+		return nil, err
+	}
+	input.ConfigurationValues = configurationValues
+	if delta.DifferentAt("Spec.ConfigurationValues") || delta.DifferentAt("Spec.Configuration") ||
+		delta.DifferentAt("Spec.AddonVersion") {
+		version := input.AddonVersion
+		if version == nil {
+			version = latest.ko.Status.ResolvedAddonVersion
+		}
+		if version == nil {
+			version = latest.ko.Spec.AddonVersion
+		}
+		if err := rm.validateConfigurationValues(ctx, desired, version, input.ConfigurationValues); err != nil {
+			return nil, err
+		}
+	}
 
 	var resp *svcsdk.UpdateAddonOutput
 	_ = resp
@@ -491,6 +548,7 @@ func (rm *resourceManager) sdkUpdate(
 	}
 
 	rm.setStatusDefaults(ko)
+	ko.Status.ResolvedAddonVersion = latest.ko.Status.ResolvedAddonVersion
 	// Updating addons will very likely change the state of the addon
 	// so we should requeue the resource to check the status again.
 	returnAddonUpdating(&resource{ko})
@@ -0,0 +1,200 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package addon
+
+import (
+	"context"
+	"fmt"
+
+	ackcompare "github.com/aws-controllers-k8s/runtime/pkg/compare"
+	ackerr "github.com/aws-controllers-k8s/runtime/pkg/errors"
+	ackrtlog "github.com/aws-controllers-k8s/runtime/pkg/runtime/log"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	svcsdk "github.com/aws/aws-sdk-go-v2/service/eks"
+	svcsdktypes "github.com/aws/aws-sdk-go-v2/service/eks/types"
+	utilversion "k8s.io/apimachinery/pkg/util/version"
+
+	"github.com/aws-controllers-k8s/eks-controller/pkg/util"
+)
+
+const (
+	// AddonVersionDefault resolves to the default version of the add-on for
+	// the Kubernetes version of the cluster.
+	AddonVersionDefault = "default"
+	// AddonVersionLatest resolves to the newest version of the add-on that is
+	// compatible with the Kubernetes version of the cluster.
+	AddonVersionLatest = "latest"
+)
+
+// isAddonVersionAlias returns true if the supplied add-on version is an alias
+// to resolve, that is "default", "latest" or a version constraint, rather
+// than an exact version such as "v1.19.0-eksbuild.1".
+func isAddonVersionAlias(version *string) bool {
+	if version == nil {
+		return false
+	}
+	_, err := utilversion.ParseSemantic(*version)
+	return err != nil
+}
+
+// resolveAddonVersion resolves the version alias of the supplied add-on to the
+// add-on version it stands for, for the current Kubernetes version of the
+// cluster.
+func (rm *resourceManager) resolveAddonVersion(
+	ctx context.Context,
+	r *resource,
+) (version string, err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.resolveAddonVersion")
+	defer func() { exit(err) }()
+
+	cluster, err := rm.sdkapi.DescribeCluster(ctx, &svcsdk.DescribeClusterInput{
+		Name: r.ko.Spec.ClusterName,
+	})
+	rm.metrics.RecordAPICall("READ_ONE", "DescribeCluster", err)
+	if err != nil {
+		return "", err
+	}
+	clusterVersion := aws.ToString(cluster.Cluster.Version)
+
+	var versions []svcsdktypes.AddonVersionInfo
+	paginator := svcsdk.NewDescribeAddonVersionsPaginator(rm.sdkapi, &svcsdk.DescribeAddonVersionsInput{
+		AddonName:         r.ko.Spec.Name,
+		KubernetesVersion: &clusterVersion,
+	})
+	for paginator.HasMorePages() {
+		resp, err := paginator.NextPage(ctx)
+		rm.metrics.RecordAPICall("READ_MANY", "DescribeAddonVersions", err)
+		if err != nil {
+			return "", err
+		}
+		for _, addon := range resp.Addons {
+			if aws.ToString(addon.AddonName) == aws.ToString(r.ko.Spec.Name) {
+				versions = append(versions, addon.AddonVersions...)
+			}
+		}
+	}
+	return selectAddonVersion(*r.ko.Spec.AddonVersion, clusterVersion, versions)
+}
+
+// selectAddonVersion returns the add-on version the supplied alias stands for,
+// among the supplied add-on versions that are compatible with the supplied
+// Kubernetes version. It returns a terminal error if the alias is invalid or
+// if no version matches.
+func selectAddonVersion(
+	alias string,
+	clusterVersion string,
+	versions []svcsdktypes.AddonVersionInfo,
+) (string, error) {
+	selected := ""
+	for _, v := range versions {
+		version := aws.ToString(v.AddonVersion)
+		compatibility := findCompatibility(v.Compatibilities, clusterVersion)
+		if version == "" || compatibility == nil {
+			continue
+		}
+		switch alias {
+		case AddonVersionDefault:
+			if compatibility.DefaultVersion {
+				return version, nil
+			}
+			continue
+		case AddonVersionLatest:
+		default:
+			match, err := util.MatchAddonVersionConstraint(alias, version)
+			if err != nil {
+				return "", ackerr.NewTerminalError(err)
+			}
+			if !match {
+				continue
+			}
+		}
+		if selected == "" {
+			selected = version
+			continue
+		}
+		if cmp, err := util.CompareAddonVersions(version, selected); err == nil && cmp > 0 {
+			selected = version
+		}
+	}
+	if selected == "" {
+		return "", ackerr.NewTerminalError(fmt.Errorf(
+			"no add-on version matching %q is compatible with Kubernetes version %s", alias, clusterVersion,
+		))
+	}
+	return selected, nil
+}
+
+// findCompatibility returns the compatibility of an add-on version with the
+// supplied Kubernetes version, if any.
+func findCompatibility(
+	compatibilities []svcsdktypes.Compatibility,
+	clusterVersion string,
+) *svcsdktypes.Compatibility {
+	for i := range compatibilities {
+		if aws.ToString(compatibilities[i].ClusterVersion) == clusterVersion {
+			return &compatibilities[i]
+		}
+	}
+	return nil
+}
+
+// syncResolvedAddonVersion resolves the version alias of the desired add-on,
+// if any, and records the resolved version in the status of the latest
+// add-on.
+//
+// The alias is kept in the spec of the latest add-on, so that it doesn't show
+// as drift, unless the resolved version is newer than the installed version.
+// An add-on is never downgraded, so that an alias resolving to an older
+// version, such as "default" after a newer version was installed, is
+// satisfied by the installed version.
+func (rm *resourceManager) syncResolvedAddonVersion(
+	ctx context.Context,
+	desired *resource,
+	latest *resource,
+) error {
+	if !isAddonVersionAlias(desired.ko.Spec.AddonVersion) {
+		latest.ko.Status.ResolvedAddonVersion = nil
+		return nil
+	}
+	resolved, err := rm.resolveAddonVersion(ctx, desired)
+	if err != nil {
+		return err
+	}
+	latest.ko.Status.ResolvedAddonVersion = &resolved
+
+	installed := aws.ToString(latest.ko.Spec.AddonVersion)
+	if cmp, err := util.CompareAddonVersions(resolved, installed); err == nil && cmp > 0 {
+		return nil
+	}
+	latest.ko.Spec.AddonVersion = desired.ko.Spec.AddonVersion
+	return nil
+}
+
+// updateAddonVersion returns the add-on version to send to UpdateAddon. A
+// version alias is replaced with the version it resolved to when it shows
+// as drift, and left out of the update otherwise.
+func updateAddonVersion(
+	desired *resource,
+	latest *resource,
+	delta *ackcompare.Delta,
+) *string {
+	if !isAddonVersionAlias(desired.ko.Spec.AddonVersion) {
+		return desired.ko.Spec.AddonVersion
+	}
+	if delta.DifferentAt("Spec.AddonVersion") {
+		return latest.ko.Status.ResolvedAddonVersion
+	}
+	return nil
+}
@@ -0,0 +1,103 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package addon
+
+import (
+	"errors"
+	"testing"
+
+	ackcompare "github.com/aws-controllers-k8s/runtime/pkg/compare"
+	ackerr "github.com/aws-controllers-k8s/runtime/pkg/errors"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	svcsdktypes "github.com/aws/aws-sdk-go-v2/service/eks/types"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/aws-controllers-k8s/eks-controller/apis/v1alpha1"
+)
+
+func TestIsAddonVersionAlias(t *testing.T) {
+	assert.False(t, isAddonVersionAlias(nil))
+	assert.False(t, isAddonVersionAlias(aws.String("v1.19.0-eksbuild.1")))
+	assert.True(t, isAddonVersionAlias(aws.String("default")))
+	assert.True(t, isAddonVersionAlias(aws.String("latest")))
+	assert.True(t, isAddonVersionAlias(aws.String("~1.18")))
+}
+
+func TestSelectAddonVersion(t *testing.T) {
+	newVersion := func(version string, compatibilities ...svcsdktypes.Compatibility) svcsdktypes.AddonVersionInfo {
+		return svcsdktypes.AddonVersionInfo{
+			AddonVersion:    aws.String(version),
+			Compatibilities: compatibilities,
+		}
+	}
+	compatible := func(clusterVersion string, defaultVersion bool) svcsdktypes.Compatibility {
+		return svcsdktypes.Compatibility{
+			ClusterVersion: aws.String(clusterVersion),
+			DefaultVersion: defaultVersion,
+		}
+	}
+	versions := []svcsdktypes.AddonVersionInfo{
+		newVersion("v1.18.3-eksbuild.1", compatible("1.29", false), compatible("1.30", false)),
+		newVersion("v1.18.6-eksbuild.2", compatible("1.29", true), compatible("1.30", false)),
+		newVersion("v1.19.0-eksbuild.1", compatible("1.30", true)),
+		newVersion("v1.19.2-eksbuild.1", compatible("1.30", false)),
+	}
+
+	tests := []struct {
+		name           string
+		alias          string
+		clusterVersion string
+		want           string
+		wantErr        bool
+	}{
+		{"default", "default", "1.29", "v1.18.6-eksbuild.2", false},
+		{"default after a cluster upgrade", "default", "1.30", "v1.19.0-eksbuild.1", false},
+		{"latest", "latest", "1.29", "v1.18.6-eksbuild.2", false},
+		{"latest after a cluster upgrade", "latest", "1.30", "v1.19.2-eksbuild.1", false},
+		{"constraint", "~1.18", "1.30", "v1.18.6-eksbuild.2", false},
+		{"no compatible version", "~1.19", "1.29", "", true},
+		{"unknown cluster version", "latest", "1.31", "", true},
+		{"invalid constraint", "newest", "1.29", "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := selectAddonVersion(tt.alias, tt.clusterVersion, versions)
+			if tt.wantErr {
+				var terminalErr *ackerr.TerminalError
+				assert.True(t, errors.As(err, &terminalErr))
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestUpdateAddonVersion(t *testing.T) {
+	newResource := func(version string) *resource {
+		return &resource{ko: &v1alpha1.Addon{
+			Spec: v1alpha1.AddonSpec{AddonVersion: aws.String(version)},
+		}}
+	}
+	latest := newResource("latest")
+	latest.ko.Status.ResolvedAddonVersion = aws.String("v1.19.2-eksbuild.1")
+
+	drift := ackcompare.NewDelta()
+	drift.Add("Spec.AddonVersion", nil, nil)
+	noDrift := ackcompare.NewDelta()
+
+	assert.Equal(t, "v1.19.0-eksbuild.1", *updateAddonVersion(newResource("v1.19.0-eksbuild.1"), latest, noDrift))
+	assert.Equal(t, "v1.19.2-eksbuild.1", *updateAddonVersion(newResource("latest"), latest, drift))
+	assert.Nil(t, updateAddonVersion(newResource("latest"), latest, noDrift))
+}
@@ -0,0 +1,86 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package capability
+
+import (
+	"context"
+
+	ackcompare "github.com/aws-controllers-k8s/runtime/pkg/compare"
+	ackrtlog "github.com/aws-controllers-k8s/runtime/pkg/runtime/log"
+	"github.com/aws/aws-sdk-go-v2/aws"
+
+	"github.com/aws-controllers-k8s/eks-controller/pkg/dryrun"
+)
+
+// dryRunEnabled returns true if the supplied capability is annotated for
+// dry-run updates.
+func dryRunEnabled(r *resource) bool {
+	return dryrun.Enabled(r.ko)
+}
+
+// clearDryRunPlan removes the plan of a previous dry-run update from the
+// supplied capability.
+func clearDryRunPlan(r *resource) {
+	dryrun.Reset(r, &r.ko.Status.DryRunPlan)
+}
+
+// planUpdate computes the calls sdkUpdate would make to bring the latest
+// capability to the desired state, and publishes them in the status of the
+// returned resource instead of making them.
+func (rm *resourceManager) planUpdate(
+	ctx context.Context,
+	desired *resource,
+	latest *resource,
+	delta *ackcompare.Delta,
+) (updated *resource, err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.planUpdate")
+	defer func() { exit(err) }()
+
+	updatedRes := rm.concreteResource(desired.DeepCopy())
+	updatedRes.SetStatus(latest)
+
+	plan := dryrun.NewPlan()
+	// The update calls are made through a copy of the manager whose
+	// mutating calls are recorded in the plan instead of being sent.
+	dry := *rm
+	dry.sdkapi = plan.Client(rm.sdkapi)
+	mr := plan.Metrics(rm.metrics)
+	if delta.DifferentAt("Spec.Tags") {
+		err := dryrun.SyncTags(
+			ctx, dry.sdkapi, mr,
+			string(*latest.ko.Status.ACKResourceMetadata.ARN),
+			aws.ToStringMap(desired.ko.Spec.Tags), aws.ToStringMap(latest.ko.Spec.Tags),
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if delta.DifferentExcept("Spec.Tags") {
+		input, err := dry.newUpdateRequestPayload(ctx, desired, delta)
+		if err != nil {
+			return nil, err
+		}
+		setConfiguration(input, desired, latest)
+		_, err = dry.sdkapi.UpdateCapability(ctx, input)
+		mr.RecordAPICall("UPDATE", "UpdateCapability", err)
+		if err != nil && !dryrun.IsNotSent(err) {
+			return nil, err
+		}
+	}
+
+	plan.Publish(updatedRes, &updatedRes.ko.Status.DryRunPlan)
+	return updatedRes, nil
+}
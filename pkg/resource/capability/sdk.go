@@ -224,6 +224,8 @@ func (rm *resourceManager) sdkFind(
 	}
 
 	rm.setStatusDefaults(ko)
+	clearDryRunPlan(&resource{ko})
+	syncHealthConditions(ctx, &resource{ko})
 	return &resource{ko}, nil
 }
 
@@ -515,6 +517,9 @@ func (rm *resourceManager) sdkUpdate(
 	defer func() {
 		exit(err)
 	}()
+	if dryRunEnabled(desired) {
+		return rm.planUpdate(ctx, desired, latest, delta)
+	}
 	if delta.DifferentAt("Spec.Tags") {
 		err := syncTags(
 			ctx, rm.sdkapi, rm.metrics,
@@ -0,0 +1,380 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package cluster
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+
+	ackerr "github.com/aws-controllers-k8s/runtime/pkg/errors"
+	ackrequeue "github.com/aws-controllers-k8s/runtime/pkg/requeue"
+	ackrtlog "github.com/aws-controllers-k8s/runtime/pkg/runtime/log"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	svcsdk "github.com/aws/aws-sdk-go-v2/service/eks"
+	"github.com/aws/smithy-go"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	ctrlrtclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/aws-controllers-k8s/eks-controller/apis/v1alpha1"
+	"github.com/aws-controllers-k8s/eks-controller/pkg/kube"
+)
+
+// The kinds of children deleted by the Cascade deletion policy.
+const (
+	DeletionCascadeKindNodegroup              = "Nodegroup"
+	DeletionCascadeKindFargateProfile         = "FargateProfile"
+	DeletionCascadeKindAddon                  = "Addon"
+	DeletionCascadeKindPodIdentityAssociation = "PodIdentityAssociation"
+	DeletionCascadeKindAccessEntry            = "AccessEntry"
+)
+
+// The states of a child deleted by the Cascade deletion policy.
+const (
+	// DeletionCascadeStatePending means the child waits for the children it
+	// depends on, or for another Fargate profile, to be deleted.
+	DeletionCascadeStatePending = "Pending"
+	// DeletionCascadeStateDeleting means the deletion of the child was
+	// requested.
+	DeletionCascadeStateDeleting = "Deleting"
+)
+
+// deletionCascadeKinds is the order in which the Cascade deletion policy
+// deletes the children of a cluster: the compute first, then the add-ons
+// running on it and last the identities used to reach the cluster. A kind is
+// only deleted once no child of the previous kinds is left.
+var deletionCascadeKinds = []string{
+	DeletionCascadeKindNodegroup,
+	DeletionCascadeKindFargateProfile,
+	DeletionCascadeKindAddon,
+	DeletionCascadeKindPodIdentityAssociation,
+	DeletionCascadeKindAccessEntry,
+}
+
+// requeueWaitWhileDeletingChildren returns a `ackrequeue.RequeueNeededAfter`
+// explaining the cluster cannot be deleted until its children of the supplied
+// kind are gone.
+func requeueWaitWhileDeletingChildren(kind string) *ackrequeue.RequeueNeededAfter {
+	return ackrequeue.NeededAfter(
+		fmt.Errorf("waiting for the %s children of the cluster to be deleted", kind),
+		RequeueAfterUpdateDuration,
+	)
+}
+
+// clusterDeletionPolicy returns the deletion policy of the supplied cluster.
+// `spec.deletionPolicy` takes precedence over the deletion policy annotation.
+// An unknown value is a terminal error, the cluster is never deleted with a
+// policy the user didn't ask for.
+func clusterDeletionPolicy(r *resource) (string, error) {
+	policy := aws.ToString(r.ko.Spec.DeletionPolicy)
+	if policy == "" {
+		policy = r.ko.GetAnnotations()[v1alpha1.ClusterDeletionPolicyAnnotation]
+	}
+	switch policy {
+	case "":
+		return v1alpha1.DefaultClusterDeletionPolicy, nil
+	case v1alpha1.ClusterDeletionPolicyBlock,
+		v1alpha1.ClusterDeletionPolicyOrphan,
+		v1alpha1.ClusterDeletionPolicyCascade:
+		return policy, nil
+	}
+	return "", ackerr.NewTerminalError(fmt.Errorf(
+		"invalid cluster deletion policy %q, must be one of %s, %s or %s", policy,
+		v1alpha1.ClusterDeletionPolicyBlock, v1alpha1.ClusterDeletionPolicyOrphan, v1alpha1.ClusterDeletionPolicyCascade,
+	))
+}
+
+// newDeletionCascadeProgress returns the deletion progress of the supplied
+// children, keyed by kind, along with the kind being deleted. The children
+// of the first kind that has any are being deleted, except for Fargate
+// profiles which EKS deletes one at a time. The others are pending.
+func newDeletionCascadeProgress(
+	children map[string][]string,
+) (progress []*v1alpha1.DeletionCascadeChild, current string) {
+	for _, kind := range deletionCascadeKinds {
+		for i, name := range children[kind] {
+			if current == "" {
+				current = kind
+			}
+			state := DeletionCascadeStatePending
+			if kind == current && (kind != DeletionCascadeKindFargateProfile || i == 0) {
+				state = DeletionCascadeStateDeleting
+			}
+			progress = append(progress, &v1alpha1.DeletionCascadeChild{
+				Kind:  aws.String(kind),
+				Name:  aws.String(name),
+				State: aws.String(state),
+			})
+		}
+	}
+	return progress, current
+}
+
+// cascadeDelete deletes the next kind of children of the supplied cluster and
+// records the progress in the cluster status. It returns a requeue error as
+// long as any child is left, and nil once the cluster can be deleted.
+func (rm *resourceManager) cascadeDelete(
+	ctx context.Context,
+	r *resource,
+) (err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.cascadeDelete")
+	defer func() { exit(err) }()
+
+	children, err := rm.listDeletionCascadeChildren(ctx, r)
+	if err != nil {
+		return err
+	}
+	progress, current := newDeletionCascadeProgress(children)
+	r.ko.Status.DeletionCascadeProgress = progress
+	if current == "" {
+		return nil
+	}
+
+	if err := rm.deleteChildResources(ctx, r, current); err != nil {
+		return err
+	}
+	for _, child := range progress {
+		if *child.Kind != current || *child.State != DeletionCascadeStateDeleting {
+			continue
+		}
+		if err := rm.deleteCascadeChild(ctx, r, current, *child.Name); err != nil {
+			return err
+		}
+	}
+	return requeueWaitWhileDeletingChildren(current)
+}
+
+// listDeletionCascadeChildren returns the names of the children of the
+// supplied cluster, keyed by kind and sorted.
+func (rm *resourceManager) listDeletionCascadeChildren(
+	ctx context.Context,
+	r *resource,
+) (map[string][]string, error) {
+	children := map[string][]string{}
+
+	nodegroups, err := rm.listNodegroupNames(ctx, r)
+	if err != nil {
+		return nil, err
+	}
+	children[DeletionCascadeKindNodegroup] = nodegroups
+
+	profiles := svcsdk.NewListFargateProfilesPaginator(rm.sdkapi, &svcsdk.ListFargateProfilesInput{
+		ClusterName: r.ko.Spec.Name,
+	})
+	for profiles.HasMorePages() {
+		resp, err := profiles.NextPage(ctx)
+		rm.metrics.RecordAPICall("READ_MANY", "ListFargateProfiles", err)
+		if err != nil {
+			return nil, err
+		}
+		children[DeletionCascadeKindFargateProfile] = append(
+			children[DeletionCascadeKindFargateProfile], resp.FargateProfileNames...,
+		)
+	}
+
+	addons, err := rm.listAddonNames(ctx, r)
+	if err != nil {
+		return nil, err
+	}
+	children[DeletionCascadeKindAddon] = addons
+
+	associations := svcsdk.NewListPodIdentityAssociationsPaginator(rm.sdkapi, &svcsdk.ListPodIdentityAssociationsInput{
+		ClusterName: r.ko.Spec.Name,
+	})
+	for associations.HasMorePages() {
+		resp, err := associations.NextPage(ctx)
+		rm.metrics.RecordAPICall("READ_MANY", "ListPodIdentityAssociations", err)
+		if err != nil {
+			return nil, err
+		}
+		for _, association := range resp.Associations {
+			if association.AssociationId != nil {
+				children[DeletionCascadeKindPodIdentityAssociation] = append(
+					children[DeletionCascadeKindPodIdentityAssociation], *association.AssociationId,
+				)
+			}
+		}
+	}
+
+	entries := svcsdk.NewListAccessEntriesPaginator(rm.sdkapi, &svcsdk.ListAccessEntriesInput{
+		ClusterName: r.ko.Spec.Name,
+	})
+	for entries.HasMorePages() {
+		resp, err := entries.NextPage(ctx)
+		rm.metrics.RecordAPICall("READ_MANY", "ListAccessEntries", err)
+		if err != nil {
+			return nil, err
+		}
+		children[DeletionCascadeKindAccessEntry] = append(
+			children[DeletionCascadeKindAccessEntry], resp.AccessEntries...,
+		)
+	}
+
+	for _, names := range children {
+		sort.Strings(names)
+	}
+	return children, nil
+}
+
+// listNodegroupNames returns the names of all the node groups of the
+// supplied cluster.
+func (rm *resourceManager) listNodegroupNames(
+	ctx context.Context,
+	r *resource,
+) ([]string, error) {
+	names := []string{}
+	paginator := svcsdk.NewListNodegroupsPaginator(rm.sdkapi, &svcsdk.ListNodegroupsInput{
+		ClusterName: r.ko.Spec.Name,
+	})
+	for paginator.HasMorePages() {
+		resp, err := paginator.NextPage(ctx)
+		rm.metrics.RecordAPICall("READ_MANY", "ListNodegroups", err)
+		if err != nil {
+			return nil, err
+		}
+		names = append(names, resp.Nodegroups...)
+	}
+	return names, nil
+}
+
+// listAddonNames returns the names of all the add-ons of the supplied
+// cluster.
+func (rm *resourceManager) listAddonNames(
+	ctx context.Context,
+	r *resource,
+) ([]string, error) {
+	names := []string{}
+	paginator := svcsdk.NewListAddonsPaginator(rm.sdkapi, &svcsdk.ListAddonsInput{
+		ClusterName: r.ko.Spec.Name,
+	})
+	for paginator.HasMorePages() {
+		resp, err := paginator.NextPage(ctx)
+		rm.metrics.RecordAPICall("READ_MANY", "ListAddons", err)
+		if err != nil {
+			return nil, err
+		}
+		names = append(names, resp.Addons...)
+	}
+	return names, nil
+}
+
+// deleteCascadeChild requests the deletion of a child of the supplied
+// cluster. Children that are already gone, or already being deleted, are
+// ignored.
+func (rm *resourceManager) deleteCascadeChild(
+	ctx context.Context,
+	r *resource,
+	kind string,
+	name string,
+) (err error) {
+	switch kind {
+	case DeletionCascadeKindNodegroup:
+		_, err = rm.sdkapi.DeleteNodegroup(ctx, &svcsdk.DeleteNodegroupInput{
+			ClusterName:   r.ko.Spec.Name,
+			NodegroupName: aws.String(name),
+		})
+		rm.metrics.RecordAPICall("DELETE", "DeleteNodegroup", err)
+	case DeletionCascadeKindFargateProfile:
+		_, err = rm.sdkapi.DeleteFargateProfile(ctx, &svcsdk.DeleteFargateProfileInput{
+			ClusterName:        r.ko.Spec.Name,
+			FargateProfileName: aws.String(name),
+		})
+		rm.metrics.RecordAPICall("DELETE", "DeleteFargateProfile", err)
+	case DeletionCascadeKindAddon:
+		_, err = rm.sdkapi.DeleteAddon(ctx, &svcsdk.DeleteAddonInput{
+			ClusterName: r.ko.Spec.Name,
+			AddonName:   aws.String(name),
+		})
+		rm.metrics.RecordAPICall("DELETE", "DeleteAddon", err)
+	case DeletionCascadeKindPodIdentityAssociation:
+		_, err = rm.sdkapi.DeletePodIdentityAssociation(ctx, &svcsdk.DeletePodIdentityAssociationInput{
+			ClusterName:   r.ko.Spec.Name,
+			AssociationId: aws.String(name),
+		})
+		rm.metrics.RecordAPICall("DELETE", "DeletePodIdentityAssociation", err)
+	case DeletionCascadeKindAccessEntry:
+		_, err = rm.sdkapi.DeleteAccessEntry(ctx, &svcsdk.DeleteAccessEntryInput{
+			ClusterName:  r.ko.Spec.Name,
+			PrincipalArn: aws.String(name),
+		})
+		rm.metrics.RecordAPICall("DELETE", "DeleteAccessEntry", err)
+	}
+	var awsErr smithy.APIError
+	if errors.As(err, &awsErr) {
+		switch awsErr.ErrorCode() {
+		case "ResourceNotFoundException", "ResourceInUseException":
+			return nil
+		}
+	}
+	return err
+}
+
+// deleteChildResources deletes the custom resources managing the children of
+// the supplied kind of the cluster, so they don't recreate the children the
+// Cascade deletion policy deletes.
+func (rm *resourceManager) deleteChildResources(
+	ctx context.Context,
+	r *resource,
+	kind string,
+) error {
+	cache, err := kube.ClusterCache(rm.cfg)
+	if err != nil {
+		return err
+	}
+	objs, err := childResources(ctx, cache, r, kind)
+	if err != nil {
+		return err
+	}
+	kc, err := kube.Client()
+	if err != nil {
+		return err
+	}
+	for _, obj := range objs {
+		if obj.GetDeletionTimestamp() != nil {
+			continue
+		}
+		if err := kc.Delete(ctx, obj); err != nil && !apierrors.IsNotFound(err) {
+			return err
+		}
+	}
+	return nil
+}
+
+// childResources returns the custom resources of the supplied kind that
+// reference the cluster.
+func childResources(
+	ctx context.Context,
+	kc ctrlrtclient.Reader,
+	r *resource,
+	kind string,
+) ([]ctrlrtclient.Object, error) {
+	var newList func() ctrlrtclient.ObjectList
+	switch kind {
+	case DeletionCascadeKindNodegroup:
+		newList = func() ctrlrtclient.ObjectList { return &v1alpha1.NodegroupList{} }
+	case DeletionCascadeKindFargateProfile:
+		newList = func() ctrlrtclient.ObjectList { return &v1alpha1.FargateProfileList{} }
+	case DeletionCascadeKindAddon:
+		newList = func() ctrlrtclient.ObjectList { return &v1alpha1.AddonList{} }
+	case DeletionCascadeKindPodIdentityAssociation:
+		newList = func() ctrlrtclient.ObjectList { return &v1alpha1.PodIdentityAssociationList{} }
+	case DeletionCascadeKindAccessEntry:
+		newList = func() ctrlrtclient.ObjectList { return &v1alpha1.AccessEntryList{} }
+	default:
+		return nil, nil
+	}
+	return kube.ListClusterChildren(ctx, kc, r.ko, newList)
+}
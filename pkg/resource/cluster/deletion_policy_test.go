@@ -0,0 +1,150 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package cluster
+
+import (
+	"context"
+	"testing"
+
+	ackv1alpha1 "github.com/aws-controllers-k8s/runtime/apis/core/v1alpha1"
+	ackerr "github.com/aws-controllers-k8s/runtime/pkg/errors"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/aws-controllers-k8s/eks-controller/apis/v1alpha1"
+	"github.com/aws-controllers-k8s/eks-controller/pkg/testutil"
+)
+
+func TestClusterDeletionPolicy(t *testing.T) {
+	tests := []struct {
+		name       string
+		spec       *string
+		annotation string
+		want       string
+		wantErr    bool
+	}{
+		{"default", nil, "", v1alpha1.ClusterDeletionPolicyBlock, false},
+		{"annotation", nil, "Cascade", v1alpha1.ClusterDeletionPolicyCascade, false},
+		{"spec wins over annotation", aws.String("Orphan"), "Cascade", v1alpha1.ClusterDeletionPolicyOrphan, false},
+		{"invalid annotation", nil, "cascade", "", true},
+		{"invalid spec", aws.String("Delete"), "Block", "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ko := &v1alpha1.Cluster{}
+			ko.Spec.DeletionPolicy = tt.spec
+			if tt.annotation != "" {
+				ko.SetAnnotations(map[string]string{
+					v1alpha1.ClusterDeletionPolicyAnnotation: tt.annotation,
+				})
+			}
+			got, err := clusterDeletionPolicy(&resource{ko})
+			if tt.wantErr {
+				var terminal *ackerr.TerminalError
+				assert.ErrorAs(t, err, &terminal)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestNewDeletionCascadeProgress(t *testing.T) {
+	states := func(progress []*v1alpha1.DeletionCascadeChild) []string {
+		got := []string{}
+		for _, c := range progress {
+			got = append(got, *c.Kind+"/"+*c.Name+"="+*c.State)
+		}
+		return got
+	}
+
+	progress, current := newDeletionCascadeProgress(map[string][]string{
+		DeletionCascadeKindAccessEntry: {"arn:aws:iam::111122223333:role/admin"},
+		DeletionCascadeKindNodegroup:   {"ng-1", "ng-2"},
+		DeletionCascadeKindAddon:       {"vpc-cni"},
+	})
+	assert.Equal(t, DeletionCascadeKindNodegroup, current)
+	assert.Equal(t, []string{
+		"Nodegroup/ng-1=Deleting",
+		"Nodegroup/ng-2=Deleting",
+		"Addon/vpc-cni=Pending",
+		"AccessEntry/arn:aws:iam::111122223333:role/admin=Pending",
+	}, states(progress))
+
+	progress, current = newDeletionCascadeProgress(map[string][]string{
+		DeletionCascadeKindFargateProfile: {"fp-1", "fp-2"},
+	})
+	assert.Equal(t, DeletionCascadeKindFargateProfile, current)
+	assert.Equal(t, []string{
+		"FargateProfile/fp-1=Deleting",
+		"FargateProfile/fp-2=Pending",
+	}, states(progress))
+
+	progress, current = newDeletionCascadeProgress(map[string][]string{})
+	assert.Empty(t, current)
+	assert.Empty(t, progress)
+}
+
+func TestChildResources(t *testing.T) {
+	accountID := ackv1alpha1.AWSAccountID("111122223333")
+	region := ackv1alpha1.AWSRegion("us-west-2")
+	otherRegion := ackv1alpha1.AWSRegion("eu-west-1")
+	cluster := &v1alpha1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-cluster", Namespace: "default"},
+	}
+	cluster.Spec.Name = aws.String("my-eks-cluster")
+	cluster.Status.ACKResourceMetadata = &ackv1alpha1.ResourceMetadata{OwnerAccountID: &accountID, Region: &region}
+
+	byName := &v1alpha1.Addon{
+		ObjectMeta: metav1.ObjectMeta{Name: "by-name", Namespace: "other"},
+	}
+	byName.Spec.ClusterName = aws.String("my-eks-cluster")
+	byName.Status.ACKResourceMetadata = &ackv1alpha1.ResourceMetadata{OwnerAccountID: &accountID, Region: &region}
+	otherRegionByName := &v1alpha1.Addon{
+		ObjectMeta: metav1.ObjectMeta{Name: "other-region-by-name", Namespace: "other"},
+	}
+	otherRegionByName.Spec.ClusterName = aws.String("my-eks-cluster")
+	otherRegionByName.Status.ACKResourceMetadata = &ackv1alpha1.ResourceMetadata{OwnerAccountID: &accountID, Region: &otherRegion}
+	byRef := &v1alpha1.Addon{
+		ObjectMeta: metav1.ObjectMeta{Name: "by-ref", Namespace: "default"},
+	}
+	byRef.Spec.ClusterRef = &ackv1alpha1.AWSResourceReferenceWrapper{
+		From: &ackv1alpha1.AWSResourceReference{Name: aws.String("my-cluster")},
+	}
+	unrelated := &v1alpha1.Addon{
+		ObjectMeta: metav1.ObjectMeta{Name: "unrelated", Namespace: "default"},
+	}
+	unrelated.Spec.ClusterName = aws.String("another-cluster")
+	nodegroup := &v1alpha1.Nodegroup{
+		ObjectMeta: metav1.ObjectMeta{Name: "ng", Namespace: "default"},
+	}
+	nodegroup.Spec.ClusterName = aws.String("my-eks-cluster")
+
+	kc := testutil.NewKubeClient(byName, otherRegionByName, byRef, unrelated, nodegroup)
+
+	objs, err := childResources(context.TODO(), kc, &resource{cluster}, DeletionCascadeKindAddon)
+	require.NoError(t, err)
+	names := []string{}
+	for _, obj := range objs {
+		names = append(names, obj.GetName())
+	}
+	assert.ElementsMatch(t, []string{"by-name", "by-ref"}, names)
+
+	objs, err = childResources(context.TODO(), kc, &resource{cluster}, DeletionCascadeKindAccessEntry)
+	require.NoError(t, err)
+	assert.Empty(t, objs)
+}
@@ -41,7 +41,7 @@ func newResourceDelta(
 		delta.Add("", a, b)
 		return delta
 	}
-	customPreCompare(a, b)
+	customPreCompare(delta, a, b)
 
 	if ackcompare.HasNilDifference(a.ko.Spec.AccessConfig, b.ko.Spec.AccessConfig) {
 		delta.Add("Spec.AccessConfig", a.ko.Spec.AccessConfig, b.ko.Spec.AccessConfig)
@@ -257,24 +257,6 @@ func newResourceDelta(
 			}
 		}
 	}
-	if ackcompare.HasNilDifference(a.ko.Spec.RemoteNetworkConfig, b.ko.Spec.RemoteNetworkConfig) {
-		delta.Add("Spec.RemoteNetworkConfig", a.ko.Spec.RemoteNetworkConfig, b.ko.Spec.RemoteNetworkConfig)
-	} else if a.ko.Spec.RemoteNetworkConfig != nil && b.ko.Spec.RemoteNetworkConfig != nil {
-		if len(a.ko.Spec.RemoteNetworkConfig.RemoteNodeNetworks) != len(b.ko.Spec.RemoteNetworkConfig.RemoteNodeNetworks) {
-			delta.Add("Spec.RemoteNetworkConfig.RemoteNodeNetworks", a.ko.Spec.RemoteNetworkConfig.RemoteNodeNetworks, b.ko.Spec.RemoteNetworkConfig.RemoteNodeNetworks)
-		} else if len(a.ko.Spec.RemoteNetworkConfig.RemoteNodeNetworks) > 0 {
-			if !equality.Semantic.Equalities.DeepEqual(a.ko.Spec.RemoteNetworkConfig.RemoteNodeNetworks, b.ko.Spec.RemoteNetworkConfig.RemoteNodeNetworks) {
-				delta.Add("Spec.RemoteNetworkConfig.RemoteNodeNetworks", a.ko.Spec.RemoteNetworkConfig.RemoteNodeNetworks, b.ko.Spec.RemoteNetworkConfig.RemoteNodeNetworks)
-			}
-		}
-		if len(a.ko.Spec.RemoteNetworkConfig.RemotePodNetworks) != len(b.ko.Spec.RemoteNetworkConfig.RemotePodNetworks) {
-			delta.Add("Spec.RemoteNetworkConfig.RemotePodNetworks", a.ko.Spec.RemoteNetworkConfig.RemotePodNetworks, b.ko.Spec.RemoteNetworkConfig.RemotePodNetworks)
-		} else if len(a.ko.Spec.RemoteNetworkConfig.RemotePodNetworks) > 0 {
-			if !equality.Semantic.Equalities.DeepEqual(a.ko.Spec.RemoteNetworkConfig.RemotePodNetworks, b.ko.Spec.RemoteNetworkConfig.RemotePodNetworks) {
-				delta.Add("Spec.RemoteNetworkConfig.RemotePodNetworks", a.ko.Spec.RemoteNetworkConfig.RemotePodNetworks, b.ko.Spec.RemoteNetworkConfig.RemotePodNetworks)
-			}
-		}
-	}
 	if ackcompare.HasNilDifference(a.ko.Spec.ResourcesVPCConfig, b.ko.Spec.ResourcesVPCConfig) {
 		delta.Add("Spec.ResourcesVPCConfig", a.ko.Spec.ResourcesVPCConfig, b.ko.Spec.ResourcesVPCConfig)
 	} else if a.ko.Spec.ResourcesVPCConfig != nil && b.ko.Spec.ResourcesVPCConfig != nil {
@@ -0,0 +1,105 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package cluster
+
+import (
+	"context"
+	"errors"
+
+	ackcompare "github.com/aws-controllers-k8s/runtime/pkg/compare"
+	ackcondition "github.com/aws-controllers-k8s/runtime/pkg/condition"
+	ackrequeue "github.com/aws-controllers-k8s/runtime/pkg/requeue"
+	ackrtlog "github.com/aws-controllers-k8s/runtime/pkg/runtime/log"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/aws-controllers-k8s/eks-controller/pkg/dryrun"
+)
+
+// clearDryRunPlan removes the plan of a previous dry-run update from the
+// supplied cluster.
+func clearDryRunPlan(r *resource) {
+	dryrun.Reset(r, &r.ko.Status.DryRunPlan)
+}
+
+// planUpdate computes the calls customUpdate would make to bring the latest
+// cluster to the desired state, and publishes them in the status of
+// updatedRes instead of making them.
+//
+// Every step of the update plan is planned, including the ones that would
+// wait for a previous step to complete or for their gate, such as the
+// maintenance window, to open.
+func (rm *resourceManager) planUpdate(
+	ctx context.Context,
+	desired *resource,
+	latest *resource,
+	updatedRes *resource,
+	delta *ackcompare.Delta,
+) (updated *resource, err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.planUpdate")
+	defer func() { exit(err) }()
+
+	if delta.DifferentAt("Spec.EncryptionConfig") {
+		if msg := validateEncryptionConfigUpdate(desired, latest); msg != "" {
+			ackcondition.SetTerminal(updatedRes, corev1.ConditionTrue, &msg, nil)
+			return updatedRes, nil
+		}
+	}
+	if delta.DifferentAt("Spec.Version") {
+		if err := rm.validateVersion(ctx, desired.ko.Spec.Version); err != nil {
+			return nil, err
+		}
+	}
+
+	plan := dryrun.NewPlan()
+	// The update calls are made through a copy of the manager whose
+	// mutating calls are recorded in the plan instead of being sent.
+	dry := *rm
+	dry.sdkapi = plan.Client(rm.sdkapi)
+	mr := plan.Metrics(rm.metrics)
+	if delta.DifferentAt("Spec.Tags") {
+		err := dryrun.SyncTags(
+			ctx,
+			dry.sdkapi,
+			mr,
+			string(*latest.ko.Status.ACKResourceMetadata.ARN),
+			aws.ToStringMap(desired.ko.Spec.Tags),
+			aws.ToStringMap(latest.ko.Spec.Tags),
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// The steps record their progress in the resource they are given, which
+	// must not leak into the status of the cluster.
+	scratch := &resource{updatedRes.ko.DeepCopy()}
+	for _, step := range newUpdatePlan(delta) {
+		_, err := step.apply(&dry, ctx, desired, latest, scratch, delta)
+		if err == nil || dryrun.IsNotSent(err) {
+			continue
+		}
+		// A step waiting for an update in progress, such as a cascading
+		// upgrade, ends the plan.
+		var requeueErr *ackrequeue.RequeueNeededAfter
+		if errors.As(err, &requeueErr) {
+			break
+		}
+		return nil, err
+	}
+
+	plan.Publish(updatedRes, &updatedRes.ko.Status.DryRunPlan)
+	return updatedRes, nil
+}
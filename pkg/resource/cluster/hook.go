@@ -33,6 +33,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/aws-controllers-k8s/eks-controller/apis/v1alpha1"
+	"github.com/aws-controllers-k8s/eks-controller/pkg/dryrun"
 	"github.com/aws-controllers-k8s/eks-controller/pkg/tags"
 	"github.com/aws-controllers-k8s/eks-controller/pkg/util"
 )
@@ -185,6 +186,7 @@ func (rm *resourceManager) clusterInUse(ctx context.Context, r *resource) (bool,
 }
 
 func customPreCompare(
+	delta *ackcompare.Delta,
 	a *resource,
 	b *resource,
 ) {
@@ -194,6 +196,14 @@ func customPreCompare(
 	if a.ko.Spec.ControlPlaneScalingConfig == nil && b.ko.Spec.ControlPlaneScalingConfig != nil {
 		a.ko.Spec.ControlPlaneScalingConfig = b.ko.Spec.ControlPlaneScalingConfig.DeepCopy()
 	}
+	if !remoteNetworkConfigEqual(a.ko.Spec.RemoteNetworkConfig, b.ko.Spec.RemoteNetworkConfig) {
+		delta.Add("Spec.RemoteNetworkConfig", a.ko.Spec.RemoteNetworkConfig, b.ko.Spec.RemoteNetworkConfig)
+	}
+	// The cascading upgrade has no counterpart in EKS, it is driven from the
+	// progress observed during the last read.
+	if upgradeCascadeEnabled(a) && upgradeCascadeInProgress(b.ko.Status.UpgradeCascadeProgress) {
+		delta.Add("Spec.UpgradeCascade", a.ko.Spec.UpgradeCascade, b.ko.Spec.UpgradeCascade)
+	}
 }
 
 func (rm *resourceManager) customUpdate(
@@ -212,6 +222,7 @@ func (rm *resourceManager) customUpdate(
 	// obtain correct spec fields and then copy the status from latest.
 	updatedRes := rm.concreteResource(desired.DeepCopy())
 	updatedRes.SetStatus(latest)
+	updatedRes.ko.Status.UpdatePlan = describeUpdatePlan(newUpdatePlan(delta))
 	if clusterDeleting(latest) {
 		msg := "Cluster is currently being deleted"
 		ackcondition.SetSynced(updatedRes, corev1.ConditionFalse, &msg, nil)
@@ -227,6 +238,10 @@ func (rm *resourceManager) customUpdate(
 		return updatedRes, requeueWaitUntilCanModify(latest)
 	}
 
+	if dryrun.Enabled(desired.ko) {
+		return rm.planUpdate(ctx, desired, latest, updatedRes, delta)
+	}
+
 	// Sync tags if they have changed
 	if delta.DifferentAt("Spec.Tags") {
 		err := tags.SyncTags(
@@ -242,235 +257,52 @@ func (rm *resourceManager) customUpdate(
 		}
 	}
 
-	// If no changes except tags, return the desired state
+	// If no changes except tags, there is nothing left to plan
 	if !delta.DifferentExcept("Spec.Tags") {
-		return desired, nil
-	}
-
-	// Handle logging configuration updates
-	if delta.DifferentAt("Spec.Logging") {
-		if err := rm.updateConfigLogging(ctx, desired); err != nil {
-			awsErr, ok := extractAWSError(err)
-
-			// The API responds with an error if there were no changes applied
-			if !ok || awsErr.Message != LoggingNoChangesError {
-				return nil, err
-			}
-
-			// Check to see if we've raced an async update call and need to requeue
-			if ok && awsErr.Code == "ResourceInUseException" {
-				return nil, requeueAfterAsyncUpdate()
-			}
-		}
-		return returnClusterUpdating(updatedRes)
-	}
-
-	// Handle VPC configuration updates for public and private access
-	if delta.DifferentAt("Spec.ResourcesVPCConfig.EndpointPrivateAccess") ||
-		delta.DifferentAt("Spec.ResourcesVPCConfig.EndpointPublicAccess") ||
-		delta.DifferentAt("Spec.ResourcesVPCConfig.PublicAccessCIDRs") {
-		if err := rm.updateConfigResourcesVPCConfigPublicAndPrivateAccess(ctx, desired); err != nil {
-			awsErr, ok := extractAWSError(err)
-
-			// Check to see if we've raced an async update call and need to requeue
-			if ok && awsErr.Code == "ResourceInUseException" {
-				return nil, requeueAfterAsyncUpdate()
-			}
-
-			return nil, err
-		}
-		return returnClusterUpdating(updatedRes)
-	}
-
-	// Handle VPC configuration updates for subnets and security groups
-	if delta.DifferentAt("Spec.ResourcesVPCConfig.SecurityGroupIDs") ||
-		delta.DifferentAt("Spec.ResourcesVPCConfig.SecurityGroupRefs") ||
-		delta.DifferentAt("Spec.ResourcesVPCConfig.SubnetIDs") ||
-		delta.DifferentAt("Spec.ResourcesVPCConfig.SubnetRefs") {
-		if err := rm.updateConfigResourcesVPCConfigSubnetsAndSecurityGroups(ctx, desired); err != nil {
-			awsErr, ok := extractAWSError(err)
-
-			// Check to see if we've raced an async update call and need to requeue
-			if ok && awsErr.Code == "ResourceInUseException" {
-				return nil, requeueAfterAsyncUpdate()
-			}
-
-			return nil, err
-		}
-		return returnClusterUpdating(updatedRes)
+		updatedRes.ko.Status.UpdatePlan = nil
+		return updatedRes, nil
 	}
 
-	// Handle access configuration updates
-	if delta.DifferentAt("Spec.AccessConfig") {
-		if err := rm.updateAccessConfig(ctx, desired); err != nil {
-			awsErr, ok := extractAWSError(err)
-
-			// Check to see if we've raced an async update call and need to requeue
-			if ok && awsErr.Code == "ResourceInUseException" {
-				return nil, requeueAfterAsyncUpdate()
-			}
-			return nil, err
-		}
-		return returnClusterUpdating(updatedRes)
-	}
-
-	// Handle upgrade policy updates
-	if delta.DifferentAt("Spec.UpgradePolicy") {
-		if err := rm.updateClusterUpgradePolicy(ctx, desired); err != nil {
-			awsErr, ok := extractAWSError(err)
-
-			// Check to see if we've raced an async update call and need to requeue
-			if ok && awsErr.Code == "ResourceInUseException" {
-				return nil, requeueAfterAsyncUpdate()
-			}
-			return nil, err
-		}
-		return returnClusterUpdating(updatedRes)
-	}
-
-	// Handle encryption configuration updates
 	if delta.DifferentAt("Spec.EncryptionConfig") {
-		// Set a terminal condition if the observed cluster has encryption
-		// config and the desired cluster does not.
-		if len(latest.ko.Spec.EncryptionConfig) > 0 && len(desired.ko.Spec.EncryptionConfig) == 0 {
-			msg := "Encryption configuration cannot be removed from an existing cluster"
+		if msg := validateEncryptionConfigUpdate(desired, latest); msg != "" {
 			ackcondition.SetTerminal(updatedRes, corev1.ConditionTrue, &msg, nil)
 			return updatedRes, nil
 		}
-		// Set a terminal condition if the user tries to patch the encryption
-		// config of an existing cluster.
-		if len(latest.ko.Spec.EncryptionConfig) == 1 && len(desired.ko.Spec.EncryptionConfig) == 1 {
-			msg := "Encryption configuration cannot be updated"
-			ackcondition.SetTerminal(updatedRes, corev1.ConditionTrue, &msg, nil)
-			return updatedRes, nil
-		}
-		// Set a terminal condition if the user tries to add a second encryption
-		// config to an existing cluster.
-		if len(latest.ko.Spec.EncryptionConfig) == 0 && len(desired.ko.Spec.EncryptionConfig) > 1 {
-			msg := "Only one encryption configuration is allowed"
-			ackcondition.SetTerminal(updatedRes, corev1.ConditionTrue, &msg, nil)
-			return updatedRes, nil
-		}
-
-		if err := rm.associateEncryptionConfig(ctx, desired); err != nil {
-			awsErr, ok := extractAWSError(err)
-
-			// Check to see if we've raced an async update call and need to requeue
-			if ok && awsErr.Code == "ResourceInUseException" {
-				return nil, requeueAfterAsyncUpdate()
-			}
-
-			return nil, err
-		}
-		// This doesn't reflect the actual status of the cluster, so we have to explicitly
-		// requeue and set the status to updating.
-		updatedRes.ko.Status.Status = aws.String(string(svcsdktypes.ClusterStatusUpdating))
-		return returnClusterUpdating(updatedRes)
 	}
-
-	// Handle version updates
 	if delta.DifferentAt("Spec.Version") {
-		if err := rm.updateVersion(ctx, desired, latest); err != nil {
-			awsErr, ok := extractAWSError(err)
-
-			// Check to see if we've raced an async update call and need to requeue
-			if ok && awsErr.Code == "ResourceInUseException" {
-				return nil, requeueAfterAsyncUpdate()
-			}
-
+		if err := rm.validateVersion(ctx, desired.ko.Spec.Version); err != nil {
 			return nil, err
 		}
-		return returnClusterUpdating(updatedRes)
-	}
-
-	// Handle computeConfig updates
-	if delta.DifferentAt("Spec.ComputeConfig") || delta.DifferentAt("Spec.StorageConfig") || delta.DifferentAt("Spec.KubernetesNetworkConfig") {
-		if err := rm.updateComputeConfig(ctx, desired); err != nil {
-			awsErr, ok := extractAWSError(err)
-			rlog.Info("attempting to update AutoMode config",
-				"error", err,
-				"isAWSError", ok,
-				"awsErrorCode", awsErr.Code)
-
-			// Check to see if we've raced an async update call and need to requeue
-			if ok && awsErr.Code == "ResourceInUseException" {
-				rlog.Info("resource in use, requeueing after async update")
-				return nil, requeueAfterAsyncUpdate()
-			}
-
-			return nil, fmt.Errorf("failed to update AutoMode config: %w", err)
-		}
-
-		return returnClusterUpdating(updatedRes)
 	}
 
-	// Handle zonalShiftConfig updates
-	if delta.DifferentAt("Spec.ZonalShiftConfig") {
-		if err := rm.updateZonalShiftConfig(ctx, desired); err != nil {
-			awsErr, ok := extractAWSError(err)
-
-			// Check to see if we've raced an async update call and need to requeue
-			if ok && awsErr.Code == "ResourceInUseException" {
-				return nil, requeueAfterAsyncUpdate()
-			}
-
-			return nil, err
-		}
-		return returnClusterUpdating(updatedRes)
+	updatedRes, err = rm.runUpdatePlan(ctx, desired, latest, updatedRes, delta, newUpdatePlan(delta))
+	if err != nil {
+		return updatedRes, err
 	}
 
-	// Handle controlPlaneScalingConfig updates
-	if delta.DifferentAt("Spec.ControlPlaneScalingConfig") {
-		if err := rm.updateControlPlaneScalingConfig(ctx, desired); err != nil {
-			awsErr, ok := extractAWSError(err)
-
-			// Check to see if we've raced an async update call and need to requeue
-			if ok && awsErr.Code == "ResourceInUseException" {
-				return nil, requeueAfterAsyncUpdate()
-			}
+	// Set default status values and return the updated resource
+	rm.setStatusDefaults(updatedRes.ko)
+	return updatedRes, nil
+}
 
-			return nil, err
-		}
-		return returnClusterUpdating(updatedRes)
+// validateEncryptionConfigUpdate returns a message explaining why the
+// encryption configuration of the latest cluster cannot be changed to the
+// desired one, or an empty string if the change is allowed.
+func validateEncryptionConfigUpdate(desired, latest *resource) string {
+	// The observed cluster has encryption config and the desired cluster does
+	// not.
+	if len(latest.ko.Spec.EncryptionConfig) > 0 && len(desired.ko.Spec.EncryptionConfig) == 0 {
+		return "Encryption configuration cannot be removed from an existing cluster"
 	}
-
-	// Handle Kubernetes control plane component config updates. These three
-	// configs (kube-apiserver, kube-scheduler, kube-controller-manager) are a
-	// single logical unit sent in one UpdateClusterConfig call.
-	if delta.DifferentAt("Spec.KubeAPIServerConfig") ||
-		delta.DifferentAt("Spec.KubeSchedulerConfig") ||
-		delta.DifferentAt("Spec.KubeControllerManagerConfig") {
-		if err := rm.updateComponentConfig(ctx, desired, delta); err != nil {
-			awsErr, ok := extractAWSError(err)
-
-			// Check to see if we've raced an async update call and need to requeue
-			if ok && awsErr.Code == "ResourceInUseException" {
-				return nil, requeueAfterAsyncUpdate()
-			}
-
-			return nil, err
-		}
-		return returnClusterUpdating(updatedRes)
+	// The user tries to patch the encryption config of an existing cluster.
+	if len(latest.ko.Spec.EncryptionConfig) == 1 && len(desired.ko.Spec.EncryptionConfig) == 1 {
+		return "Encryption configuration cannot be updated"
 	}
-
-	// Handle deletionProtection updates
-	if delta.DifferentAt("Spec.DeletionProtection") {
-		if err := rm.updateDeletionProtection(ctx, desired); err != nil {
-			awsErr, ok := extractAWSError(err)
-
-			// Check to see if we've raced an async update call and need to requeue
-			if ok && awsErr.Code == "ResourceInUseException" {
-				return nil, requeueAfterAsyncUpdate()
-			}
-
-			return nil, err
-		}
-		return returnClusterUpdating(updatedRes)
+	// The user tries to add a second encryption config to an existing cluster.
+	if len(latest.ko.Spec.EncryptionConfig) == 0 && len(desired.ko.Spec.EncryptionConfig) > 1 {
+		return "Only one encryption configuration is allowed"
 	}
-
-	// Set default status values and return the updated resource
-	rm.setStatusDefaults(updatedRes.ko)
-	return updatedRes, nil
+	return ""
 }
 
 // updateVersion updates the cluster version to the next possible version.
@@ -482,7 +314,7 @@ func (rm *resourceManager) customUpdate(
 func (rm *resourceManager) updateVersion(
 	ctx context.Context,
 	desired, latest *resource,
-) (err error) {
+) (update *svcsdktypes.Update, err error) {
 	rlog := ackrtlog.FromContext(ctx)
 	exit := rlog.Trace("rm.updateVersion")
 	defer exit(err)
@@ -493,10 +325,10 @@ func (rm *resourceManager) updateVersion(
 	// equal at this stage, as the delta comparison would have caught that.
 	compareResult, err := util.CompareEKSKubernetesVersions(*desired.ko.Spec.Version, *latest.ko.Spec.Version)
 	if err != nil {
-		return ackerr.NewTerminalError(fmt.Errorf("failed to compare the desired and observed versions: %v", err))
+		return nil, ackerr.NewTerminalError(fmt.Errorf("failed to compare the desired and observed versions: %v", err))
 	}
 	if compareResult != 1 {
-		return ackerr.NewTerminalError(
+		return nil, ackerr.NewTerminalError(
 			fmt.Errorf("desired cluster version is less than the observed version: %s < %s",
 				*desired.ko.Spec.Version, *latest.ko.Spec.Version,
 			),
@@ -506,7 +338,7 @@ func (rm *resourceManager) updateVersion(
 	// Compure the next minor version of the desired version
 	nextVersion, err := util.IncrementEKSMinorVersion(*latest.ko.Spec.Version)
 	if err != nil {
-		return ackerr.NewTerminalError(fmt.Errorf("failed to compute the next minor version: %v", err))
+		return nil, ackerr.NewTerminalError(fmt.Errorf("failed to compute the next minor version: %v", err))
 	}
 
 	input := &svcsdk.UpdateClusterVersionInput{
@@ -515,19 +347,19 @@ func (rm *resourceManager) updateVersion(
 		Force:   GetForceUpgrade(&desired.ko.ObjectMeta),
 	}
 
-	_, err = rm.sdkapi.UpdateClusterVersion(ctx, input)
+	resp, err := rm.sdkapi.UpdateClusterVersion(ctx, input)
 	rm.metrics.RecordAPICall("UPDATE", "UpdateClusterVersion", err)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	return nil
+	return resp.Update, nil
 }
 
 func (rm *resourceManager) updateConfigLogging(
 	ctx context.Context,
 	r *resource,
-) (err error) {
+) (update *svcsdktypes.Update, err error) {
 	rlog := ackrtlog.FromContext(ctx)
 	exit := rlog.Trace("rm.updateConfigLogging")
 	defer exit(err)
@@ -536,13 +368,13 @@ func (rm *resourceManager) updateConfigLogging(
 		Logging: rm.newLogging(r),
 	}
 
-	_, err = rm.sdkapi.UpdateClusterConfig(ctx, input)
+	resp, err := rm.sdkapi.UpdateClusterConfig(ctx, input)
 	rm.metrics.RecordAPICall("UPDATE", "UpdateClusterConfig", err)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	return nil
+	return resp.Update, nil
 }
 
 func newAccessConfig(r *resource) *svcsdktypes.UpdateAccessConfigRequest {
@@ -556,7 +388,7 @@ func newAccessConfig(r *resource) *svcsdktypes.UpdateAccessConfigRequest {
 func (rm *resourceManager) updateAccessConfig(
 	ctx context.Context,
 	r *resource,
-) (err error) {
+) (update *svcsdktypes.Update, err error) {
 	rlog := ackrtlog.FromContext(ctx)
 	exit := rlog.Trace("rm.updateAccessConfig")
 	defer exit(err)
@@ -564,19 +396,19 @@ func (rm *resourceManager) updateAccessConfig(
 		Name:         r.ko.Spec.Name,
 		AccessConfig: newAccessConfig(r),
 	}
-	_, err = rm.sdkapi.UpdateClusterConfig(ctx, input)
+	resp, err := rm.sdkapi.UpdateClusterConfig(ctx, input)
 	rm.metrics.RecordAPICall("UPDATE", "UpdateClusterConfig", err)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	return nil
+	return resp.Update, nil
 }
 
 func (rm *resourceManager) updateClusterUpgradePolicy(
 	ctx context.Context,
 	r *resource,
-) (err error) {
+) (update *svcsdktypes.Update, err error) {
 	rlog := ackrtlog.FromContext(ctx)
 	exit := rlog.Trace("rm.updateClusterUpgradePolicy")
 	defer func() { exit(err) }()
@@ -586,19 +418,19 @@ func (rm *resourceManager) updateClusterUpgradePolicy(
 			SupportType: svcsdktypes.SupportType(*r.ko.Spec.UpgradePolicy.SupportType),
 		},
 	}
-	_, err = rm.sdkapi.UpdateClusterConfig(ctx, input)
+	resp, err := rm.sdkapi.UpdateClusterConfig(ctx, input)
 	rm.metrics.RecordAPICall("UPDATE", "UpdateClusterConfig", err)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	return nil
+	return resp.Update, nil
 }
 
 func (rm *resourceManager) updateConfigResourcesVPCConfigPublicAndPrivateAccess(
 	ctx context.Context,
 	r *resource,
-) (err error) {
+) (update *svcsdktypes.Update, err error) {
 	rlog := ackrtlog.FromContext(ctx)
 	exit := rlog.Trace("rm.updateConfigResourcesVPCConfigPublicAndPrivateAccess")
 	defer exit(err)
@@ -612,19 +444,19 @@ func (rm *resourceManager) updateConfigResourcesVPCConfigPublicAndPrivateAccess(
 	input.ResourcesVpcConfig.SubnetIds = nil
 	input.ResourcesVpcConfig.SecurityGroupIds = nil
 
-	_, err = rm.sdkapi.UpdateClusterConfig(ctx, input)
+	resp, err := rm.sdkapi.UpdateClusterConfig(ctx, input)
 	rm.metrics.RecordAPICall("UPDATE", "UpdateClusterConfig", err)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	return nil
+	return resp.Update, nil
 }
 
 func (rm *resourceManager) updateConfigResourcesVPCConfigSubnetsAndSecurityGroups(
 	ctx context.Context,
 	r *resource,
-) (err error) {
+) (update *svcsdktypes.Update, err error) {
 	rlog := ackrtlog.FromContext(ctx)
 	exit := rlog.Trace("rm.updateConfigResourcesVPCConfigSubnetsAndSecurityGroups")
 	defer exit(err)
@@ -638,13 +470,13 @@ func (rm *resourceManager) updateConfigResourcesVPCConfigSubnetsAndSecurityGroup
 	input.ResourcesVpcConfig.EndpointPrivateAccess = nil
 	input.ResourcesVpcConfig.PublicAccessCidrs = nil
 
-	_, err = rm.sdkapi.UpdateClusterConfig(ctx, input)
+	resp, err := rm.sdkapi.UpdateClusterConfig(ctx, input)
 	rm.metrics.RecordAPICall("UPDATE", "UpdateClusterConfig", err)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	return nil
+	return resp.Update, nil
 }
 
 func (rm *resourceManager) listNodegroups(
@@ -672,7 +504,7 @@ func (rm *resourceManager) listNodegroups(
 func (rm *resourceManager) associateEncryptionConfig(
 	ctx context.Context,
 	r *resource,
-) (err error) {
+) (update *svcsdktypes.Update, err error) {
 	rlog := ackrtlog.FromContext(ctx)
 	exit := rlog.Trace("rm.updateEncryptionConfiguration")
 	defer func() { exit(err) }()
@@ -700,20 +532,20 @@ func (rm *resourceManager) associateEncryptionConfig(
 		},
 	}
 
-	_, err = rm.sdkapi.AssociateEncryptionConfig(ctx, input)
+	resp, err := rm.sdkapi.AssociateEncryptionConfig(ctx, input)
 	rm.metrics.RecordAPICall("UPDATE", "AssociateEncryptionConfig", err)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	return nil
+	return resp.Update, nil
 }
 
 // updateComputeConfig updates the compute config of the cluster.
 func (rm *resourceManager) updateComputeConfig(
 	ctx context.Context,
 	r *resource,
-) (err error) {
+) (update *svcsdktypes.Update, err error) {
 	rlog := ackrtlog.FromContext(ctx)
 	exit := rlog.Trace("rm.updateComputeConfig")
 	defer exit(err)
@@ -768,19 +600,19 @@ func (rm *resourceManager) updateComputeConfig(
 		input.KubernetesNetworkConfig = kubernetesNetworkConfig
 	}
 
-	_, err = rm.sdkapi.UpdateClusterConfig(ctx, input)
+	resp, err := rm.sdkapi.UpdateClusterConfig(ctx, input)
 	rm.metrics.RecordAPICall("UPDATE", "UpdateClusterConfig", err)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	return nil
+	return resp.Update, nil
 }
 
 func (rm *resourceManager) updateZonalShiftConfig(
 	ctx context.Context,
 	r *resource,
-) (err error) {
+) (update *svcsdktypes.Update, err error) {
 	rlog := ackrtlog.FromContext(ctx)
 	exit := rlog.Trace("rm.updateZonalShiftConfig")
 	defer exit(err)
@@ -792,13 +624,13 @@ func (rm *resourceManager) updateZonalShiftConfig(
 		},
 	}
 
-	_, err = rm.sdkapi.UpdateClusterConfig(ctx, input)
+	resp, err := rm.sdkapi.UpdateClusterConfig(ctx, input)
 	rm.metrics.RecordAPICall("UPDATE", "UpdateClusterConfig", err)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	return nil
+	return resp.Update, nil
 }
 
 // updateControlPlaneScalingConfig updates the control plane scaling tier of
@@ -806,7 +638,7 @@ func (rm *resourceManager) updateZonalShiftConfig(
 func (rm *resourceManager) updateControlPlaneScalingConfig(
 	ctx context.Context,
 	r *resource,
-) (err error) {
+) (update *svcsdktypes.Update, err error) {
 	rlog := ackrtlog.FromContext(ctx)
 	exit := rlog.Trace("rm.updateControlPlaneScalingConfig")
 	defer exit(err)
@@ -819,13 +651,13 @@ func (rm *resourceManager) updateControlPlaneScalingConfig(
 		input.ControlPlaneScalingConfig.Tier = svcsdktypes.ProvisionedControlPlaneTier(*r.ko.Spec.ControlPlaneScalingConfig.Tier)
 	}
 
-	_, err = rm.sdkapi.UpdateClusterConfig(ctx, input)
+	resp, err := rm.sdkapi.UpdateClusterConfig(ctx, input)
 	rm.metrics.RecordAPICall("UPDATE", "UpdateClusterConfig", err)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	return nil
+	return resp.Update, nil
 }
 
 // updateComponentConfig updates the Kubernetes control plane component configs
@@ -838,14 +670,14 @@ func (rm *resourceManager) updateComponentConfig(
 	ctx context.Context,
 	r *resource,
 	delta *ackcompare.Delta,
-) (err error) {
+) (update *svcsdktypes.Update, err error) {
 	rlog := ackrtlog.FromContext(ctx)
 	exit := rlog.Trace("rm.updateComponentConfig")
 	defer exit(err)
 
 	createPayload, err := rm.newCreateRequestPayload(ctx, r)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	input := &svcsdk.UpdateClusterConfigInput{
@@ -861,19 +693,19 @@ func (rm *resourceManager) updateComponentConfig(
 		input.KubeControllerManagerConfig = createPayload.KubeControllerManagerConfig
 	}
 
-	_, err = rm.sdkapi.UpdateClusterConfig(ctx, input)
+	resp, err := rm.sdkapi.UpdateClusterConfig(ctx, input)
 	rm.metrics.RecordAPICall("UPDATE", "UpdateClusterConfig", err)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	return nil
+	return resp.Update, nil
 }
 
 func (rm *resourceManager) updateDeletionProtection(
 	ctx context.Context,
 	r *resource,
-) (err error) {
+) (update *svcsdktypes.Update, err error) {
 	rlog := ackrtlog.FromContext(ctx)
 	exit := rlog.Trace("rm.updateDeletionProtection")
 	defer exit(err)
@@ -883,13 +715,13 @@ func (rm *resourceManager) updateDeletionProtection(
 		DeletionProtection: r.ko.Spec.DeletionProtection,
 	}
 
-	_, err = rm.sdkapi.UpdateClusterConfig(ctx, input)
+	resp, err := rm.sdkapi.UpdateClusterConfig(ctx, input)
 	rm.metrics.RecordAPICall("UPDATE", "UpdateClusterConfig", err)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	return nil
+	return resp.Update, nil
 }
 
 // extractAWSError extracts the underlying AWS error from a smithy.GenericAPIError.
@@ -0,0 +1,232 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package cluster
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	ackrequeue "github.com/aws-controllers-k8s/runtime/pkg/requeue"
+	ackrtlog "github.com/aws-controllers-k8s/runtime/pkg/runtime/log"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	svcsdk "github.com/aws/aws-sdk-go-v2/service/eks"
+	svcsdktypes "github.com/aws/aws-sdk-go-v2/service/eks/types"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/aws-controllers-k8s/eks-controller/apis/v1alpha1"
+	"github.com/aws-controllers-k8s/eks-controller/pkg/condition"
+	"github.com/aws-controllers-k8s/eks-controller/pkg/util"
+)
+
+var (
+	// RequeueAfterUpgradeBlockedDuration is how long the controller waits
+	// before checking the upgrade insights of a blocked cluster again. EKS
+	// refreshes insights periodically, and users may also fix the findings
+	// and wait for the next refresh.
+	RequeueAfterUpgradeBlockedDuration = 5 * time.Minute
+)
+
+// requeueWaitWhileUpgradeBlocked returns a `ackrequeue.RequeueNeededAfter`
+// struct explaining the cluster version cannot be upgraded until the blocking
+// upgrade insights are resolved.
+func requeueWaitWhileUpgradeBlocked(version string) *ackrequeue.RequeueNeededAfter {
+	return ackrequeue.NeededAfter(
+		fmt.Errorf("cluster upgrade to version '%s' is blocked by upgrade insights", version),
+		RequeueAfterUpgradeBlockedDuration,
+	)
+}
+
+// checkUpgradeInsights gates a cluster version upgrade step on the
+// UPGRADE_READINESS insights that EKS collected for the next minor version.
+// Failing and warning insights are summarized in Status.UpgradeInsights. If any
+// insight is failing (ERROR status), the step is blocked with an UpgradeBlocked
+// condition, unless the upgrade is forced with the ForceClusterUpgradeAnnotation.
+func (rm *resourceManager) checkUpgradeInsights(
+	ctx context.Context,
+	desired *resource,
+	latest *resource,
+	updatedRes *resource,
+) (err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.checkUpgradeInsights")
+	defer func() { exit(err) }()
+
+	if latest.ko.Spec.Version == nil {
+		return nil
+	}
+	// An invalid version is reported as a terminal error by updateVersion.
+	nextVersion, err := util.IncrementEKSMinorVersion(*latest.ko.Spec.Version)
+	if err != nil {
+		return nil
+	}
+
+	insights, err := rm.listUpgradeInsights(ctx, latest, nextVersion)
+	if err != nil {
+		return err
+	}
+	updatedRes.ko.Status.UpgradeInsights = insights
+
+	blocking := blockingInsights(insights)
+	if len(blocking) == 0 {
+		condition.Remove(updatedRes, v1alpha1.ConditionTypeUpgradeBlocked)
+		return nil
+	}
+
+	names := make([]string, 0, len(blocking))
+	for _, insight := range blocking {
+		names = append(names, aws.ToString(insight.Name))
+	}
+	if GetForceUpgrade(&desired.ko.ObjectMeta) {
+		msg := fmt.Sprintf(
+			"Upgrade to version %s forced despite failing upgrade insights: %s",
+			nextVersion, strings.Join(names, ", "),
+		)
+		condition.Set(updatedRes, v1alpha1.ConditionTypeUpgradeBlocked, corev1.ConditionFalse, &msg, aws.String("ForceUpgrade"))
+		return nil
+	}
+
+	msg := fmt.Sprintf(
+		"Upgrade to version %s is blocked by failing upgrade insights: %s. "+
+			"Resolve the findings or set the '%s' annotation to 'true' to upgrade anyway",
+		nextVersion, strings.Join(names, ", "), v1alpha1.ForceClusterUpgradeAnnotation,
+	)
+	condition.Set(updatedRes, v1alpha1.ConditionTypeUpgradeBlocked, corev1.ConditionTrue, &msg, aws.String("FailingUpgradeInsights"))
+	return requeueWaitWhileUpgradeBlocked(nextVersion)
+}
+
+// clearUpgradeInsights drops the upgrade insights and the UpgradeBlocked
+// condition of a cluster that has no version upgrade pending.
+func clearUpgradeInsights(r *resource) {
+	r.ko.Status.UpgradeInsights = nil
+	condition.Remove(r, v1alpha1.ConditionTypeUpgradeBlocked)
+}
+
+// listUpgradeInsights returns the failing and warning UPGRADE_READINESS
+// insights of the cluster for the supplied Kubernetes version, sorted by name
+// and ID so that the status of the cluster only changes with the insights.
+func (rm *resourceManager) listUpgradeInsights(
+	ctx context.Context,
+	r *resource,
+	version string,
+) (insights []*v1alpha1.UpgradeInsight, err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.listUpgradeInsights")
+	defer func() { exit(err) }()
+
+	input := &svcsdk.ListInsightsInput{
+		ClusterName: r.ko.Spec.Name,
+		Filter: &svcsdktypes.InsightsFilter{
+			Categories:         []svcsdktypes.Category{svcsdktypes.CategoryUpgradeReadiness},
+			KubernetesVersions: []string{version},
+			Statuses: []svcsdktypes.InsightStatusValue{
+				svcsdktypes.InsightStatusValueError,
+				svcsdktypes.InsightStatusValueWarning,
+			},
+		},
+	}
+	for {
+		resp, err := rm.sdkapi.ListInsights(ctx, input)
+		rm.metrics.RecordAPICall("READ_MANY", "ListInsights", err)
+		if err != nil {
+			return nil, err
+		}
+		for _, summary := range resp.Insights {
+			insight, err := rm.describeInsight(ctx, r, summary.Id)
+			if err != nil {
+				return nil, err
+			}
+			insights = append(insights, insight)
+		}
+		if resp.NextToken == nil {
+			break
+		}
+		input.NextToken = resp.NextToken
+	}
+	sort.Slice(insights, func(i, j int) bool {
+		a, b := insights[i], insights[j]
+		if aws.ToString(a.Name) != aws.ToString(b.Name) {
+			return aws.ToString(a.Name) < aws.ToString(b.Name)
+		}
+		return aws.ToString(a.ID) < aws.ToString(b.ID)
+	})
+	return insights, nil
+}
+
+// describeInsight returns the summary of the supplied cluster insight.
+func (rm *resourceManager) describeInsight(
+	ctx context.Context,
+	r *resource,
+	id *string,
+) (insight *v1alpha1.UpgradeInsight, err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.describeInsight")
+	defer func() { exit(err) }()
+
+	resp, err := rm.sdkapi.DescribeInsight(ctx, &svcsdk.DescribeInsightInput{
+		ClusterName: r.ko.Spec.Name,
+		Id:          id,
+	})
+	rm.metrics.RecordAPICall("READ_ONE", "DescribeInsight", err)
+	if err != nil {
+		return nil, err
+	}
+	return newUpgradeInsight(resp.Insight), nil
+}
+
+// blockingInsights returns the insights that must block a version upgrade,
+// that is the ones in the ERROR status.
+func blockingInsights(insights []*v1alpha1.UpgradeInsight) []*v1alpha1.UpgradeInsight {
+	blocking := []*v1alpha1.UpgradeInsight{}
+	for _, insight := range insights {
+		if aws.ToString(insight.Status) == string(svcsdktypes.InsightStatusValueError) {
+			blocking = append(blocking, insight)
+		}
+	}
+	return blocking
+}
+
+// newUpgradeInsight summarizes an EKS SDK Insight for the status of the
+// cluster. The timestamps and the affected resources, which change on every
+// refresh of the insight, are left out; only the number of resources is kept.
+func newUpgradeInsight(i *svcsdktypes.Insight) *v1alpha1.UpgradeInsight {
+	if i == nil {
+		return nil
+	}
+	insight := &v1alpha1.UpgradeInsight{
+		ID:             i.Id,
+		Name:           i.Name,
+		Recommendation: i.Recommendation,
+		ResourceCount:  aws.Int64(int64(len(i.Resources))),
+	}
+	if i.InsightStatus != nil {
+		insight.Reason = i.InsightStatus.Reason
+		if i.InsightStatus.Status != "" {
+			insight.Status = aws.String(string(i.InsightStatus.Status))
+		}
+	}
+	return insight
+}
+
+// newTime converts an optional time.Time into a metav1.Time.
+func newTime(t *time.Time) *metav1.Time {
+	if t == nil {
+		return nil
+	}
+	mt := metav1.NewTime(*t)
+	return &mt
+}
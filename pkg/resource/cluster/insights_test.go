@@ -0,0 +1,130 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package cluster
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	ackcondition "github.com/aws-controllers-k8s/runtime/pkg/condition"
+	ackmetrics "github.com/aws-controllers-k8s/runtime/pkg/metrics"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	svcsdktypes "github.com/aws/aws-sdk-go-v2/service/eks/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/aws-controllers-k8s/eks-controller/apis/v1alpha1"
+	"github.com/aws-controllers-k8s/eks-controller/pkg/condition"
+	"github.com/aws-controllers-k8s/eks-controller/pkg/testutil"
+)
+
+func TestNewUpgradeInsight(t *testing.T) {
+	assert.Nil(t, newUpgradeInsight(nil))
+
+	now := time.Now()
+	insight := newUpgradeInsight(&svcsdktypes.Insight{
+		Id:       aws.String("id-1"),
+		Name:     aws.String("Deprecated APIs removed in Kubernetes v1.32"),
+		Category: svcsdktypes.CategoryUpgradeReadiness,
+		InsightStatus: &svcsdktypes.InsightStatus{
+			Status: svcsdktypes.InsightStatusValueError,
+			Reason: aws.String("Deprecated API usage detected"),
+		},
+		Recommendation:  aws.String("Update the manifests"),
+		LastRefreshTime: &now,
+		Resources: []svcsdktypes.InsightResourceDetail{
+			{KubernetesResourceUri: aws.String("/apis/flowcontrol.apiserver.k8s.io/v1beta3/flowschemas/foo")},
+			{KubernetesResourceUri: aws.String("/apis/flowcontrol.apiserver.k8s.io/v1beta3/flowschemas/bar")},
+		},
+	})
+	assert.Equal(t, &v1alpha1.UpgradeInsight{
+		ID:             aws.String("id-1"),
+		Name:           aws.String("Deprecated APIs removed in Kubernetes v1.32"),
+		Reason:         aws.String("Deprecated API usage detected"),
+		Recommendation: aws.String("Update the manifests"),
+		ResourceCount:  aws.Int64(2),
+		Status:         aws.String("ERROR"),
+	}, insight)
+
+	// A refresh of the insight leaves its summary unchanged.
+	later := now.Add(time.Hour)
+	assert.Equal(t, insight, newUpgradeInsight(&svcsdktypes.Insight{
+		Id:       aws.String("id-1"),
+		Name:     aws.String("Deprecated APIs removed in Kubernetes v1.32"),
+		Category: svcsdktypes.CategoryUpgradeReadiness,
+		InsightStatus: &svcsdktypes.InsightStatus{
+			Status: svcsdktypes.InsightStatusValueError,
+			Reason: aws.String("Deprecated API usage detected"),
+		},
+		Recommendation:  aws.String("Update the manifests"),
+		LastRefreshTime: &later,
+		Resources: []svcsdktypes.InsightResourceDetail{
+			{KubernetesResourceUri: aws.String("/apis/flowcontrol.apiserver.k8s.io/v1beta3/flowschemas/bar")},
+			{KubernetesResourceUri: aws.String("/apis/flowcontrol.apiserver.k8s.io/v1beta3/flowschemas/foo")},
+		},
+	}))
+}
+
+func TestListUpgradeInsights(t *testing.T) {
+	hc := &testutil.FakeHTTPClient{Responses: map[string]string{
+		"POST /clusters/my-cluster/insights": `{"insights": [{"id": "id-2"}, {"id": "id-1"}]}`,
+		"GET /clusters/my-cluster/insights/id-1": `{"insight": {"id": "id-1", "name": "b",
+			"insightStatus": {"status": "WARNING"}, "lastRefreshTime": 1700000000}}`,
+		"GET /clusters/my-cluster/insights/id-2": `{"insight": {"id": "id-2", "name": "a",
+			"insightStatus": {"status": "ERROR"}, "resources": [{"arn": "arn-1"}]}}`,
+	}}
+	rm := &resourceManager{
+		metrics: ackmetrics.NewMetrics("eks"),
+		sdkapi:  testutil.NewEKSClient(hc),
+	}
+	r := &resource{ko: &v1alpha1.Cluster{Spec: v1alpha1.ClusterSpec{Name: aws.String("my-cluster")}}}
+
+	insights, err := rm.listUpgradeInsights(context.TODO(), r, "1.32")
+	require.NoError(t, err)
+	assert.Equal(t, []*v1alpha1.UpgradeInsight{
+		{ID: aws.String("id-2"), Name: aws.String("a"), Status: aws.String("ERROR"), ResourceCount: aws.Int64(1)},
+		{ID: aws.String("id-1"), Name: aws.String("b"), Status: aws.String("WARNING"), ResourceCount: aws.Int64(0)},
+	}, insights)
+}
+
+func TestBlockingInsights(t *testing.T) {
+	newTestInsight := func(name string, status svcsdktypes.InsightStatusValue) *v1alpha1.UpgradeInsight {
+		return &v1alpha1.UpgradeInsight{
+			Name:   aws.String(name),
+			Status: aws.String(string(status)),
+		}
+	}
+
+	blocking := blockingInsights([]*v1alpha1.UpgradeInsight{
+		newTestInsight("warning", svcsdktypes.InsightStatusValueWarning),
+		newTestInsight("error", svcsdktypes.InsightStatusValueError),
+		newTestInsight("passing", svcsdktypes.InsightStatusValuePassing),
+		{Name: aws.String("no-status")},
+	})
+	assert.Len(t, blocking, 1)
+	assert.Equal(t, "error", *blocking[0].Name)
+	assert.Empty(t, blockingInsights(nil))
+}
+
+func TestClearUpgradeInsights(t *testing.T) {
+	r := &resource{ko: &v1alpha1.Cluster{}}
+	r.ko.Status.UpgradeInsights = []*v1alpha1.UpgradeInsight{{Name: aws.String("error")}}
+	condition.Set(r, v1alpha1.ConditionTypeUpgradeBlocked, corev1.ConditionTrue, nil, aws.String("FailingUpgradeInsights"))
+
+	clearUpgradeInsights(r)
+	assert.Nil(t, r.ko.Status.UpgradeInsights)
+	assert.Nil(t, ackcondition.FirstOfType(r, v1alpha1.ConditionTypeUpgradeBlocked))
+}
@@ -0,0 +1,170 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package cluster
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+
+	ackrtlog "github.com/aws-controllers-k8s/runtime/pkg/runtime/log"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"k8s.io/client-go/tools/clientcmd"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+
+	"github.com/aws-controllers-k8s/eks-controller/pkg/kube"
+)
+
+const (
+	// defaultKubeconfigSecretKey is the default key of the kubeconfig in the
+	// data of the kubeconfig Secret.
+	defaultKubeconfigSecretKey = "kubeconfig"
+	// kubeconfigSecretNameSuffix is appended to the name of the Cluster
+	// resource to name the kubeconfig Secret by default.
+	kubeconfigSecretNameSuffix = "-kubeconfig"
+	// kubeconfigExecAPIVersion is the version of the client authentication API
+	// implemented by `aws eks get-token`.
+	kubeconfigExecAPIVersion = "client.authentication.k8s.io/v1beta1"
+)
+
+// kubeconfigSecretName returns the name of the kubeconfig Secret of the
+// supplied cluster, or an empty string if the cluster doesn't ask for one.
+func kubeconfigSecretName(r *resource) string {
+	if r.ko.Spec.KubeconfigSecret == nil {
+		return ""
+	}
+	if name := aws.ToString(r.ko.Spec.KubeconfigSecret.Name); name != "" {
+		return name
+	}
+	return r.ko.Name + kubeconfigSecretNameSuffix
+}
+
+// kubeconfigSecretKey returns the key of the kubeconfig in the data of the
+// kubeconfig Secret of the supplied cluster.
+func kubeconfigSecretKey(r *resource) string {
+	if r.ko.Spec.KubeconfigSecret != nil {
+		if key := aws.ToString(r.ko.Spec.KubeconfigSecret.Key); key != "" {
+			return key
+		}
+	}
+	return defaultKubeconfigSecretKey
+}
+
+// newKubeconfig returns a kubeconfig for the supplied cluster, authenticating
+// with `aws eks get-token`, the same way `aws eks update-kubeconfig` does.
+func newKubeconfig(r *resource, region string) ([]byte, error) {
+	if r.ko.Status.ACKResourceMetadata == nil || r.ko.Status.ACKResourceMetadata.ARN == nil {
+		return nil, fmt.Errorf("cluster ARN is unknown")
+	}
+	if r.ko.Status.Endpoint == nil {
+		return nil, fmt.Errorf("cluster endpoint is unknown")
+	}
+	if r.ko.Status.CertificateAuthority == nil || r.ko.Status.CertificateAuthority.Data == nil {
+		return nil, fmt.Errorf("cluster certificate authority is unknown")
+	}
+	caData, err := base64.StdEncoding.DecodeString(*r.ko.Status.CertificateAuthority.Data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode cluster certificate authority: %w", err)
+	}
+
+	args := []string{
+		"--region", region,
+		"eks", "get-token",
+		"--cluster-name", aws.ToString(r.ko.Spec.Name),
+		"--output", "json",
+	}
+	if roleARN := aws.ToString(r.ko.Spec.KubeconfigSecret.RoleARN); roleARN != "" {
+		args = append(args, "--role-arn", roleARN)
+	}
+
+	name := string(*r.ko.Status.ACKResourceMetadata.ARN)
+	config := clientcmdapi.NewConfig()
+	config.Clusters[name] = &clientcmdapi.Cluster{
+		Server:                   *r.ko.Status.Endpoint,
+		CertificateAuthorityData: caData,
+	}
+	config.AuthInfos[name] = &clientcmdapi.AuthInfo{
+		Exec: &clientcmdapi.ExecConfig{
+			APIVersion:      kubeconfigExecAPIVersion,
+			Command:         "aws",
+			Args:            args,
+			InteractiveMode: clientcmdapi.NeverExecInteractiveMode,
+		},
+	}
+	config.Contexts[name] = &clientcmdapi.Context{
+		Cluster:  name,
+		AuthInfo: name,
+	}
+	config.CurrentContext = name
+	return clientcmd.Write(*config)
+}
+
+// syncKubeconfigSecret writes the kubeconfig Secret of the supplied cluster
+// when it is read. A failure doesn't fail the read, it is reported with the
+// SecretSyncFailed condition and the Secret is written again on the next
+// reconcile.
+func (rm *resourceManager) syncKubeconfigSecret(
+	ctx context.Context,
+	r *resource,
+) {
+	kube.SetSecretSyncCondition(r, rm.writeKubeconfigSecret(ctx, r))
+}
+
+// writeKubeconfigSecret writes the kubeconfig Secret of the supplied cluster,
+// and deletes the one previously written if the cluster doesn't ask for it
+// anymore or asks for another name. The name of the Secret is recorded in
+// Status.KubeconfigSecretName.
+func (rm *resourceManager) writeKubeconfigSecret(
+	ctx context.Context,
+	r *resource,
+) (err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.writeKubeconfigSecret")
+	defer func() { exit(err) }()
+
+	name := kubeconfigSecretName(r)
+	if prev := aws.ToString(r.ko.Status.KubeconfigSecretName); prev != "" && prev != name {
+		if err := kube.DeleteSecret(ctx, r.ko, prev); err != nil {
+			return err
+		}
+		r.ko.Status.KubeconfigSecretName = nil
+	}
+	if name == "" {
+		return nil
+	}
+
+	kubeconfig, err := newKubeconfig(r, string(rm.awsRegion))
+	if err != nil {
+		return err
+	}
+	data := map[string][]byte{kubeconfigSecretKey(r): kubeconfig}
+	if err := kube.ApplySecret(ctx, r.ko, name, data); err != nil {
+		return err
+	}
+	r.ko.Status.KubeconfigSecretName = aws.String(name)
+	return nil
+}
+
+// deleteKubeconfigSecret deletes the kubeconfig Secret written for the
+// supplied cluster, if any.
+func (rm *resourceManager) deleteKubeconfigSecret(
+	ctx context.Context,
+	r *resource,
+) error {
+	name := aws.ToString(r.ko.Status.KubeconfigSecretName)
+	if name == "" {
+		return nil
+	}
+	return kube.DeleteSecret(ctx, r.ko, name)
+}
@@ -0,0 +1,121 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package cluster
+
+import (
+	"context"
+	"encoding/base64"
+	"testing"
+
+	ackv1alpha1 "github.com/aws-controllers-k8s/runtime/apis/core/v1alpha1"
+	ackcondition "github.com/aws-controllers-k8s/runtime/pkg/condition"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/clientcmd"
+
+	"github.com/aws-controllers-k8s/eks-controller/apis/v1alpha1"
+	"github.com/aws-controllers-k8s/eks-controller/pkg/kube"
+	"github.com/aws-controllers-k8s/eks-controller/pkg/testutil"
+)
+
+func TestKubeconfigSecretName(t *testing.T) {
+	r := &resource{ko: &v1alpha1.Cluster{}}
+	r.ko.Name = "my-cluster"
+	assert.Equal(t, "", kubeconfigSecretName(r))
+
+	r.ko.Spec.KubeconfigSecret = &v1alpha1.KubeconfigSecret{}
+	assert.Equal(t, "my-cluster-kubeconfig", kubeconfigSecretName(r))
+	assert.Equal(t, "kubeconfig", kubeconfigSecretKey(r))
+
+	r.ko.Spec.KubeconfigSecret = &v1alpha1.KubeconfigSecret{Name: aws.String("admin"), Key: aws.String("value")}
+	assert.Equal(t, "admin", kubeconfigSecretName(r))
+	assert.Equal(t, "value", kubeconfigSecretKey(r))
+}
+
+func TestNewKubeconfig(t *testing.T) {
+	arn := ackv1alpha1.AWSResourceName("arn:aws:eks:us-west-2:123456789012:cluster/my-cluster")
+	r := &resource{ko: &v1alpha1.Cluster{}}
+	r.ko.Spec.Name = aws.String("my-cluster")
+	r.ko.Spec.KubeconfigSecret = &v1alpha1.KubeconfigSecret{}
+
+	_, err := newKubeconfig(r, "us-west-2")
+	assert.Error(t, err)
+
+	r.ko.Status.ACKResourceMetadata = &ackv1alpha1.ResourceMetadata{ARN: &arn}
+	r.ko.Status.Endpoint = aws.String("https://ABCDEF.gr7.us-west-2.eks.amazonaws.com")
+	r.ko.Status.CertificateAuthority = &v1alpha1.Certificate{
+		Data: aws.String(base64.StdEncoding.EncodeToString([]byte("ca-data"))),
+	}
+
+	data, err := newKubeconfig(r, "us-west-2")
+	require.NoError(t, err)
+	config, err := clientcmd.Load(data)
+	require.NoError(t, err)
+	assert.Equal(t, string(arn), config.CurrentContext)
+	assert.Equal(t, *r.ko.Status.Endpoint, config.Clusters[string(arn)].Server)
+	assert.Equal(t, []byte("ca-data"), config.Clusters[string(arn)].CertificateAuthorityData)
+	exec := config.AuthInfos[string(arn)].Exec
+	assert.Equal(t, "aws", exec.Command)
+	assert.Equal(t, []string{
+		"--region", "us-west-2", "eks", "get-token", "--cluster-name", "my-cluster", "--output", "json",
+	}, exec.Args)
+
+	r.ko.Spec.KubeconfigSecret.RoleARN = aws.String("arn:aws:iam::123456789012:role/admin")
+	data, err = newKubeconfig(r, "us-west-2")
+	require.NoError(t, err)
+	config, err = clientcmd.Load(data)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"--role-arn", "arn:aws:iam::123456789012:role/admin"},
+		config.AuthInfos[string(arn)].Exec.Args[8:])
+}
+
+func TestSyncKubeconfigSecret(t *testing.T) {
+	ctx := context.Background()
+	kc := testutil.NewKubeClient(&corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "infra", Name: "my-cluster-kubeconfig"},
+	})
+	kube.SetClient(kc)
+
+	arn := ackv1alpha1.AWSResourceName("arn:aws:eks:us-west-2:123456789012:cluster/my-cluster")
+	r := &resource{ko: &v1alpha1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "infra", Name: "my-cluster", UID: "uid-1"},
+	}}
+	r.ko.Spec.Name = aws.String("my-cluster")
+	r.ko.Spec.KubeconfigSecret = &v1alpha1.KubeconfigSecret{}
+	r.ko.Status.ACKResourceMetadata = &ackv1alpha1.ResourceMetadata{ARN: &arn}
+	r.ko.Status.Endpoint = aws.String("https://ABCDEF.gr7.us-west-2.eks.amazonaws.com")
+	r.ko.Status.CertificateAuthority = &v1alpha1.Certificate{
+		Data: aws.String(base64.StdEncoding.EncodeToString([]byte("ca-data"))),
+	}
+	rm := &resourceManager{awsRegion: "us-west-2"}
+
+	// A Secret of the same name owned by no one is reported, not overwritten.
+	rm.syncKubeconfigSecret(ctx, r)
+	cond := ackcondition.FirstOfType(r, v1alpha1.ConditionTypeSecretSyncFailed)
+	require.NotNil(t, cond)
+	assert.Equal(t, "SecretNotControlled", *cond.Reason)
+	assert.Nil(t, r.ko.Status.KubeconfigSecretName)
+
+	r.ko.Spec.KubeconfigSecret.Name = aws.String("admin")
+	rm.syncKubeconfigSecret(ctx, r)
+	assert.Nil(t, ackcondition.FirstOfType(r, v1alpha1.ConditionTypeSecretSyncFailed))
+	assert.Equal(t, "admin", aws.ToString(r.ko.Status.KubeconfigSecretName))
+	secret := &corev1.Secret{}
+	require.NoError(t, kc.Get(ctx, types.NamespacedName{Namespace: "infra", Name: "admin"}, secret))
+	assert.NotEmpty(t, secret.Data["kubeconfig"])
+}
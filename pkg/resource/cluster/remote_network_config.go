@@ -0,0 +1,167 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package cluster
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	ackerr "github.com/aws-controllers-k8s/runtime/pkg/errors"
+	ackrtlog "github.com/aws-controllers-k8s/runtime/pkg/runtime/log"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	svcsdk "github.com/aws/aws-sdk-go-v2/service/eks"
+	svcsdktypes "github.com/aws/aws-sdk-go-v2/service/eks/types"
+
+	"github.com/aws-controllers-k8s/eks-controller/apis/v1alpha1"
+)
+
+// errRemoteNetworkConfigRemoval is returned when the remote network
+// configuration is removed from the spec of a cluster that has one.
+var errRemoteNetworkConfigRemoval = errors.New(
+	"remoteNetworkConfig cannot be removed from an existing cluster, " +
+		"restore it in the spec or recreate the cluster",
+)
+
+// normalizeCIDRs returns the supplied CIDRs sorted and joined, so that two
+// lists holding the same CIDRs in a different order compare equal.
+func normalizeCIDRs(cidrs []*string) string {
+	s := aws.ToStringSlice(cidrs)
+	sort.Strings(s)
+	return strings.Join(s, ",")
+}
+
+// normalizeRemoteNetworks returns the sorted, normalized CIDRs of each of the
+// supplied remote networks.
+func normalizeRemoteNetworks(networks [][]*string) []string {
+	normalized := make([]string, 0, len(networks))
+	for _, cidrs := range networks {
+		normalized = append(normalized, normalizeCIDRs(cidrs))
+	}
+	sort.Strings(normalized)
+	return normalized
+}
+
+// remoteNodeNetworks returns the CIDRs of each remote node network of the
+// supplied configuration.
+func remoteNodeNetworks(c *v1alpha1.RemoteNetworkConfigRequest) [][]*string {
+	if c == nil {
+		return nil
+	}
+	networks := [][]*string{}
+	for _, n := range c.RemoteNodeNetworks {
+		if n != nil {
+			networks = append(networks, n.CIDRs)
+		}
+	}
+	return networks
+}
+
+// remotePodNetworks returns the CIDRs of each remote pod network of the
+// supplied configuration.
+func remotePodNetworks(c *v1alpha1.RemoteNetworkConfigRequest) [][]*string {
+	if c == nil {
+		return nil
+	}
+	networks := [][]*string{}
+	for _, n := range c.RemotePodNetworks {
+		if n != nil {
+			networks = append(networks, n.CIDRs)
+		}
+	}
+	return networks
+}
+
+// remoteNetworkConfigEmpty returns true if the supplied configuration has no
+// remote network. EKS may report an empty configuration for clusters created
+// without one.
+func remoteNetworkConfigEmpty(c *v1alpha1.RemoteNetworkConfigRequest) bool {
+	return len(remoteNodeNetworks(c)) == 0 && len(remotePodNetworks(c)) == 0
+}
+
+// remoteNetworkConfigEqual returns true if both configurations hold the same
+// remote node and pod networks, regardless of the order of the networks and
+// of their CIDRs.
+func remoteNetworkConfigEqual(a, b *v1alpha1.RemoteNetworkConfigRequest) bool {
+	if remoteNetworkConfigEmpty(a) || remoteNetworkConfigEmpty(b) {
+		return remoteNetworkConfigEmpty(a) && remoteNetworkConfigEmpty(b)
+	}
+	return strings.Join(normalizeRemoteNetworks(remoteNodeNetworks(a)), ";") ==
+		strings.Join(normalizeRemoteNetworks(remoteNodeNetworks(b)), ";") &&
+		strings.Join(normalizeRemoteNetworks(remotePodNetworks(a)), ";") ==
+			strings.Join(normalizeRemoteNetworks(remotePodNetworks(b)), ";")
+}
+
+// newRemoteNetworkConfigRequest returns the SDK representation of the supplied
+// remote network configuration.
+func newRemoteNetworkConfigRequest(
+	c *v1alpha1.RemoteNetworkConfigRequest,
+) *svcsdktypes.RemoteNetworkConfigRequest {
+	req := &svcsdktypes.RemoteNetworkConfigRequest{
+		RemoteNodeNetworks: []svcsdktypes.RemoteNodeNetwork{},
+		RemotePodNetworks:  []svcsdktypes.RemotePodNetwork{},
+	}
+	for _, cidrs := range remoteNodeNetworks(c) {
+		req.RemoteNodeNetworks = append(req.RemoteNodeNetworks, svcsdktypes.RemoteNodeNetwork{
+			Cidrs: aws.ToStringSlice(cidrs),
+		})
+	}
+	for _, cidrs := range remotePodNetworks(c) {
+		req.RemotePodNetworks = append(req.RemotePodNetworks, svcsdktypes.RemotePodNetwork{
+			Cidrs: aws.ToStringSlice(cidrs),
+		})
+	}
+	return req
+}
+
+// updateRemoteNetworkConfig updates the remote node and pod networks of the
+// cluster used by EKS Hybrid Nodes. Changes EKS rejects are terminal, they
+// won't succeed until the spec changes.
+func (rm *resourceManager) updateRemoteNetworkConfig(
+	ctx context.Context,
+	desired *resource,
+	latest *resource,
+) (update *svcsdktypes.Update, err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.updateRemoteNetworkConfig")
+	defer func() { exit(err) }()
+
+	if remoteNetworkConfigEmpty(desired.ko.Spec.RemoteNetworkConfig) &&
+		!remoteNetworkConfigEmpty(latest.ko.Spec.RemoteNetworkConfig) {
+		return nil, ackerr.NewTerminalError(errRemoteNetworkConfigRemoval)
+	}
+
+	input := &svcsdk.UpdateClusterConfigInput{
+		Name:                desired.ko.Spec.Name,
+		RemoteNetworkConfig: newRemoteNetworkConfigRequest(desired.ko.Spec.RemoteNetworkConfig),
+	}
+
+	resp, err := rm.sdkapi.UpdateClusterConfig(ctx, input)
+	rm.metrics.RecordAPICall("UPDATE", "UpdateClusterConfig", err)
+	if err != nil {
+		if awsErr, ok := extractAWSError(err); ok {
+			switch awsErr.Code {
+			case "InvalidParameterException", "InvalidRequestException":
+				return nil, ackerr.NewTerminalError(
+					fmt.Errorf("remoteNetworkConfig update rejected: %s", awsErr.Message),
+				)
+			}
+		}
+		return nil, err
+	}
+
+	return resp.Update, nil
+}
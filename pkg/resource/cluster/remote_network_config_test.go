@@ -0,0 +1,142 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package cluster
+
+import (
+	"context"
+	"testing"
+
+	ackcompare "github.com/aws-controllers-k8s/runtime/pkg/compare"
+	ackerr "github.com/aws-controllers-k8s/runtime/pkg/errors"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/aws-controllers-k8s/eks-controller/apis/v1alpha1"
+)
+
+func newTestRemoteNetworkConfig(nodes [][]string, pods [][]string) *v1alpha1.RemoteNetworkConfigRequest {
+	c := &v1alpha1.RemoteNetworkConfigRequest{}
+	for _, cidrs := range nodes {
+		c.RemoteNodeNetworks = append(c.RemoteNodeNetworks, &v1alpha1.RemoteNodeNetwork{
+			CIDRs: aws.StringSlice(cidrs),
+		})
+	}
+	for _, cidrs := range pods {
+		c.RemotePodNetworks = append(c.RemotePodNetworks, &v1alpha1.RemotePodNetwork{
+			CIDRs: aws.StringSlice(cidrs),
+		})
+	}
+	return c
+}
+
+func TestRemoteNetworkConfigEqual(t *testing.T) {
+	base := newTestRemoteNetworkConfig(
+		[][]string{{"10.80.0.0/16", "10.81.0.0/16"}, {"192.168.0.0/24"}},
+		[][]string{{"10.85.0.0/16"}},
+	)
+	tests := []struct {
+		name string
+		a    *v1alpha1.RemoteNetworkConfigRequest
+		b    *v1alpha1.RemoteNetworkConfigRequest
+		want bool
+	}{
+		{"both nil", nil, nil, true},
+		{"nil and empty", nil, &v1alpha1.RemoteNetworkConfigRequest{}, true},
+		{"nil and set", nil, base, false},
+		{"same", base, base.DeepCopy(), true},
+		{
+			name: "CIDRs in a different order",
+			a:    base,
+			b: newTestRemoteNetworkConfig(
+				[][]string{{"192.168.0.0/24"}, {"10.81.0.0/16", "10.80.0.0/16"}},
+				[][]string{{"10.85.0.0/16"}},
+			),
+			want: true,
+		},
+		{
+			name: "node CIDR changed",
+			a:    base,
+			b: newTestRemoteNetworkConfig(
+				[][]string{{"10.80.0.0/16", "10.82.0.0/16"}, {"192.168.0.0/24"}},
+				[][]string{{"10.85.0.0/16"}},
+			),
+			want: false,
+		},
+		{
+			name: "pod networks removed",
+			a:    base,
+			b: newTestRemoteNetworkConfig(
+				[][]string{{"10.80.0.0/16", "10.81.0.0/16"}, {"192.168.0.0/24"}},
+				nil,
+			),
+			want: false,
+		},
+		{
+			name: "CIDR moved to another network",
+			a:    base,
+			b: newTestRemoteNetworkConfig(
+				[][]string{{"10.80.0.0/16"}, {"10.81.0.0/16", "192.168.0.0/24"}},
+				[][]string{{"10.85.0.0/16"}},
+			),
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, remoteNetworkConfigEqual(tt.a, tt.b))
+			assert.Equal(t, tt.want, remoteNetworkConfigEqual(tt.b, tt.a))
+		})
+	}
+}
+
+func TestCustomPreCompareRemoteNetworkConfig(t *testing.T) {
+	a := &resource{&v1alpha1.Cluster{}}
+	b := &resource{&v1alpha1.Cluster{}}
+	a.ko.Spec.RemoteNetworkConfig = newTestRemoteNetworkConfig([][]string{{"10.80.0.0/16", "10.81.0.0/16"}}, nil)
+	b.ko.Spec.RemoteNetworkConfig = newTestRemoteNetworkConfig([][]string{{"10.81.0.0/16", "10.80.0.0/16"}}, nil)
+
+	delta := ackcompare.NewDelta()
+	customPreCompare(delta, a, b)
+	assert.False(t, delta.DifferentAt("Spec.RemoteNetworkConfig"))
+
+	b.ko.Spec.RemoteNetworkConfig = newTestRemoteNetworkConfig([][]string{{"10.80.0.0/16"}}, nil)
+	delta = ackcompare.NewDelta()
+	customPreCompare(delta, a, b)
+	assert.True(t, delta.DifferentAt("Spec.RemoteNetworkConfig"))
+}
+
+func TestNewRemoteNetworkConfigRequest(t *testing.T) {
+	req := newRemoteNetworkConfigRequest(newTestRemoteNetworkConfig(
+		[][]string{{"10.80.0.0/16"}},
+		[][]string{{"10.85.0.0/16"}},
+	))
+	assert.Equal(t, []string{"10.80.0.0/16"}, req.RemoteNodeNetworks[0].Cidrs)
+	assert.Equal(t, []string{"10.85.0.0/16"}, req.RemotePodNetworks[0].Cidrs)
+
+	req = newRemoteNetworkConfigRequest(newTestRemoteNetworkConfig([][]string{{"10.80.0.0/16"}}, nil))
+	assert.NotNil(t, req.RemotePodNetworks)
+	assert.Empty(t, req.RemotePodNetworks)
+}
+
+func TestUpdateRemoteNetworkConfigRemoval(t *testing.T) {
+	desired := &resource{&v1alpha1.Cluster{}}
+	latest := &resource{&v1alpha1.Cluster{}}
+	latest.ko.Spec.RemoteNetworkConfig = newTestRemoteNetworkConfig([][]string{{"10.80.0.0/16"}}, nil)
+
+	rm := &resourceManager{}
+	_, err := rm.updateRemoteNetworkConfig(context.TODO(), desired, latest)
+	var terminalErr *ackerr.TerminalError
+	assert.ErrorAs(t, err, &terminalErr)
+	assert.ErrorIs(t, err, errRemoteNetworkConfigRemoval)
+}
@@ -491,6 +491,29 @@ func (rm *resourceManager) sdkFind(
 		ko.Spec.ResourcesVPCConfig.SecurityGroupRefs = r.ko.Spec.ResourcesVPCConfig.SecurityGroupRefs
 	}
 
+	if err := rm.syncUpdates(ctx, &resource{ko}); err != nil {
+		return nil, err
+	}
+	// The update plan is recomputed from the delta by customUpdate, any plan
+	// left in the status is stale once the cluster is read again.
+	ko.Status.UpdatePlan = nil
+	clearDryRunPlan(&resource{ko})
+	// Upgrade insights are only relevant while a version upgrade is pending.
+	if r.ko.Spec.Version == nil || ko.Spec.Version == nil || *r.ko.Spec.Version == *ko.Spec.Version {
+		clearUpgradeInsights(&resource{ko})
+	}
+	if clusterActive(&resource{ko}) {
+		if err := rm.syncUpgradeCascade(ctx, &resource{ko}); err != nil {
+			return nil, err
+		}
+		rm.syncKubeconfigSecret(ctx, &resource{ko})
+		if err := rm.syncVersionSupport(ctx, &resource{ko}); err != nil {
+			return nil, err
+		}
+	}
+	syncHealthConditions(ctx, &resource{ko})
+	syncMaintenanceWindowCondition(r, &resource{ko})
+
 	if !clusterActive(&resource{ko}) {
 		// Setting resource synced condition to false will trigger a requeue of
 		// the resource. No need to return a requeue error here.
@@ -537,6 +560,12 @@ func (rm *resourceManager) sdkCreate(
 	defer func() {
 		exit(err)
 	}()
+	if err := rm.validateVersion(ctx, desired.ko.Spec.Version); err != nil {
+		return nil, err
+	}
+	if _, err := clusterDeletionPolicy(desired); err != nil {
+		return nil, err
+	}
 	input, err := rm.newCreateRequestPayload(ctx, desired)
 	if err != nil {
 		return nil, err
@@ -1270,11 +1299,27 @@ func (rm *resourceManager) sdkDelete(
 	if clusterDeleting(r) {
 		return r, requeueWaitWhileDeleting
 	}
-	inUse, err := rm.clusterInUse(ctx, r)
+	policy, err := clusterDeletionPolicy(r)
 	if err != nil {
 		return nil, err
-	} else if inUse {
-		return r, requeueWaitWhileInUse
+	}
+	switch policy {
+	case svcapitypes.ClusterDeletionPolicyCascade:
+		if err := rm.cascadeDelete(ctx, r); err != nil {
+			return r, err
+		}
+	case svcapitypes.ClusterDeletionPolicyBlock:
+		inUse, err := rm.clusterInUse(ctx, r)
+		if err != nil {
+			return nil, err
+		} else if inUse {
+			return r, requeueWaitWhileInUse
+		}
+	case svcapitypes.ClusterDeletionPolicyOrphan:
+		// The children, and their custom resources, are left in place.
+	}
+	if err := rm.deleteKubeconfigSecret(ctx, r); err != nil {
+		return nil, err
 	}
 	input, err := rm.newDeleteRequestPayload(r)
 	if err != nil {
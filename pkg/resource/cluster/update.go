@@ -0,0 +1,213 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package cluster
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	ackrtlog "github.com/aws-controllers-k8s/runtime/pkg/runtime/log"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	svcsdk "github.com/aws/aws-sdk-go-v2/service/eks"
+	svcsdktypes "github.com/aws/aws-sdk-go-v2/service/eks/types"
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/aws-controllers-k8s/eks-controller/apis/v1alpha1"
+	"github.com/aws-controllers-k8s/eks-controller/pkg/condition"
+)
+
+const (
+	// maxTrackedUpdates is the maximum number of asynchronous updates kept in
+	// Status.Updates. When the limit is reached the oldest completed updates
+	// are evicted first; in-progress updates are never evicted.
+	maxTrackedUpdates = 10
+)
+
+// newUpdate converts an EKS SDK Update into its CR representation.
+func newUpdate(u *svcsdktypes.Update) *v1alpha1.Update {
+	if u == nil {
+		return nil
+	}
+	update := &v1alpha1.Update{
+		CreatedAt: newTime(u.CreatedAt),
+		ID:        u.Id,
+	}
+	if u.Status != "" {
+		update.Status = aws.String(string(u.Status))
+	}
+	if u.Type != "" {
+		update.Type = aws.String(string(u.Type))
+	}
+	if u.Cancellation != nil {
+		update.Cancellation = &v1alpha1.Cancellation{
+			Reason: u.Cancellation.Reason,
+		}
+		if u.Cancellation.Status != "" {
+			update.Cancellation.Status = aws.String(string(u.Cancellation.Status))
+		}
+	}
+	for _, e := range u.Errors {
+		errorDetail := &v1alpha1.ErrorDetail{
+			ErrorMessage: e.ErrorMessage,
+			ResourceIDs:  aws.StringSlice(e.ResourceIds),
+		}
+		if e.ErrorCode != "" {
+			errorDetail.ErrorCode = aws.String(string(e.ErrorCode))
+		}
+		update.Errors = append(update.Errors, errorDetail)
+	}
+	for _, p := range u.Params {
+		param := &v1alpha1.UpdateParam{
+			Value: p.Value,
+		}
+		if p.Type != "" {
+			param.Type = aws.String(string(p.Type))
+		}
+		update.Params = append(update.Params, param)
+	}
+	return update
+}
+
+// updateInProgress returns true if the supplied update hasn't reached a
+// final status yet.
+func updateInProgress(u *v1alpha1.Update) bool {
+	return u.Status != nil && *u.Status == string(svcsdktypes.UpdateStatusInProgress)
+}
+
+// recordUpdate records the supplied asynchronous update in the resource's
+// Status.Updates. An update that is already tracked (same ID) is replaced in
+// place, otherwise it is appended so that the list stays ordered from oldest
+// to newest.
+func recordUpdate(r *resource, u *svcsdktypes.Update) {
+	update := newUpdate(u)
+	if update == nil || update.ID == nil {
+		return
+	}
+	for i, existing := range r.ko.Status.Updates {
+		if existing.ID != nil && *existing.ID == *update.ID {
+			r.ko.Status.Updates[i] = update
+			return
+		}
+	}
+	r.ko.Status.Updates = trimUpdates(append(r.ko.Status.Updates, update))
+}
+
+// trimUpdates evicts the oldest completed updates until at most
+// maxTrackedUpdates remain. In-progress updates are always kept.
+func trimUpdates(updates []*v1alpha1.Update) []*v1alpha1.Update {
+	excess := len(updates) - maxTrackedUpdates
+	if excess <= 0 {
+		return updates
+	}
+	trimmed := make([]*v1alpha1.Update, 0, len(updates))
+	for _, u := range updates {
+		if excess > 0 && !updateInProgress(u) {
+			excess--
+			continue
+		}
+		trimmed = append(trimmed, u)
+	}
+	return trimmed
+}
+
+// syncUpdates refreshes every in-progress update tracked in the resource's
+// Status.Updates by calling DescribeUpdate, and then sets the UpdateFailed
+// condition accordingly.
+func (rm *resourceManager) syncUpdates(
+	ctx context.Context,
+	r *resource,
+) (err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.syncUpdates")
+	defer func() { exit(err) }()
+
+	updates := make([]*v1alpha1.Update, 0, len(r.ko.Status.Updates))
+	for _, u := range r.ko.Status.Updates {
+		if u.ID == nil || !updateInProgress(u) {
+			updates = append(updates, u)
+			continue
+		}
+		resp, err := rm.sdkapi.DescribeUpdate(ctx, &svcsdk.DescribeUpdateInput{
+			Name:     r.ko.Spec.Name,
+			UpdateId: u.ID,
+		})
+		rm.metrics.RecordAPICall("READ_ONE", "DescribeUpdate", err)
+		if err != nil {
+			awsErr, ok := extractAWSError(err)
+			// The update is no longer known to EKS, stop tracking it.
+			if ok && awsErr.Code == "ResourceNotFoundException" {
+				continue
+			}
+			return err
+		}
+		if update := newUpdate(resp.Update); update != nil {
+			updates = append(updates, update)
+		}
+	}
+	r.ko.Status.Updates = updates
+	setUpdateFailedCondition(r)
+	return nil
+}
+
+// setUpdateFailedCondition sets the UpdateFailed condition on the resource if
+// the most recent tracked update of any update type has failed, and removes
+// it otherwise. A failed update stops being reported as soon as a newer
+// update of the same type is started.
+func setUpdateFailedCondition(r *resource) {
+	latestByType := map[string]*v1alpha1.Update{}
+	types := []string{}
+	for _, u := range r.ko.Status.Updates {
+		updateType := aws.ToString(u.Type)
+		if _, ok := latestByType[updateType]; !ok {
+			types = append(types, updateType)
+		}
+		latestByType[updateType] = u
+	}
+
+	var reason string
+	messages := []string{}
+	for _, updateType := range types {
+		u := latestByType[updateType]
+		if aws.ToString(u.Status) != string(svcsdktypes.UpdateStatusFailed) {
+			continue
+		}
+		reason = updateType
+		messages = append(messages, failedUpdateMessage(u))
+	}
+	if len(messages) == 0 {
+		condition.Remove(r, v1alpha1.ConditionTypeUpdateFailed)
+		return
+	}
+	msg := strings.Join(messages, "; ")
+	condition.Set(r, v1alpha1.ConditionTypeUpdateFailed, corev1.ConditionTrue, &msg, &reason)
+}
+
+// failedUpdateMessage returns a human readable description of a failed
+// update, including the errors reported by EKS.
+func failedUpdateMessage(u *v1alpha1.Update) string {
+	msg := fmt.Sprintf("update %s (%s) failed", aws.ToString(u.ID), aws.ToString(u.Type))
+	details := []string{}
+	for _, e := range u.Errors {
+		detail := fmt.Sprintf("%s: %s", aws.ToString(e.ErrorCode), aws.ToString(e.ErrorMessage))
+		if len(e.ResourceIDs) > 0 {
+			detail += fmt.Sprintf(" %v", aws.ToStringSlice(e.ResourceIDs))
+		}
+		details = append(details, detail)
+	}
+	if len(details) > 0 {
+		msg += ": " + strings.Join(details, ", ")
+	}
+	return msg
+}
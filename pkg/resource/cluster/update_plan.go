@@ -0,0 +1,357 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package cluster
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	ackcompare "github.com/aws-controllers-k8s/runtime/pkg/compare"
+	ackcondition "github.com/aws-controllers-k8s/runtime/pkg/condition"
+	ackrequeue "github.com/aws-controllers-k8s/runtime/pkg/requeue"
+	ackrtlog "github.com/aws-controllers-k8s/runtime/pkg/runtime/log"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	svcsdktypes "github.com/aws/aws-sdk-go-v2/service/eks/types"
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/aws-controllers-k8s/eks-controller/pkg/maintenance"
+)
+
+// updateStep is a single step of a cluster update plan. Each step maps to
+// exactly one EKS API call, and therefore to one asynchronous update type.
+type updateStep struct {
+	// name identifies the step in Status.UpdatePlan.
+	name string
+	// fields are the delta paths handled by the step. All of them are sent to
+	// EKS together in a single call.
+	fields []string
+	// after lists the steps that must not be held back by their gate when
+	// this step runs. A step waits for the steps it lists.
+	after []string
+	// gate, if set, is checked before the step is applied. It returns a
+	// non-nil error (usually a requeue) when the step cannot run yet, and may
+	// record why in the status and conditions of updatedRes.
+	gate func(
+		rm *resourceManager,
+		ctx context.Context,
+		desired *resource,
+		latest *resource,
+		updatedRes *resource,
+	) error
+	// apply calls the EKS API and returns the update it started, if any. It
+	// may record progress in the status and conditions of updatedRes.
+	apply func(
+		rm *resourceManager,
+		ctx context.Context,
+		desired *resource,
+		latest *resource,
+		updatedRes *resource,
+		delta *ackcompare.Delta,
+	) (*svcsdktypes.Update, error)
+}
+
+// clusterUpdateSteps lists, in execution order, every update the controller
+// knows how to apply to an existing cluster. EKS only accepts one type of
+// update per UpdateClusterConfig call and a single in-flight update per
+// cluster, so fields that EKS accepts together are grouped in one step and the
+// steps are run one after the other. The order resolves the known conflicts
+// between steps:
+//
+//   - UpgradePolicy runs before Version, so that an upgrade into extended
+//     support happens with the support type the user asked for.
+//   - UpgradeCascade runs before Version, so that the node groups and add-ons
+//     catch up with the control plane before it moves to the next version.
+//   - Version runs before AutoMode and ComponentConfig, as newer compute and
+//     control plane component settings may only be accepted by the target
+//     Kubernetes version. Only AutoMode waits while Version is held back, the
+//     component settings are applied to the current version in the meantime.
+//   - DeletionProtection runs last so that enabling it never holds back any
+//     other change.
+//
+// The disruptive steps, UpgradeCascade and Version, only run while the
+// maintenance window of the cluster is open and Version is also held back by
+// the upgrade insights and the skew of the cascading upgrade. While a step is
+// held back, the steps that list it in after wait with it and the other steps
+// keep being applied.
+var clusterUpdateSteps = []updateStep{
+	{
+		name:   "Logging",
+		fields: []string{"Spec.Logging"},
+		apply: func(rm *resourceManager, ctx context.Context, desired, _, _ *resource, _ *ackcompare.Delta) (*svcsdktypes.Update, error) {
+			update, err := rm.updateConfigLogging(ctx, desired)
+			if err != nil {
+				// The API responds with an error if there were no changes applied
+				if awsErr, ok := extractAWSError(err); ok && awsErr.Message == LoggingNoChangesError {
+					return nil, nil
+				}
+				return nil, err
+			}
+			return update, nil
+		},
+	},
+	{
+		name: "EndpointAccess",
+		fields: []string{
+			"Spec.ResourcesVPCConfig.EndpointPrivateAccess",
+			"Spec.ResourcesVPCConfig.EndpointPublicAccess",
+			"Spec.ResourcesVPCConfig.PublicAccessCIDRs",
+		},
+		apply: func(rm *resourceManager, ctx context.Context, desired, _, _ *resource, _ *ackcompare.Delta) (*svcsdktypes.Update, error) {
+			return rm.updateConfigResourcesVPCConfigPublicAndPrivateAccess(ctx, desired)
+		},
+	},
+	{
+		name: "VPCResources",
+		fields: []string{
+			"Spec.ResourcesVPCConfig.SecurityGroupIDs",
+			"Spec.ResourcesVPCConfig.SecurityGroupRefs",
+			"Spec.ResourcesVPCConfig.SubnetIDs",
+			"Spec.ResourcesVPCConfig.SubnetRefs",
+		},
+		apply: func(rm *resourceManager, ctx context.Context, desired, _, _ *resource, _ *ackcompare.Delta) (*svcsdktypes.Update, error) {
+			return rm.updateConfigResourcesVPCConfigSubnetsAndSecurityGroups(ctx, desired)
+		},
+	},
+	{
+		name:   "RemoteNetworkConfig",
+		fields: []string{"Spec.RemoteNetworkConfig"},
+		apply: func(rm *resourceManager, ctx context.Context, desired, latest, _ *resource, _ *ackcompare.Delta) (*svcsdktypes.Update, error) {
+			return rm.updateRemoteNetworkConfig(ctx, desired, latest)
+		},
+	},
+	{
+		name:   "AccessConfig",
+		fields: []string{"Spec.AccessConfig"},
+		apply: func(rm *resourceManager, ctx context.Context, desired, _, _ *resource, _ *ackcompare.Delta) (*svcsdktypes.Update, error) {
+			return rm.updateAccessConfig(ctx, desired)
+		},
+	},
+	{
+		name:   "UpgradePolicy",
+		fields: []string{"Spec.UpgradePolicy"},
+		apply: func(rm *resourceManager, ctx context.Context, desired, _, _ *resource, _ *ackcompare.Delta) (*svcsdktypes.Update, error) {
+			return rm.updateClusterUpgradePolicy(ctx, desired)
+		},
+	},
+	{
+		name:   "EncryptionConfig",
+		fields: []string{"Spec.EncryptionConfig"},
+		apply: func(rm *resourceManager, ctx context.Context, desired, _, _ *resource, _ *ackcompare.Delta) (*svcsdktypes.Update, error) {
+			return rm.associateEncryptionConfig(ctx, desired)
+		},
+	},
+	{
+		name:   "UpgradeCascade",
+		fields: []string{"Spec.UpgradeCascade"},
+		gate: func(_ *resourceManager, ctx context.Context, desired, _, updatedRes *resource) error {
+			return maintenance.Check(ctx, desired.ko, updatedRes, "Cascading upgrade")
+		},
+		apply: func(rm *resourceManager, ctx context.Context, desired, _, updatedRes *resource, _ *ackcompare.Delta) (*svcsdktypes.Update, error) {
+			return nil, rm.applyUpgradeCascade(ctx, desired, updatedRes)
+		},
+	},
+	{
+		name:   "Version",
+		fields: []string{"Spec.Version"},
+		after:  []string{"UpgradeCascade"},
+		gate: func(rm *resourceManager, ctx context.Context, desired, latest, updatedRes *resource) error {
+			if err := maintenance.Check(ctx, desired.ko, updatedRes, "Cluster version upgrade"); err != nil {
+				return err
+			}
+			if err := rm.checkUpgradeCascadeSkew(desired, latest, updatedRes); err != nil {
+				return err
+			}
+			return rm.checkUpgradeInsights(ctx, desired, latest, updatedRes)
+		},
+		apply: func(rm *resourceManager, ctx context.Context, desired, latest, _ *resource, _ *ackcompare.Delta) (*svcsdktypes.Update, error) {
+			return rm.updateVersion(ctx, desired, latest)
+		},
+	},
+	{
+		name: "AutoMode",
+		fields: []string{
+			"Spec.ComputeConfig",
+			"Spec.StorageConfig",
+			"Spec.KubernetesNetworkConfig",
+		},
+		after: []string{"Version"},
+		apply: func(rm *resourceManager, ctx context.Context, desired, _, _ *resource, _ *ackcompare.Delta) (*svcsdktypes.Update, error) {
+			update, err := rm.updateComputeConfig(ctx, desired)
+			if err != nil {
+				return nil, fmt.Errorf("failed to update AutoMode config: %w", err)
+			}
+			return update, nil
+		},
+	},
+	{
+		name:   "ZonalShiftConfig",
+		fields: []string{"Spec.ZonalShiftConfig"},
+		apply: func(rm *resourceManager, ctx context.Context, desired, _, _ *resource, _ *ackcompare.Delta) (*svcsdktypes.Update, error) {
+			return rm.updateZonalShiftConfig(ctx, desired)
+		},
+	},
+	{
+		name:   "ControlPlaneScalingConfig",
+		fields: []string{"Spec.ControlPlaneScalingConfig"},
+		apply: func(rm *resourceManager, ctx context.Context, desired, _, _ *resource, _ *ackcompare.Delta) (*svcsdktypes.Update, error) {
+			return rm.updateControlPlaneScalingConfig(ctx, desired)
+		},
+	},
+	{
+		// These three configs (kube-apiserver, kube-scheduler,
+		// kube-controller-manager) are a single logical unit sent in one
+		// UpdateClusterConfig call.
+		name: "ComponentConfig",
+		fields: []string{
+			"Spec.KubeAPIServerConfig",
+			"Spec.KubeSchedulerConfig",
+			"Spec.KubeControllerManagerConfig",
+		},
+		apply: func(rm *resourceManager, ctx context.Context, desired, _, _ *resource, delta *ackcompare.Delta) (*svcsdktypes.Update, error) {
+			return rm.updateComponentConfig(ctx, desired, delta)
+		},
+	},
+	{
+		name:   "DeletionProtection",
+		fields: []string{"Spec.DeletionProtection"},
+		apply: func(rm *resourceManager, ctx context.Context, desired, _, _ *resource, _ *ackcompare.Delta) (*svcsdktypes.Update, error) {
+			return rm.updateDeletionProtection(ctx, desired)
+		},
+	},
+}
+
+// newUpdatePlan returns the ordered list of steps required to reconcile the
+// differences in the supplied delta. The fields of each returned step are
+// restricted to the ones that actually differ.
+func newUpdatePlan(delta *ackcompare.Delta) []updateStep {
+	plan := []updateStep{}
+	for _, step := range clusterUpdateSteps {
+		changed := []string{}
+		for _, field := range step.fields {
+			if delta.DifferentAt(field) {
+				changed = append(changed, field)
+			}
+		}
+		if len(changed) == 0 {
+			continue
+		}
+		step.fields = changed
+		plan = append(plan, step)
+	}
+	return plan
+}
+
+// describeUpdatePlan returns the representation of the supplied plan stored
+// in Status.UpdatePlan. Each entry has the form "<step> (<field>, ...)".
+func describeUpdatePlan(plan []updateStep) []*string {
+	if len(plan) == 0 {
+		return nil
+	}
+	steps := make([]*string, 0, len(plan))
+	for _, step := range plan {
+		s := fmt.Sprintf("%s (%s)", step.name, strings.Join(step.fields, ", "))
+		steps = append(steps, &s)
+	}
+	return steps
+}
+
+// syncMaintenanceWindowCondition removes the WaitingForMaintenanceWindow
+// condition of the latest cluster when none of the steps left to reconcile it
+// with the desired cluster is gated.
+func syncMaintenanceWindowCondition(desired, latest *resource) {
+	for _, step := range newUpdatePlan(newResourceDelta(desired, latest)) {
+		if step.gate != nil {
+			return
+		}
+	}
+	maintenance.Clear(latest)
+}
+
+// runUpdatePlan applies the steps of the supplied plan in order and records
+// the steps left in Status.UpdatePlan. EKS only allows one in-flight update
+// per cluster, so it stops at the first step that starts an asynchronous
+// update and the remaining steps are picked up once the cluster is active
+// again. A step held back by its gate is skipped, along with the steps that
+// wait for it, and the error of the first gate is returned once the other
+// steps have been applied.
+func (rm *resourceManager) runUpdatePlan(
+	ctx context.Context,
+	desired *resource,
+	latest *resource,
+	updatedRes *resource,
+	delta *ackcompare.Delta,
+	plan []updateStep,
+) (*resource, error) {
+	rlog := ackrtlog.FromContext(ctx)
+
+	var gateErr error
+	held := []updateStep{}
+	heldNames := map[string]bool{}
+	for i, step := range plan {
+		pending := append(append([]updateStep{}, held...), plan[i:]...)
+		updatedRes.ko.Status.UpdatePlan = describeUpdatePlan(pending)
+		waiting := false
+		for _, name := range step.after {
+			waiting = waiting || heldNames[name]
+		}
+		if !waiting && step.gate != nil {
+			if err := step.gate(rm, ctx, desired, latest, updatedRes); err != nil {
+				if gateErr == nil {
+					gateErr = err
+					msg := fmt.Sprintf("Cluster update step '%s' cannot be applied yet", step.name)
+					ackcondition.SetSynced(updatedRes, corev1.ConditionFalse, &msg, nil)
+				}
+				waiting = true
+			}
+		}
+		if waiting {
+			rlog.Debug("holding back cluster update step", "step", step.name, "fields", step.fields)
+			held = append(held, step)
+			heldNames[step.name] = true
+			continue
+		}
+		rlog.Debug("applying cluster update step", "step", step.name, "fields", step.fields)
+		update, err := step.apply(rm, ctx, desired, latest, updatedRes, delta)
+		if err != nil {
+			var requeueErr *ackrequeue.RequeueNeededAfter
+			if errors.As(err, &requeueErr) {
+				return updatedRes, err
+			}
+			awsErr, ok := extractAWSError(err)
+
+			// Check to see if we've raced an async update call and need to requeue
+			if ok && awsErr.Code == "ResourceInUseException" {
+				return nil, requeueAfterAsyncUpdate()
+			}
+			return nil, err
+		}
+		recordUpdate(updatedRes, update)
+		if update == nil || update.Status == svcsdktypes.UpdateStatusSuccessful {
+			continue
+		}
+		// The status returned by DescribeCluster doesn't always reflect the
+		// update right away, so we have to explicitly requeue and set the
+		// status to updating.
+		updatedRes.ko.Status.Status = aws.String(string(svcsdktypes.ClusterStatusUpdating))
+		return returnClusterUpdating(updatedRes)
+	}
+
+	updatedRes.ko.Status.UpdatePlan = describeUpdatePlan(held)
+	if gateErr != nil {
+		return updatedRes, gateErr
+	}
+	return updatedRes, nil
+}
@@ -0,0 +1,222 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package cluster
+
+import (
+	"context"
+	"testing"
+
+	ackcompare "github.com/aws-controllers-k8s/runtime/pkg/compare"
+	ackcondition "github.com/aws-controllers-k8s/runtime/pkg/condition"
+	ackrequeue "github.com/aws-controllers-k8s/runtime/pkg/requeue"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	svcsdktypes "github.com/aws/aws-sdk-go-v2/service/eks/types"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/aws-controllers-k8s/eks-controller/apis/v1alpha1"
+	"github.com/aws-controllers-k8s/eks-controller/pkg/condition"
+)
+
+func TestNewUpdatePlan(t *testing.T) {
+	tests := []struct {
+		name      string
+		fields    []string
+		wantSteps []string
+	}{
+		{
+			name:      "no changes",
+			fields:    nil,
+			wantSteps: []string{},
+		},
+		{
+			name:      "tags only",
+			fields:    []string{"Spec.Tags"},
+			wantSteps: []string{},
+		},
+		{
+			name: "version is applied before component config",
+			fields: []string{
+				"Spec.KubeSchedulerConfig",
+				"Spec.Version",
+			},
+			wantSteps: []string{
+				"Version (Spec.Version)",
+				"ComponentConfig (Spec.KubeSchedulerConfig)",
+			},
+		},
+		{
+			name: "compatible changes are grouped",
+			fields: []string{
+				"Spec.DeletionProtection",
+				"Spec.ResourcesVPCConfig.EndpointPublicAccess",
+				"Spec.ResourcesVPCConfig.PublicAccessCIDRs",
+				"Spec.ComputeConfig",
+				"Spec.StorageConfig",
+				"Spec.Logging",
+			},
+			wantSteps: []string{
+				"Logging (Spec.Logging)",
+				"EndpointAccess (Spec.ResourcesVPCConfig.EndpointPublicAccess, Spec.ResourcesVPCConfig.PublicAccessCIDRs)",
+				"AutoMode (Spec.ComputeConfig, Spec.StorageConfig)",
+				"DeletionProtection (Spec.DeletionProtection)",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			delta := ackcompare.NewDelta()
+			for _, field := range tt.fields {
+				delta.Add(field, nil, nil)
+			}
+			got := describeUpdatePlan(newUpdatePlan(delta))
+			assert.Equal(t, tt.wantSteps, aws.ToStringSlice(got))
+		})
+	}
+}
+
+func TestRunUpdatePlan(t *testing.T) {
+	errGated := ackrequeue.NeededAfter(nil, 0)
+	gated := func(*resourceManager, context.Context, *resource, *resource, *resource) error {
+		return errGated
+	}
+	tests := []struct {
+		name        string
+		steps       []updateStep
+		async       string
+		wantApplied []string
+		wantPlan    []string
+		wantErr     error
+	}{
+		{
+			name: "all steps are applied",
+			steps: []updateStep{
+				{name: "Logging"},
+				{name: "Version"},
+				{name: "DeletionProtection"},
+			},
+			wantApplied: []string{"Logging", "Version", "DeletionProtection"},
+			wantPlan:    []string{},
+		},
+		{
+			name: "stops at the first asynchronous update",
+			steps: []updateStep{
+				{name: "Logging"},
+				{name: "Version"},
+				{name: "DeletionProtection"},
+			},
+			async:       "Version",
+			wantApplied: []string{"Logging", "Version"},
+			wantPlan:    []string{"Version ()", "DeletionProtection ()"},
+			wantErr:     requeueAfterAsyncUpdate(),
+		},
+		{
+			name: "independent steps run while a step is gated",
+			steps: []updateStep{
+				{name: "Version", gate: gated},
+				{name: "AutoMode", after: []string{"Version"}},
+				{name: "ZonalShiftConfig"},
+				{name: "DeletionProtection"},
+			},
+			wantApplied: []string{"ZonalShiftConfig", "DeletionProtection"},
+			wantPlan:    []string{"Version ()", "AutoMode ()"},
+			wantErr:     errGated,
+		},
+		{
+			name: "gated steps stay in the plan of an asynchronous update",
+			steps: []updateStep{
+				{name: "UpgradeCascade", gate: gated},
+				{name: "Version", after: []string{"UpgradeCascade"}},
+				{name: "ControlPlaneScalingConfig"},
+				{name: "DeletionProtection"},
+			},
+			async:       "ControlPlaneScalingConfig",
+			wantApplied: []string{"ControlPlaneScalingConfig"},
+			wantPlan:    []string{"UpgradeCascade ()", "Version ()", "ControlPlaneScalingConfig ()", "DeletionProtection ()"},
+			wantErr:     requeueAfterAsyncUpdate(),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			applied := []string{}
+			plan := []updateStep{}
+			for _, step := range tt.steps {
+				name := step.name
+				step.apply = func(*resourceManager, context.Context, *resource, *resource, *resource, *ackcompare.Delta) (*svcsdktypes.Update, error) {
+					applied = append(applied, name)
+					if name != tt.async {
+						return nil, nil
+					}
+					return &svcsdktypes.Update{
+						Id:     aws.String("update-" + name),
+						Status: svcsdktypes.UpdateStatusInProgress,
+					}, nil
+				}
+				plan = append(plan, step)
+			}
+			latest := &resource{ko: &v1alpha1.Cluster{}}
+			updatedRes := &resource{ko: latest.ko.DeepCopy()}
+
+			got, err := (&resourceManager{}).runUpdatePlan(
+				context.TODO(), latest, latest, updatedRes, ackcompare.NewDelta(), plan,
+			)
+			assert.Equal(t, tt.wantErr, err)
+			assert.Equal(t, tt.wantApplied, applied)
+			assert.Equal(t, tt.wantPlan, aws.ToStringSlice(got.ko.Status.UpdatePlan))
+		})
+	}
+}
+
+func TestSyncMaintenanceWindowCondition(t *testing.T) {
+	tests := []struct {
+		name        string
+		desired     func(*v1alpha1.Cluster)
+		wantCleared bool
+	}{
+		{
+			name:        "no change",
+			desired:     func(*v1alpha1.Cluster) {},
+			wantCleared: true,
+		},
+		{
+			name: "ungated change",
+			desired: func(c *v1alpha1.Cluster) {
+				c.Spec.DeletionProtection = aws.Bool(true)
+			},
+			wantCleared: true,
+		},
+		{
+			name: "version upgrade",
+			desired: func(c *v1alpha1.Cluster) {
+				c.Spec.Version = aws.String("1.31")
+			},
+			wantCleared: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			latest := &resource{ko: &v1alpha1.Cluster{}}
+			latest.ko.Spec.Version = aws.String("1.30")
+			msg := "Cluster version upgrade held until the maintenance window opens"
+			condition.Set(latest, v1alpha1.ConditionTypeWaitingForMaintenanceWindow, corev1.ConditionTrue, &msg, nil)
+			desired := &resource{ko: latest.ko.DeepCopy()}
+			tt.desired(desired.ko)
+
+			syncMaintenanceWindowCondition(desired, latest)
+			cond := ackcondition.FirstOfType(latest, v1alpha1.ConditionTypeWaitingForMaintenanceWindow)
+			assert.Equal(t, tt.wantCleared, cond == nil)
+		})
+	}
+}
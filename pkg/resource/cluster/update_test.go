@@ -0,0 +1,148 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package cluster
+
+import (
+	"fmt"
+	"testing"
+
+	ackcondition "github.com/aws-controllers-k8s/runtime/pkg/condition"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	svcsdktypes "github.com/aws/aws-sdk-go-v2/service/eks/types"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/aws-controllers-k8s/eks-controller/apis/v1alpha1"
+)
+
+func newTestUpdate(id string, updateType svcsdktypes.UpdateType, status svcsdktypes.UpdateStatus) *v1alpha1.Update {
+	return &v1alpha1.Update{
+		ID:     aws.String(id),
+		Type:   aws.String(string(updateType)),
+		Status: aws.String(string(status)),
+	}
+}
+
+func TestNewUpdate(t *testing.T) {
+	assert.Nil(t, newUpdate(nil))
+
+	update := newUpdate(&svcsdktypes.Update{
+		Id:     aws.String("id-1"),
+		Status: svcsdktypes.UpdateStatusFailed,
+		Type:   svcsdktypes.UpdateTypeVersionUpdate,
+		Params: []svcsdktypes.UpdateParam{
+			{Type: svcsdktypes.UpdateParamTypeVersion, Value: aws.String("1.31")},
+		},
+		Errors: []svcsdktypes.ErrorDetail{
+			{
+				ErrorCode:    svcsdktypes.ErrorCodeSubnetNotFound,
+				ErrorMessage: aws.String("subnet not found"),
+				ResourceIds:  []string{"subnet-1"},
+			},
+		},
+	})
+	assert.Equal(t, "id-1", *update.ID)
+	assert.Equal(t, "Failed", *update.Status)
+	assert.Equal(t, "VersionUpdate", *update.Type)
+	assert.Equal(t, "Version", *update.Params[0].Type)
+	assert.Equal(t, "1.31", *update.Params[0].Value)
+	assert.Equal(t, "SubnetNotFound", *update.Errors[0].ErrorCode)
+	assert.Equal(t, []*string{aws.String("subnet-1")}, update.Errors[0].ResourceIDs)
+	assert.Nil(t, update.CreatedAt)
+	assert.Nil(t, update.Cancellation)
+}
+
+func TestRecordUpdate(t *testing.T) {
+	r := &resource{ko: &v1alpha1.Cluster{}}
+
+	recordUpdate(r, nil)
+	assert.Empty(t, r.ko.Status.Updates)
+
+	recordUpdate(r, &svcsdktypes.Update{
+		Id:     aws.String("id-1"),
+		Status: svcsdktypes.UpdateStatusInProgress,
+		Type:   svcsdktypes.UpdateTypeLoggingUpdate,
+	})
+	recordUpdate(r, &svcsdktypes.Update{
+		Id:     aws.String("id-1"),
+		Status: svcsdktypes.UpdateStatusSuccessful,
+		Type:   svcsdktypes.UpdateTypeLoggingUpdate,
+	})
+	assert.Len(t, r.ko.Status.Updates, 1)
+	assert.Equal(t, "Successful", *r.ko.Status.Updates[0].Status)
+}
+
+func TestTrimUpdates(t *testing.T) {
+	updates := []*v1alpha1.Update{
+		newTestUpdate("in-progress", svcsdktypes.UpdateTypeVersionUpdate, svcsdktypes.UpdateStatusInProgress),
+	}
+	for i := 0; i < maxTrackedUpdates; i++ {
+		updates = append(updates, newTestUpdate(fmt.Sprintf("id-%d", i), svcsdktypes.UpdateTypeLoggingUpdate, svcsdktypes.UpdateStatusSuccessful))
+	}
+
+	trimmed := trimUpdates(updates)
+	assert.Len(t, trimmed, maxTrackedUpdates)
+	// The oldest update is still in progress and must be kept, the oldest
+	// completed one is evicted instead.
+	assert.Equal(t, "in-progress", *trimmed[0].ID)
+	assert.Equal(t, "id-1", *trimmed[1].ID)
+}
+
+func TestSetUpdateFailedCondition(t *testing.T) {
+	tests := []struct {
+		name          string
+		updates       []*v1alpha1.Update
+		wantCondition bool
+		wantReason    string
+	}{
+		{
+			name:          "no updates",
+			updates:       nil,
+			wantCondition: false,
+		},
+		{
+			name: "latest update succeeded",
+			updates: []*v1alpha1.Update{
+				newTestUpdate("id-1", svcsdktypes.UpdateTypeVersionUpdate, svcsdktypes.UpdateStatusFailed),
+				newTestUpdate("id-2", svcsdktypes.UpdateTypeVersionUpdate, svcsdktypes.UpdateStatusSuccessful),
+			},
+			wantCondition: false,
+		},
+		{
+			name: "latest update failed",
+			updates: []*v1alpha1.Update{
+				newTestUpdate("id-1", svcsdktypes.UpdateTypeVersionUpdate, svcsdktypes.UpdateStatusSuccessful),
+				newTestUpdate("id-2", svcsdktypes.UpdateTypeLoggingUpdate, svcsdktypes.UpdateStatusFailed),
+			},
+			wantCondition: true,
+			wantReason:    "LoggingUpdate",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &resource{ko: &v1alpha1.Cluster{}}
+			r.ko.Status.Updates = tt.updates
+			setUpdateFailedCondition(r)
+
+			c := ackcondition.FirstOfType(r, v1alpha1.ConditionTypeUpdateFailed)
+			if !tt.wantCondition {
+				assert.Nil(t, c)
+				return
+			}
+			assert.NotNil(t, c)
+			assert.Equal(t, tt.wantReason, *c.Reason)
+			assert.Contains(t, *c.Message, "id-2")
+		})
+	}
+}
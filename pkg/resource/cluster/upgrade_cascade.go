@@ -0,0 +1,484 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package cluster
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	ackcondition "github.com/aws-controllers-k8s/runtime/pkg/condition"
+	ackrequeue "github.com/aws-controllers-k8s/runtime/pkg/requeue"
+	ackrtlog "github.com/aws-controllers-k8s/runtime/pkg/runtime/log"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	svcsdk "github.com/aws/aws-sdk-go-v2/service/eks"
+	svcsdktypes "github.com/aws/aws-sdk-go-v2/service/eks/types"
+	corev1 "k8s.io/api/core/v1"
+	ctrlrtclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/aws-controllers-k8s/eks-controller/apis/v1alpha1"
+	"github.com/aws-controllers-k8s/eks-controller/pkg/condition"
+	"github.com/aws-controllers-k8s/eks-controller/pkg/kube"
+	"github.com/aws-controllers-k8s/eks-controller/pkg/util"
+)
+
+// The kinds of children moved by a cascading upgrade.
+const (
+	UpgradeCascadeKindNodegroup = "Nodegroup"
+	UpgradeCascadeKindAddon     = "Addon"
+)
+
+// The states of a child of a cascading upgrade.
+const (
+	// UpgradeCascadeStatePending means the child is waiting for its turn to
+	// be moved to the target version.
+	UpgradeCascadeStatePending = "Pending"
+	// UpgradeCascadeStateUpdating means EKS is moving the child.
+	UpgradeCascadeStateUpdating = "Updating"
+	// UpgradeCascadeStateUpgraded means the child runs the target version.
+	UpgradeCascadeStateUpgraded = "Upgraded"
+	// UpgradeCascadeStatePinned means the custom resource managing the child
+	// sets its version explicitly, the cascade leaves it alone.
+	UpgradeCascadeStatePinned = "Pinned"
+	// UpgradeCascadeStateSkipped means the cascade cannot move the child, for
+	// instance because EKS reports no version for it.
+	UpgradeCascadeStateSkipped = "Skipped"
+	// UpgradeCascadeStateFailed means the last update of the child failed.
+	// The cascade doesn't retry it until the target version changes.
+	UpgradeCascadeStateFailed = "Failed"
+)
+
+const (
+	// upgradeBlockedReasonMaxSkew is the reason of the UpgradeBlocked
+	// condition set when the node groups lag too far behind the next version.
+	upgradeBlockedReasonMaxSkew = "MaxSkew"
+	// defaultUpgradeCascadeMaxSkew is the default maximum number of minor
+	// versions the node groups may lag behind the control plane.
+	defaultUpgradeCascadeMaxSkew = 1
+)
+
+// requeueWaitWhileCascading returns a `ackrequeue.RequeueNeededAfter` struct
+// explaining the cluster cannot be modified until the cascading upgrade of
+// its children progresses.
+func requeueWaitWhileCascading(kind, name string) *ackrequeue.RequeueNeededAfter {
+	return ackrequeue.NeededAfter(
+		fmt.Errorf("cascading upgrade of %s '%s' in progress", kind, name),
+		RequeueAfterUpdateDuration,
+	)
+}
+
+// upgradeCascadeEnabled returns true if the supplied cluster opted in the
+// cascading upgrade of its children.
+func upgradeCascadeEnabled(r *resource) bool {
+	return r.ko.Spec.UpgradeCascade != nil && aws.ToBool(r.ko.Spec.UpgradeCascade.Enabled)
+}
+
+// upgradeCascadeMaxSkew returns the maximum number of minor versions the node
+// groups of the supplied cluster may lag behind its control plane.
+func upgradeCascadeMaxSkew(r *resource) int {
+	if r.ko.Spec.UpgradeCascade == nil || r.ko.Spec.UpgradeCascade.MaxSkew == nil {
+		return defaultUpgradeCascadeMaxSkew
+	}
+	return int(*r.ko.Spec.UpgradeCascade.MaxSkew)
+}
+
+// upgradeCascadeInProgress returns true if any child of the cascading upgrade
+// still has to be moved, or is being moved, to the target version.
+func upgradeCascadeInProgress(children []*v1alpha1.UpgradeCascadeChild) bool {
+	for _, c := range children {
+		switch aws.ToString(c.State) {
+		case UpgradeCascadeStatePending, UpgradeCascadeStateUpdating:
+			return true
+		}
+	}
+	return false
+}
+
+// cascadeChildKey returns the key identifying a child of the cascading
+// upgrade.
+func cascadeChildKey(kind, name string) string {
+	return kind + "/" + name
+}
+
+// cascadeChildState returns the state of a child of the cascading upgrade.
+// prev is the state recorded during the previous reconciliation, if any.
+func cascadeChildState(
+	upgraded bool,
+	updating bool,
+	pinned bool,
+	targetVersion string,
+	prev *v1alpha1.UpgradeCascadeChild,
+) string {
+	switch {
+	case upgraded:
+		return UpgradeCascadeStateUpgraded
+	case updating:
+		return UpgradeCascadeStateUpdating
+	case pinned:
+		return UpgradeCascadeStatePinned
+	case prev != nil &&
+		aws.ToString(prev.TargetVersion) == targetVersion &&
+		aws.ToString(prev.State) == UpgradeCascadeStateFailed:
+		return UpgradeCascadeStateFailed
+	default:
+		return UpgradeCascadeStatePending
+	}
+}
+
+// syncUpgradeCascade refreshes the progress of the cascading upgrade in the
+// resource's Status.UpgradeCascadeProgress. Node groups come first, then
+// add-ons, both sorted by name, which is the order the cascade moves them in.
+func (rm *resourceManager) syncUpgradeCascade(
+	ctx context.Context,
+	r *resource,
+) (err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.syncUpgradeCascade")
+	defer func() { exit(err) }()
+
+	if !upgradeCascadeEnabled(r) || r.ko.Spec.Version == nil {
+		r.ko.Status.UpgradeCascadeProgress = nil
+		return nil
+	}
+	targetVersion := *r.ko.Spec.Version
+
+	prev := map[string]*v1alpha1.UpgradeCascadeChild{}
+	for _, c := range r.ko.Status.UpgradeCascadeProgress {
+		prev[cascadeChildKey(aws.ToString(c.Kind), aws.ToString(c.Name))] = c
+	}
+	pinned, err := rm.pinnedCascadeChildren(ctx, r)
+	if err != nil {
+		return err
+	}
+
+	children := []*v1alpha1.UpgradeCascadeChild{}
+
+	nodegroups, err := rm.listNodegroupNames(ctx, r)
+	if err != nil {
+		return err
+	}
+	sort.Strings(nodegroups)
+	for _, name := range nodegroups {
+		resp, err := rm.sdkapi.DescribeNodegroup(ctx, &svcsdk.DescribeNodegroupInput{
+			ClusterName:   r.ko.Spec.Name,
+			NodegroupName: aws.String(name),
+		})
+		rm.metrics.RecordAPICall("READ_ONE", "DescribeNodegroup", err)
+		if err != nil {
+			return err
+		}
+		child := &v1alpha1.UpgradeCascadeChild{
+			Kind:          aws.String(UpgradeCascadeKindNodegroup),
+			Name:          aws.String(name),
+			TargetVersion: aws.String(targetVersion),
+			Version:       resp.Nodegroup.Version,
+		}
+		if child.Version == nil {
+			child.State = aws.String(UpgradeCascadeStateSkipped)
+			child.Message = aws.String("node group reports no Kubernetes version")
+		} else {
+			cmp, err := util.CompareEKSKubernetesVersions(*child.Version, targetVersion)
+			if err != nil {
+				return err
+			}
+			updating := resp.Nodegroup.Status == svcsdktypes.NodegroupStatusUpdating
+			key := cascadeChildKey(UpgradeCascadeKindNodegroup, name)
+			if err := rm.setCascadeChildState(ctx, r, child, cmp >= 0, updating, pinned[key], prev[key]); err != nil {
+				return err
+			}
+		}
+		children = append(children, child)
+	}
+
+	addons, err := rm.listAddonNames(ctx, r)
+	if err != nil {
+		return err
+	}
+	sort.Strings(addons)
+	for _, name := range addons {
+		resp, err := rm.sdkapi.DescribeAddon(ctx, &svcsdk.DescribeAddonInput{
+			ClusterName: r.ko.Spec.Name,
+			AddonName:   aws.String(name),
+		})
+		rm.metrics.RecordAPICall("READ_ONE", "DescribeAddon", err)
+		if err != nil {
+			return err
+		}
+		defaultVersion, err := rm.defaultAddonVersion(ctx, name, targetVersion)
+		if err != nil {
+			return err
+		}
+		child := &v1alpha1.UpgradeCascadeChild{
+			Kind:    aws.String(UpgradeCascadeKindAddon),
+			Name:    aws.String(name),
+			Version: resp.Addon.AddonVersion,
+		}
+		if defaultVersion == "" || child.Version == nil {
+			child.State = aws.String(UpgradeCascadeStateSkipped)
+			child.Message = aws.String(fmt.Sprintf("no default add-on version for Kubernetes %s", targetVersion))
+		} else {
+			child.TargetVersion = aws.String(defaultVersion)
+			// Never move an add-on backwards, it may run a version newer than
+			// the default one.
+			cmp, err := util.CompareAddonVersions(*child.Version, defaultVersion)
+			if err != nil {
+				return err
+			}
+			updating := resp.Addon.Status == svcsdktypes.AddonStatusUpdating
+			key := cascadeChildKey(UpgradeCascadeKindAddon, name)
+			if err := rm.setCascadeChildState(ctx, r, child, cmp >= 0, updating, pinned[key], prev[key]); err != nil {
+				return err
+			}
+		}
+		children = append(children, child)
+	}
+
+	r.ko.Status.UpgradeCascadeProgress = children
+	return nil
+}
+
+// setCascadeChildState sets the state of the supplied child. When the last
+// update started by the cascade is over but the child didn't reach the target
+// version, the update is described to report why it failed.
+func (rm *resourceManager) setCascadeChildState(
+	ctx context.Context,
+	r *resource,
+	child *v1alpha1.UpgradeCascadeChild,
+	upgraded bool,
+	updating bool,
+	pinned bool,
+	prev *v1alpha1.UpgradeCascadeChild,
+) error {
+	targetVersion := aws.ToString(child.TargetVersion)
+	state := cascadeChildState(upgraded, updating, pinned, targetVersion, prev)
+	if prev != nil && aws.ToString(prev.TargetVersion) == targetVersion {
+		child.UpdateID = prev.UpdateID
+		if state == UpgradeCascadeStateFailed {
+			child.Message = prev.Message
+		}
+	}
+
+	if state == UpgradeCascadeStatePending &&
+		prev != nil && prev.UpdateID != nil &&
+		aws.ToString(prev.State) == UpgradeCascadeStateUpdating &&
+		aws.ToString(prev.TargetVersion) == targetVersion {
+		input := &svcsdk.DescribeUpdateInput{
+			Name:     r.ko.Spec.Name,
+			UpdateId: prev.UpdateID,
+		}
+		if aws.ToString(child.Kind) == UpgradeCascadeKindNodegroup {
+			input.NodegroupName = child.Name
+		} else {
+			input.AddonName = child.Name
+		}
+		resp, err := rm.sdkapi.DescribeUpdate(ctx, input)
+		rm.metrics.RecordAPICall("READ_ONE", "DescribeUpdate", err)
+		if err != nil {
+			return err
+		}
+		if resp.Update.Status == svcsdktypes.UpdateStatusFailed ||
+			resp.Update.Status == svcsdktypes.UpdateStatusCancelled {
+			state = UpgradeCascadeStateFailed
+			child.Message = aws.String(failedUpdateMessage(newUpdate(resp.Update)))
+		}
+	}
+	child.State = aws.String(state)
+	return nil
+}
+
+// defaultAddonVersion returns the default version of the supplied add-on for
+// the supplied Kubernetes version, or an empty string if there is none.
+func (rm *resourceManager) defaultAddonVersion(
+	ctx context.Context,
+	addonName string,
+	kubernetesVersion string,
+) (string, error) {
+	resp, err := rm.sdkapi.DescribeAddonVersions(ctx, &svcsdk.DescribeAddonVersionsInput{
+		AddonName:         aws.String(addonName),
+		KubernetesVersion: aws.String(kubernetesVersion),
+	})
+	rm.metrics.RecordAPICall("READ_MANY", "DescribeAddonVersions", err)
+	if err != nil {
+		return "", err
+	}
+	for _, addon := range resp.Addons {
+		for _, v := range addon.AddonVersions {
+			for _, c := range v.Compatibilities {
+				if c.DefaultVersion && aws.ToString(c.ClusterVersion) == kubernetesVersion {
+					return aws.ToString(v.AddonVersion), nil
+				}
+			}
+		}
+	}
+	return "", nil
+}
+
+// pinnedCascadeChildren returns the keys of the children of the cluster whose
+// version is explicitly set by the Nodegroup or Addon custom resource that
+// manages them. The cascade must not move those, as the controller would
+// otherwise try to move them back.
+func (rm *resourceManager) pinnedCascadeChildren(
+	ctx context.Context,
+	r *resource,
+) (map[string]bool, error) {
+	pinned := map[string]bool{}
+	cache, err := kube.ClusterCache(rm.cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	nodegroups, err := kube.ListClusterChildren(ctx, cache, r.ko, func() ctrlrtclient.ObjectList {
+		return &v1alpha1.NodegroupList{}
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, obj := range nodegroups {
+		ng := obj.(*v1alpha1.Nodegroup)
+		if ng.Spec.Name != nil && aws.ToString(ng.Spec.Version) != "" {
+			pinned[cascadeChildKey(UpgradeCascadeKindNodegroup, *ng.Spec.Name)] = true
+		}
+	}
+
+	addons, err := kube.ListClusterChildren(ctx, cache, r.ko, func() ctrlrtclient.ObjectList {
+		return &v1alpha1.AddonList{}
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, obj := range addons {
+		addon := obj.(*v1alpha1.Addon)
+		if addon.Spec.Name != nil && aws.ToString(addon.Spec.AddonVersion) != "" {
+			pinned[cascadeChildKey(UpgradeCascadeKindAddon, *addon.Spec.Name)] = true
+		}
+	}
+	return pinned, nil
+}
+
+// applyUpgradeCascade moves the next child of the cascading upgrade to its
+// target version. Children are moved one at a time: node groups first, then
+// add-ons.
+func (rm *resourceManager) applyUpgradeCascade(
+	ctx context.Context,
+	desired *resource,
+	updatedRes *resource,
+) (err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.applyUpgradeCascade")
+	defer func() { exit(err) }()
+
+	if !upgradeCascadeEnabled(desired) {
+		return nil
+	}
+
+	var next *v1alpha1.UpgradeCascadeChild
+	for _, c := range updatedRes.ko.Status.UpgradeCascadeProgress {
+		switch aws.ToString(c.State) {
+		case UpgradeCascadeStateUpdating:
+			msg := fmt.Sprintf("Cascading upgrade of %s '%s' in progress", *c.Kind, *c.Name)
+			ackcondition.SetSynced(updatedRes, corev1.ConditionFalse, &msg, nil)
+			return requeueWaitWhileCascading(*c.Kind, *c.Name)
+		case UpgradeCascadeStatePending:
+			if next == nil {
+				next = c
+			}
+		}
+	}
+	if next == nil {
+		return nil
+	}
+
+	var update *svcsdktypes.Update
+	if *next.Kind == UpgradeCascadeKindNodegroup {
+		resp, err := rm.sdkapi.UpdateNodegroupVersion(ctx, &svcsdk.UpdateNodegroupVersionInput{
+			ClusterName:   desired.ko.Spec.Name,
+			NodegroupName: next.Name,
+			Version:       next.TargetVersion,
+		})
+		rm.metrics.RecordAPICall("UPDATE", "UpdateNodegroupVersion", err)
+		if err != nil {
+			return err
+		}
+		update = resp.Update
+	} else {
+		resp, err := rm.sdkapi.UpdateAddon(ctx, &svcsdk.UpdateAddonInput{
+			ClusterName:      desired.ko.Spec.Name,
+			AddonName:        next.Name,
+			AddonVersion:     next.TargetVersion,
+			ResolveConflicts: svcsdktypes.ResolveConflictsPreserve,
+		})
+		rm.metrics.RecordAPICall("UPDATE", "UpdateAddon", err)
+		if err != nil {
+			return err
+		}
+		update = resp.Update
+	}
+	next.State = aws.String(UpgradeCascadeStateUpdating)
+	next.Message = nil
+	if update != nil {
+		next.UpdateID = update.Id
+	}
+	msg := fmt.Sprintf("Cascading upgrade of %s '%s' to %s started", *next.Kind, *next.Name, *next.TargetVersion)
+	ackcondition.SetSynced(updatedRes, corev1.ConditionFalse, &msg, nil)
+	return requeueWaitWhileCascading(*next.Kind, *next.Name)
+}
+
+// checkUpgradeCascadeSkew holds a control plane version step that would make
+// the node groups of the cluster lag behind the control plane by more than
+// the configured maximum skew.
+func (rm *resourceManager) checkUpgradeCascadeSkew(
+	desired *resource,
+	latest *resource,
+	updatedRes *resource,
+) error {
+	if !upgradeCascadeEnabled(desired) || latest.ko.Spec.Version == nil {
+		return nil
+	}
+	nextVersion, err := util.IncrementEKSMinorVersion(*latest.ko.Spec.Version)
+	if err != nil {
+		// An invalid version is reported as a terminal error by updateVersion.
+		return nil
+	}
+	maxSkew := upgradeCascadeMaxSkew(desired)
+	lagging := []string{}
+	for _, c := range latest.ko.Status.UpgradeCascadeProgress {
+		if aws.ToString(c.Kind) != UpgradeCascadeKindNodegroup || c.Version == nil {
+			continue
+		}
+		skew, err := util.EKSMinorVersionSkew(nextVersion, *c.Version)
+		if err != nil {
+			return err
+		}
+		if skew > maxSkew {
+			lagging = append(lagging, fmt.Sprintf("%s (%s)", *c.Name, *c.Version))
+		}
+	}
+	if len(lagging) == 0 {
+		// The condition may still carry the reason of a previous check, the
+		// upgrade insights set it again if they block the upgrade.
+		if cond := ackcondition.FirstOfType(updatedRes, v1alpha1.ConditionTypeUpgradeBlocked); cond != nil &&
+			aws.ToString(cond.Reason) == upgradeBlockedReasonMaxSkew {
+			condition.Remove(updatedRes, v1alpha1.ConditionTypeUpgradeBlocked)
+		}
+		return nil
+	}
+	msg := fmt.Sprintf(
+		"Upgrade to version %s would exceed the maximum skew of %d minor version(s) with node groups: %s",
+		nextVersion, maxSkew, strings.Join(lagging, ", "),
+	)
+	condition.Set(updatedRes, v1alpha1.ConditionTypeUpgradeBlocked, corev1.ConditionTrue, &msg, aws.String(upgradeBlockedReasonMaxSkew))
+	return ackrequeue.NeededAfter(errors.New(msg), ackrequeue.DefaultRequeueAfterDuration)
+}
@@ -0,0 +1,171 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package cluster
+
+import (
+	"testing"
+
+	ackcondition "github.com/aws-controllers-k8s/runtime/pkg/condition"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/aws-controllers-k8s/eks-controller/apis/v1alpha1"
+	"github.com/aws-controllers-k8s/eks-controller/pkg/condition"
+)
+
+func TestCascadeChildState(t *testing.T) {
+	failed := &v1alpha1.UpgradeCascadeChild{
+		State:         aws.String(UpgradeCascadeStateFailed),
+		TargetVersion: aws.String("1.30"),
+	}
+	tests := []struct {
+		name          string
+		upgraded      bool
+		updating      bool
+		pinned        bool
+		targetVersion string
+		prev          *v1alpha1.UpgradeCascadeChild
+		want          string
+	}{
+		{"upgraded", true, false, false, "1.30", nil, UpgradeCascadeStateUpgraded},
+		{"upgraded wins over pin", true, false, true, "1.30", nil, UpgradeCascadeStateUpgraded},
+		{"updating", false, true, false, "1.30", nil, UpgradeCascadeStateUpdating},
+		{"pinned", false, false, true, "1.30", nil, UpgradeCascadeStatePinned},
+		{"pending", false, false, false, "1.30", nil, UpgradeCascadeStatePending},
+		{"failed for same target", false, false, false, "1.30", failed, UpgradeCascadeStateFailed},
+		{"failed for older target", false, false, false, "1.31", failed, UpgradeCascadeStatePending},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := cascadeChildState(tt.upgraded, tt.updating, tt.pinned, tt.targetVersion, tt.prev)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestUpgradeCascadeInProgress(t *testing.T) {
+	child := func(state string) *v1alpha1.UpgradeCascadeChild {
+		return &v1alpha1.UpgradeCascadeChild{State: aws.String(state)}
+	}
+	assert.False(t, upgradeCascadeInProgress(nil))
+	assert.False(t, upgradeCascadeInProgress([]*v1alpha1.UpgradeCascadeChild{
+		child(UpgradeCascadeStateUpgraded),
+		child(UpgradeCascadeStatePinned),
+		child(UpgradeCascadeStateFailed),
+	}))
+	assert.True(t, upgradeCascadeInProgress([]*v1alpha1.UpgradeCascadeChild{
+		child(UpgradeCascadeStateUpgraded),
+		child(UpgradeCascadeStatePending),
+	}))
+	assert.True(t, upgradeCascadeInProgress([]*v1alpha1.UpgradeCascadeChild{
+		child(UpgradeCascadeStateUpdating),
+	}))
+}
+
+func TestCheckUpgradeCascadeSkew(t *testing.T) {
+	nodegroup := func(version string) *v1alpha1.UpgradeCascadeChild {
+		return &v1alpha1.UpgradeCascadeChild{
+			Kind:    aws.String(UpgradeCascadeKindNodegroup),
+			Name:    aws.String("ng-" + version),
+			Version: aws.String(version),
+		}
+	}
+	tests := []struct {
+		name        string
+		cascade     *v1alpha1.UpgradeCascade
+		children    []*v1alpha1.UpgradeCascadeChild
+		wantBlocked bool
+	}{
+		{
+			name:        "cascade disabled",
+			children:    []*v1alpha1.UpgradeCascadeChild{nodegroup("1.28")},
+			wantBlocked: false,
+		},
+		{
+			name:        "within default skew",
+			cascade:     &v1alpha1.UpgradeCascade{Enabled: aws.Bool(true)},
+			children:    []*v1alpha1.UpgradeCascadeChild{nodegroup("1.29")},
+			wantBlocked: false,
+		},
+		{
+			name:        "exceeds default skew",
+			cascade:     &v1alpha1.UpgradeCascade{Enabled: aws.Bool(true)},
+			children:    []*v1alpha1.UpgradeCascadeChild{nodegroup("1.29"), nodegroup("1.28")},
+			wantBlocked: true,
+		},
+		{
+			name:        "within configured skew",
+			cascade:     &v1alpha1.UpgradeCascade{Enabled: aws.Bool(true), MaxSkew: aws.Int64(2)},
+			children:    []*v1alpha1.UpgradeCascadeChild{nodegroup("1.28")},
+			wantBlocked: false,
+		},
+		{
+			name:    "add-ons are ignored",
+			cascade: &v1alpha1.UpgradeCascade{Enabled: aws.Bool(true)},
+			children: []*v1alpha1.UpgradeCascadeChild{{
+				Kind:    aws.String(UpgradeCascadeKindAddon),
+				Name:    aws.String("vpc-cni"),
+				Version: aws.String("v1.18.0-eksbuild.1"),
+			}},
+			wantBlocked: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			desired := &resource{ko: &v1alpha1.Cluster{}}
+			desired.ko.Spec.Version = aws.String("1.31")
+			desired.ko.Spec.UpgradeCascade = tt.cascade
+			latest := &resource{ko: &v1alpha1.Cluster{}}
+			latest.ko.Spec.Version = aws.String("1.29")
+			latest.ko.Status.UpgradeCascadeProgress = tt.children
+			updatedRes := &resource{ko: latest.ko.DeepCopy()}
+
+			err := (&resourceManager{}).checkUpgradeCascadeSkew(desired, latest, updatedRes)
+			cond := ackcondition.FirstOfType(updatedRes, v1alpha1.ConditionTypeUpgradeBlocked)
+			if !tt.wantBlocked {
+				assert.NoError(t, err)
+				assert.Nil(t, cond)
+				return
+			}
+			assert.Error(t, err)
+			if assert.NotNil(t, cond) {
+				assert.Equal(t, corev1.ConditionTrue, cond.Status)
+				assert.Equal(t, "MaxSkew", *cond.Reason)
+				assert.Contains(t, *cond.Message, "ng-1.28 (1.28)")
+			}
+		})
+	}
+}
+
+func TestCheckUpgradeCascadeSkewClearsCondition(t *testing.T) {
+	for reason, wantRemoved := range map[string]bool{
+		"MaxSkew":                true,
+		"FailingUpgradeInsights": false,
+	} {
+		t.Run(reason, func(t *testing.T) {
+			desired := &resource{ko: &v1alpha1.Cluster{}}
+			desired.ko.Spec.UpgradeCascade = &v1alpha1.UpgradeCascade{Enabled: aws.Bool(true)}
+			latest := &resource{ko: &v1alpha1.Cluster{}}
+			latest.ko.Spec.Version = aws.String("1.29")
+			updatedRes := &resource{ko: latest.ko.DeepCopy()}
+			condition.Set(updatedRes, v1alpha1.ConditionTypeUpgradeBlocked, corev1.ConditionTrue, nil, aws.String(reason))
+
+			err := (&resourceManager{}).checkUpgradeCascadeSkew(desired, latest, updatedRes)
+			assert.NoError(t, err)
+			cond := ackcondition.FirstOfType(updatedRes, v1alpha1.ConditionTypeUpgradeBlocked)
+			assert.Equal(t, wantRemoved, cond == nil)
+		})
+	}
+}
@@ -0,0 +1,169 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package cluster
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	ackerr "github.com/aws-controllers-k8s/runtime/pkg/errors"
+	ackrtlog "github.com/aws-controllers-k8s/runtime/pkg/runtime/log"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	svcsdk "github.com/aws/aws-sdk-go-v2/service/eks"
+	svcsdktypes "github.com/aws/aws-sdk-go-v2/service/eks/types"
+	"github.com/aws/smithy-go"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/aws-controllers-k8s/eks-controller/apis/v1alpha1"
+	"github.com/aws-controllers-k8s/eks-controller/pkg/condition"
+)
+
+// describeClusterVersion returns the information EKS has about the supplied
+// Kubernetes version, or nil if EKS doesn't know about the version.
+func (rm *resourceManager) describeClusterVersion(
+	ctx context.Context,
+	version string,
+) (info *svcsdktypes.ClusterVersionInformation, err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.describeClusterVersion")
+	defer func() { exit(err) }()
+
+	resp, err := rm.sdkapi.DescribeClusterVersions(ctx, &svcsdk.DescribeClusterVersionsInput{
+		ClusterVersions: []string{version},
+		IncludeAll:      aws.Bool(true),
+	})
+	rm.metrics.RecordAPICall("READ_MANY", "DescribeClusterVersions", err)
+	if err != nil {
+		var awsErr smithy.APIError
+		if errors.As(err, &awsErr) && awsErr.ErrorCode() == "InvalidParameterException" {
+			return nil, nil
+		}
+		return nil, err
+	}
+	for i := range resp.ClusterVersions {
+		if aws.ToString(resp.ClusterVersions[i].ClusterVersion) == version {
+			return &resp.ClusterVersions[i], nil
+		}
+	}
+	return nil, nil
+}
+
+// validateVersion returns a terminal error if EKS doesn't know about the
+// supplied Kubernetes version. A nil version, for which EKS picks its default
+// version, is valid.
+func (rm *resourceManager) validateVersion(
+	ctx context.Context,
+	version *string,
+) error {
+	if version == nil {
+		return nil
+	}
+	info, err := rm.describeClusterVersion(ctx, *version)
+	if err != nil {
+		return err
+	}
+	if info == nil {
+		return ackerr.NewTerminalError(
+			fmt.Errorf("kubernetes version %s is not available in EKS", *version),
+		)
+	}
+	return nil
+}
+
+// syncVersionSupport refreshes the support status of the Kubernetes version
+// of the supplied cluster, and sets its ExtendedSupport condition.
+func (rm *resourceManager) syncVersionSupport(
+	ctx context.Context,
+	r *resource,
+) (err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.syncVersionSupport")
+	defer func() { exit(err) }()
+
+	if r.ko.Spec.Version == nil {
+		return nil
+	}
+	info, err := rm.describeClusterVersion(ctx, *r.ko.Spec.Version)
+	if err != nil {
+		return err
+	}
+	setVersionSupport(r, info)
+	return nil
+}
+
+// setVersionSupport records the supplied version information in the status of
+// the supplied cluster, and sets or removes its ExtendedSupport condition.
+func setVersionSupport(r *resource, info *svcsdktypes.ClusterVersionInformation) {
+	if info == nil {
+		r.ko.Status.VersionSupport = nil
+		condition.Remove(r, v1alpha1.ConditionTypeExtendedSupport)
+		return
+	}
+	support := &v1alpha1.VersionSupport{
+		EndOfExtendedSupportDate: newTime(info.EndOfExtendedSupportDate),
+		EndOfStandardSupportDate: newTime(info.EndOfStandardSupportDate),
+		Version:                  info.ClusterVersion,
+	}
+	if info.VersionStatus != "" {
+		support.VersionStatus = aws.String(string(info.VersionStatus))
+	}
+	r.ko.Status.VersionSupport = support
+
+	version := aws.ToString(info.ClusterVersion)
+	switch info.VersionStatus {
+	case svcsdktypes.VersionStatusExtendedSupport:
+		if supportType(r) == svcsdktypes.SupportTypeStandard {
+			msg := fmt.Sprintf(
+				"Kubernetes version %s left standard support on %s, EKS will upgrade the cluster "+
+					"automatically since its upgrade policy support type is %s",
+				version, formatDate(support.EndOfStandardSupportDate), svcsdktypes.SupportTypeStandard,
+			)
+			condition.Set(r, v1alpha1.ConditionTypeExtendedSupport, corev1.ConditionTrue, &msg, aws.String("StandardSupportEnded"))
+			return
+		}
+		msg := fmt.Sprintf(
+			"Kubernetes version %s left standard support on %s and is in extended support, "+
+				"billed at a higher rate, until %s",
+			version, formatDate(support.EndOfStandardSupportDate), formatDate(support.EndOfExtendedSupportDate),
+		)
+		condition.Set(r, v1alpha1.ConditionTypeExtendedSupport, corev1.ConditionTrue, &msg, aws.String("ExtendedSupport"))
+	case svcsdktypes.VersionStatusUnsupported:
+		msg := fmt.Sprintf(
+			"Kubernetes version %s left extended support on %s",
+			version, formatDate(support.EndOfExtendedSupportDate),
+		)
+		condition.Set(r, v1alpha1.ConditionTypeExtendedSupport, corev1.ConditionTrue, &msg, aws.String("Unsupported"))
+	default:
+		condition.Remove(r, v1alpha1.ConditionTypeExtendedSupport)
+	}
+}
+
+// supportType returns the upgrade policy support type of the supplied cluster.
+// EKS defaults to extended support.
+func supportType(r *resource) svcsdktypes.SupportType {
+	if r.ko.Spec.UpgradePolicy == nil || r.ko.Spec.UpgradePolicy.SupportType == nil {
+		return svcsdktypes.SupportTypeExtended
+	}
+	return svcsdktypes.SupportType(*r.ko.Spec.UpgradePolicy.SupportType)
+}
+
+// formatDate formats the supplied support end date for condition messages.
+func formatDate(t *metav1.Time) string {
+	if t == nil {
+		return "an unknown date"
+	}
+	return t.UTC().Format("2006-01-02")
+}
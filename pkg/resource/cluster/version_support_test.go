@@ -0,0 +1,111 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package cluster
+
+import (
+	"testing"
+	"time"
+
+	ackv1alpha1 "github.com/aws-controllers-k8s/runtime/apis/core/v1alpha1"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	svcsdktypes "github.com/aws/aws-sdk-go-v2/service/eks/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aws-controllers-k8s/eks-controller/apis/v1alpha1"
+)
+
+func extendedSupportCondition(r *resource) *ackv1alpha1.Condition {
+	for _, c := range r.Conditions() {
+		if c.Type == v1alpha1.ConditionTypeExtendedSupport {
+			return c
+		}
+	}
+	return nil
+}
+
+func TestSetVersionSupport(t *testing.T) {
+	endOfStandard := time.Date(2025, 7, 23, 0, 0, 0, 0, time.UTC)
+	endOfExtended := time.Date(2026, 7, 23, 0, 0, 0, 0, time.UTC)
+	newInfo := func(status svcsdktypes.VersionStatus) *svcsdktypes.ClusterVersionInformation {
+		return &svcsdktypes.ClusterVersionInformation{
+			ClusterVersion:           aws.String("1.29"),
+			EndOfStandardSupportDate: &endOfStandard,
+			EndOfExtendedSupportDate: &endOfExtended,
+			VersionStatus:            status,
+		}
+	}
+
+	tests := []struct {
+		name        string
+		supportType *string
+		info        *svcsdktypes.ClusterVersionInformation
+		wantReason  string
+		wantMessage string
+	}{
+		{
+			name: "standard support",
+			info: newInfo(svcsdktypes.VersionStatusStandardSupport),
+		},
+		{
+			name:        "extended support by default",
+			info:        newInfo(svcsdktypes.VersionStatusExtendedSupport),
+			wantReason:  "ExtendedSupport",
+			wantMessage: "Kubernetes version 1.29 left standard support on 2025-07-23 and is in extended support, billed at a higher rate, until 2026-07-23",
+		},
+		{
+			name:        "extended support with a standard support type",
+			supportType: aws.String("STANDARD"),
+			info:        newInfo(svcsdktypes.VersionStatusExtendedSupport),
+			wantReason:  "StandardSupportEnded",
+			wantMessage: "Kubernetes version 1.29 left standard support on 2025-07-23, EKS will upgrade the cluster automatically since its upgrade policy support type is STANDARD",
+		},
+		{
+			name:        "unsupported",
+			info:        newInfo(svcsdktypes.VersionStatusUnsupported),
+			wantReason:  "Unsupported",
+			wantMessage: "Kubernetes version 1.29 left extended support on 2026-07-23",
+		},
+		{
+			name: "unknown version",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &resource{ko: &v1alpha1.Cluster{}}
+			r.ko.Spec.UpgradePolicy = &v1alpha1.UpgradePolicyRequest{SupportType: tt.supportType}
+			// A condition set by a previous read must be removed.
+			setVersionSupport(r, newInfo(svcsdktypes.VersionStatusExtendedSupport))
+
+			setVersionSupport(r, tt.info)
+			c := extendedSupportCondition(r)
+			if tt.wantReason == "" {
+				assert.Nil(t, c)
+			} else {
+				require.NotNil(t, c)
+				assert.Equal(t, tt.wantReason, *c.Reason)
+				assert.Equal(t, tt.wantMessage, *c.Message)
+			}
+			if tt.info == nil {
+				assert.Nil(t, r.ko.Status.VersionSupport)
+				return
+			}
+			require.NotNil(t, r.ko.Status.VersionSupport)
+			assert.Equal(t, "1.29", *r.ko.Status.VersionSupport.Version)
+			assert.Equal(t, string(tt.info.VersionStatus), *r.ko.Status.VersionSupport.VersionStatus)
+			assert.True(t, endOfStandard.Equal(r.ko.Status.VersionSupport.EndOfStandardSupportDate.Time))
+			assert.True(t, endOfExtended.Equal(r.ko.Status.VersionSupport.EndOfExtendedSupportDate.Time))
+		})
+	}
+}
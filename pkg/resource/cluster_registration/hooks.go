@@ -0,0 +1,236 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package cluster_registration
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	ackv1alpha1 "github.com/aws-controllers-k8s/runtime/apis/core/v1alpha1"
+	ackcompare "github.com/aws-controllers-k8s/runtime/pkg/compare"
+	ackerr "github.com/aws-controllers-k8s/runtime/pkg/errors"
+	ackrequeue "github.com/aws-controllers-k8s/runtime/pkg/requeue"
+	ackrtlog "github.com/aws-controllers-k8s/runtime/pkg/runtime/log"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	svcsdk "github.com/aws/aws-sdk-go-v2/service/eks"
+	svcsdktypes "github.com/aws/aws-sdk-go-v2/service/eks/types"
+	smithy "github.com/aws/smithy-go"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	svcapitypes "github.com/aws-controllers-k8s/eks-controller/apis/v1alpha1"
+	"github.com/aws-controllers-k8s/eks-controller/pkg/kube"
+	"github.com/aws-controllers-k8s/eks-controller/pkg/tags"
+)
+
+var syncTags = tags.SyncTags
+
+const (
+	// activationSecretNameSuffix is appended to the name of the
+	// ClusterRegistration resource to name the activation Secret by default.
+	activationSecretNameSuffix = "-activation"
+	// ActivationCodeSecretKey is the key of the activation code in the data
+	// of the activation Secret.
+	ActivationCodeSecretKey = "activationCode"
+	// ActivationIDSecretKey is the key of the activation ID in the data of
+	// the activation Secret.
+	ActivationIDSecretKey = "activationID"
+)
+
+var (
+	requeueWaitWhileDeregistering = ackrequeue.NeededAfter(
+		fmt.Errorf("cluster in '%s' state, cannot be modified or deregistered", svcsdktypes.ClusterStatusDeleting),
+		ackrequeue.DefaultRequeueAfterDuration,
+	)
+)
+
+// clusterDeregistering returns true if the supplied registered cluster is
+// being deregistered.
+func clusterDeregistering(r *resource) bool {
+	return aws.ToString(r.ko.Status.Status) == string(svcsdktypes.ClusterStatusDeleting)
+}
+
+// activationSecretName returns the name of the Secret holding the activation
+// code and ID of the supplied registered cluster.
+func activationSecretName(r *resource) string {
+	if name := aws.ToString(r.ko.Spec.ActivationCodeSecretName); name != "" {
+		return name
+	}
+	return r.ko.Name + activationSecretNameSuffix
+}
+
+// customFind describes the registered cluster with DescribeCluster, which is
+// also the ReadOne operation of the Cluster resource.
+func (rm *resourceManager) customFind(
+	ctx context.Context,
+	r *resource,
+) (latest *resource, err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.customFind")
+	defer func() { exit(err) }()
+
+	if r.ko.Spec.Name == nil {
+		return nil, ackerr.NotFound
+	}
+	resp, err := rm.sdkapi.DescribeCluster(ctx, &svcsdk.DescribeClusterInput{
+		Name: r.ko.Spec.Name,
+	})
+	rm.metrics.RecordAPICall("READ_ONE", "DescribeCluster", err)
+	if err != nil {
+		var awsErr smithy.APIError
+		if errors.As(err, &awsErr) && awsErr.ErrorCode() == "ResourceNotFoundException" {
+			return nil, ackerr.NotFound
+		}
+		return nil, err
+	}
+	// An EKS cluster has no connector configuration, it must be managed with
+	// the Cluster resource.
+	if resp.Cluster.ConnectorConfig == nil {
+		return nil, ackerr.NewTerminalError(
+			fmt.Errorf("cluster %s is not a registered cluster", *r.ko.Spec.Name),
+		)
+	}
+
+	ko := r.ko.DeepCopy()
+	setResourceFromCluster(ko, resp.Cluster)
+	rm.setStatusDefaults(ko)
+	rm.syncActivationSecret(ctx, &resource{ko})
+	return &resource{ko}, nil
+}
+
+// setResourceFromCluster sets the Spec and Status fields of the supplied
+// ClusterRegistration from the supplied registered cluster.
+func setResourceFromCluster(
+	ko *svcapitypes.ClusterRegistration,
+	cluster *svcsdktypes.Cluster,
+) {
+	if ko.Status.ACKResourceMetadata == nil {
+		ko.Status.ACKResourceMetadata = &ackv1alpha1.ResourceMetadata{}
+	}
+	if cluster.Arn != nil {
+		arn := ackv1alpha1.AWSResourceName(*cluster.Arn)
+		ko.Status.ACKResourceMetadata.ARN = &arn
+	}
+	if cluster.ConnectorConfig != nil {
+		status := &svcapitypes.ConnectorConfigResponse{
+			ActivationCode: cluster.ConnectorConfig.ActivationCode,
+			ActivationID:   cluster.ConnectorConfig.ActivationId,
+			Provider:       cluster.ConnectorConfig.Provider,
+			RoleARN:        cluster.ConnectorConfig.RoleArn,
+		}
+		if cluster.ConnectorConfig.ActivationExpiry != nil {
+			status.ActivationExpiry = &metav1.Time{Time: *cluster.ConnectorConfig.ActivationExpiry}
+		}
+		ko.Status.ConnectorConfig = status
+
+		// The reference to the role, if any, is kept from the desired spec.
+		if ko.Spec.ConnectorConfig == nil {
+			ko.Spec.ConnectorConfig = &svcapitypes.ConnectorConfigRequest{}
+		}
+		ko.Spec.ConnectorConfig.Provider = cluster.ConnectorConfig.Provider
+		ko.Spec.ConnectorConfig.RoleARN = cluster.ConnectorConfig.RoleArn
+	} else {
+		ko.Status.ConnectorConfig = nil
+	}
+	if cluster.CreatedAt != nil {
+		ko.Status.CreatedAt = &metav1.Time{Time: *cluster.CreatedAt}
+	} else {
+		ko.Status.CreatedAt = nil
+	}
+	ko.Spec.Name = cluster.Name
+	if cluster.Status != "" {
+		ko.Status.Status = aws.String(string(cluster.Status))
+	} else {
+		ko.Status.Status = nil
+	}
+	if cluster.Tags != nil {
+		ko.Spec.Tags = aws.StringMap(cluster.Tags)
+	} else {
+		ko.Spec.Tags = nil
+	}
+}
+
+// syncActivationSecret writes the activation Secret of the supplied
+// registered cluster when it is registered or read. A failure doesn't fail
+// the operation, it is reported with the SecretSyncFailed condition and the
+// Secret is written again on the next reconcile.
+func (rm *resourceManager) syncActivationSecret(
+	ctx context.Context,
+	r *resource,
+) {
+	kube.SetSecretSyncCondition(r, rm.writeActivationSecret(ctx, r))
+}
+
+// writeActivationSecret writes the activation code and ID of the supplied
+// registered cluster, when EKS returns them, to the activation Secret. The
+// activation code is never kept in the status of the resource.
+func (rm *resourceManager) writeActivationSecret(
+	ctx context.Context,
+	r *resource,
+) (err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.writeActivationSecret")
+	defer func() { exit(err) }()
+
+	cc := r.ko.Status.ConnectorConfig
+	if cc == nil || aws.ToString(cc.ActivationCode) == "" {
+		return nil
+	}
+	code := *cc.ActivationCode
+	cc.ActivationCode = nil
+
+	data := map[string][]byte{
+		ActivationCodeSecretKey: []byte(code),
+		ActivationIDSecretKey:   []byte(aws.ToString(cc.ActivationID)),
+	}
+	return kube.ApplySecret(ctx, r.ko, activationSecretName(r), data)
+}
+
+// deleteActivationSecret deletes the activation Secret of the supplied
+// registered cluster, if any.
+func (rm *resourceManager) deleteActivationSecret(
+	ctx context.Context,
+	r *resource,
+) error {
+	return kube.DeleteSecret(ctx, r.ko, activationSecretName(r))
+}
+
+// customUpdate only synchronizes the tags of the registered cluster, every
+// other field is immutable.
+func (rm *resourceManager) customUpdate(
+	ctx context.Context,
+	desired *resource,
+	latest *resource,
+	delta *ackcompare.Delta,
+) (updated *resource, err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.customUpdate")
+	defer func() { exit(err) }()
+
+	if clusterDeregistering(latest) {
+		return nil, requeueWaitWhileDeregistering
+	}
+	if delta.DifferentAt("Spec.Tags") {
+		if err := syncTags(
+			ctx, rm.sdkapi, rm.metrics,
+			string(*latest.ko.Status.ACKResourceMetadata.ARN),
+			aws.ToStringMap(desired.ko.Spec.Tags), aws.ToStringMap(latest.ko.Spec.Tags),
+		); err != nil {
+			return nil, err
+		}
+	}
+	updated = rm.concreteResource(desired.DeepCopy())
+	updated.SetStatus(latest)
+	return updated, nil
+}
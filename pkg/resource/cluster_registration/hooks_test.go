@@ -0,0 +1,115 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package cluster_registration
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	ackcondition "github.com/aws-controllers-k8s/runtime/pkg/condition"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	svcsdktypes "github.com/aws/aws-sdk-go-v2/service/eks/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	svcapitypes "github.com/aws-controllers-k8s/eks-controller/apis/v1alpha1"
+	"github.com/aws-controllers-k8s/eks-controller/pkg/kube"
+	"github.com/aws-controllers-k8s/eks-controller/pkg/testutil"
+)
+
+func newTestResource() *resource {
+	return &resource{ko: &svcapitypes.ClusterRegistration{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "infra", Name: "on-prem", UID: "uid-1"},
+		Spec: svcapitypes.ClusterRegistrationSpec{
+			Name: aws.String("on-prem"),
+			ConnectorConfig: &svcapitypes.ConnectorConfigRequest{
+				Provider: aws.String("OTHER"),
+			},
+		},
+	}}
+}
+
+func TestActivationSecretName(t *testing.T) {
+	r := newTestResource()
+	assert.Equal(t, "on-prem-activation", activationSecretName(r))
+	r.ko.Spec.ActivationCodeSecretName = aws.String("connector")
+	assert.Equal(t, "connector", activationSecretName(r))
+}
+
+func TestSetResourceFromCluster(t *testing.T) {
+	expiry := time.Now().Add(72 * time.Hour)
+	r := newTestResource()
+	setResourceFromCluster(r.ko, &svcsdktypes.Cluster{
+		Arn:    aws.String("arn:aws:eks:us-west-2:123456789012:cluster/on-prem"),
+		Name:   aws.String("on-prem"),
+		Status: svcsdktypes.ClusterStatusPending,
+		ConnectorConfig: &svcsdktypes.ConnectorConfigResponse{
+			ActivationCode:   aws.String("code"),
+			ActivationExpiry: &expiry,
+			ActivationId:     aws.String("activation-id"),
+			Provider:         aws.String("OTHER"),
+			RoleArn:          aws.String("arn:aws:iam::123456789012:role/connector"),
+		},
+		Tags: map[string]string{"team": "platform"},
+	})
+	assert.Equal(t, "arn:aws:eks:us-west-2:123456789012:cluster/on-prem", string(*r.ko.Status.ACKResourceMetadata.ARN))
+	assert.Equal(t, "PENDING", *r.ko.Status.Status)
+	assert.Equal(t, "activation-id", *r.ko.Status.ConnectorConfig.ActivationID)
+	assert.Equal(t, expiry.Unix(), r.ko.Status.ConnectorConfig.ActivationExpiry.Unix())
+	assert.Equal(t, "arn:aws:iam::123456789012:role/connector", *r.ko.Spec.ConnectorConfig.RoleARN)
+	assert.Equal(t, "platform", *r.ko.Spec.Tags["team"])
+}
+
+func TestSyncActivationSecret(t *testing.T) {
+	kc := testutil.NewKubeClient(&corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "infra", Name: "connector"},
+	})
+	kube.SetClient(kc)
+
+	ctx := context.Background()
+	r := newTestResource()
+	r.ko.Status.ConnectorConfig = &svcapitypes.ConnectorConfigResponse{
+		ActivationID: aws.String("activation-id"),
+	}
+	(&resourceManager{}).syncActivationSecret(ctx, r)
+	assert.Nil(t, ackcondition.FirstOfType(r, svcapitypes.ConditionTypeSecretSyncFailed))
+	secret := &corev1.Secret{}
+	key := types.NamespacedName{Namespace: "infra", Name: "on-prem-activation"}
+	assert.Error(t, kc.Get(ctx, key, secret))
+
+	// A Secret of the same name owned by no one is reported, not overwritten.
+	r.ko.Spec.ActivationCodeSecretName = aws.String("connector")
+	r.ko.Status.ConnectorConfig.ActivationCode = aws.String("code")
+	(&resourceManager{}).syncActivationSecret(ctx, r)
+	cond := ackcondition.FirstOfType(r, svcapitypes.ConditionTypeSecretSyncFailed)
+	require.NotNil(t, cond)
+	assert.Equal(t, "SecretNotControlled", *cond.Reason)
+	assert.Nil(t, r.ko.Status.ConnectorConfig.ActivationCode)
+
+	r.ko.Spec.ActivationCodeSecretName = nil
+	r.ko.Status.ConnectorConfig.ActivationCode = aws.String("code")
+	(&resourceManager{}).syncActivationSecret(ctx, r)
+	assert.Nil(t, ackcondition.FirstOfType(r, svcapitypes.ConditionTypeSecretSyncFailed))
+	assert.Nil(t, r.ko.Status.ConnectorConfig.ActivationCode)
+	require.NoError(t, kc.Get(ctx, key, secret))
+	assert.Equal(t, []byte("code"), secret.Data[ActivationCodeSecretKey])
+	assert.Equal(t, []byte("activation-id"), secret.Data[ActivationIDSecretKey])
+
+	require.NoError(t, (&resourceManager{}).deleteActivationSecret(ctx, r))
+	assert.Error(t, kc.Get(ctx, key, secret))
+}
@@ -0,0 +1,163 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package eks_anywhere_subscription
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	ackerr "github.com/aws-controllers-k8s/runtime/pkg/errors"
+	ackrequeue "github.com/aws-controllers-k8s/runtime/pkg/requeue"
+	ackrtlog "github.com/aws-controllers-k8s/runtime/pkg/runtime/log"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	svcsdk "github.com/aws/aws-sdk-go-v2/service/eks"
+	svcsdktypes "github.com/aws/aws-sdk-go-v2/service/eks/types"
+
+	"github.com/aws-controllers-k8s/eks-controller/pkg/kube"
+	"github.com/aws-controllers-k8s/eks-controller/pkg/tags"
+)
+
+var syncTags = tags.SyncTags
+
+// licenseTokenSecretNameSuffix is appended to the name of the
+// EKSAnywhereSubscription resource to name the license token Secret by
+// default.
+const licenseTokenSecretNameSuffix = "-licenses"
+
+var (
+	requeueWaitWhileDeleting = ackrequeue.NeededAfter(
+		fmt.Errorf("subscription in '%s' state, cannot be modified or deleted", svcsdktypes.EksAnywhereSubscriptionStatusDeleting),
+		ackrequeue.DefaultRequeueAfterDuration,
+	)
+)
+
+// subscriptionDeleting returns true if the supplied subscription is being
+// deleted.
+func subscriptionDeleting(r *resource) bool {
+	return aws.ToString(r.ko.Status.Status) == string(svcsdktypes.EksAnywhereSubscriptionStatusDeleting)
+}
+
+// licenseTokenSecretName returns the name of the Secret holding the license
+// tokens of the supplied subscription.
+func licenseTokenSecretName(r *resource) string {
+	if name := aws.ToString(r.ko.Spec.LicenseTokenSecretName); name != "" {
+		return name
+	}
+	return r.ko.Name + licenseTokenSecretNameSuffix
+}
+
+// getSubscriptionID returns the ID of the subscription to adopt. EKS doesn't
+// return the name of subscriptions, so the subscription is looked up by its
+// term, license type and license quantity. Unless exactly one subscription
+// matches, the subscription is treated as not found.
+func (rm *resourceManager) getSubscriptionID(
+	ctx context.Context,
+	r *resource,
+) (id *string, err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.getSubscriptionID")
+	defer func() { exit(err) }()
+
+	var matches []*string
+	paginator := svcsdk.NewListEksAnywhereSubscriptionsPaginator(
+		rm.sdkapi, &svcsdk.ListEksAnywhereSubscriptionsInput{},
+	)
+	for paginator.HasMorePages() {
+		resp, err := paginator.NextPage(ctx)
+		rm.metrics.RecordAPICall("READ_MANY", "ListEksAnywhereSubscriptions", err)
+		if err != nil {
+			return nil, err
+		}
+		for i := range resp.Subscriptions {
+			if subscriptionMatches(r, &resp.Subscriptions[i]) {
+				matches = append(matches, resp.Subscriptions[i].Id)
+			}
+		}
+	}
+	if len(matches) != 1 {
+		return nil, nil
+	}
+	return matches[0], nil
+}
+
+// subscriptionMatches returns true if the supplied subscription, which isn't
+// being deleted, has the term, license type and license quantity of the
+// supplied resource. Unset license type and quantity match any value.
+func subscriptionMatches(
+	r *resource,
+	sub *svcsdktypes.EksAnywhereSubscription,
+) bool {
+	if aws.ToString(sub.Status) == string(svcsdktypes.EksAnywhereSubscriptionStatusDeleting) {
+		return false
+	}
+	spec := r.ko.Spec
+	if spec.Term == nil || sub.Term == nil ||
+		aws.ToInt64(spec.Term.Duration) != int64(sub.Term.Duration) ||
+		aws.ToString(spec.Term.Unit) != string(sub.Term.Unit) {
+		return false
+	}
+	if spec.LicenseType != nil && *spec.LicenseType != string(sub.LicenseType) {
+		return false
+	}
+	if spec.LicenseQuantity != nil && *spec.LicenseQuantity != int64(sub.LicenseQuantity) {
+		return false
+	}
+	return true
+}
+
+// syncLicenseTokenSecret records the IDs of the supplied licenses in the
+// status of the supplied subscription, and writes their tokens, keyed by
+// license ID, to the license token Secret. License tokens are never kept in
+// the status of the resource.
+func (rm *resourceManager) syncLicenseTokenSecret(
+	ctx context.Context,
+	r *resource,
+	licenses []svcsdktypes.License,
+) (err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.syncLicenseTokenSecret")
+	defer func() { exit(err) }()
+
+	r.ko.Status.LicenseIDs = nil
+	data := map[string][]byte{}
+	for _, license := range licenses {
+		if license.Id == nil {
+			continue
+		}
+		r.ko.Status.LicenseIDs = append(r.ko.Status.LicenseIDs, license.Id)
+		if token := aws.ToString(license.Token); token != "" {
+			data[*license.Id] = []byte(token)
+		}
+	}
+	if len(data) == 0 {
+		return nil
+	}
+	if err := kube.ApplySecret(ctx, r.ko, licenseTokenSecretName(r), data); err != nil {
+		if errors.Is(err, kube.ErrSecretNotControlled) {
+			return ackerr.NewTerminalError(err)
+		}
+		return err
+	}
+	return nil
+}
+
+// deleteLicenseTokenSecret deletes the license token Secret of the supplied
+// subscription, if any.
+func (rm *resourceManager) deleteLicenseTokenSecret(
+	ctx context.Context,
+	r *resource,
+) error {
+	return kube.DeleteSecret(ctx, r.ko, licenseTokenSecretName(r))
+}
@@ -0,0 +1,133 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package eks_anywhere_subscription
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	svcsdktypes "github.com/aws/aws-sdk-go-v2/service/eks/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	svcapitypes "github.com/aws-controllers-k8s/eks-controller/apis/v1alpha1"
+	"github.com/aws-controllers-k8s/eks-controller/pkg/kube"
+)
+
+func newTestResource() *resource {
+	return &resource{ko: &svcapitypes.EKSAnywhereSubscription{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "infra", Name: "edge", UID: "uid-1"},
+		Spec: svcapitypes.EKSAnywhereSubscriptionSpec{
+			Name:            aws.String("edge"),
+			LicenseQuantity: aws.Int64(3),
+			Term: &svcapitypes.EKSAnywhereSubscriptionTerm{
+				Duration: aws.Int64(12),
+				Unit:     aws.String("MONTHS"),
+			},
+		},
+	}}
+}
+
+func TestLicenseTokenSecretName(t *testing.T) {
+	r := newTestResource()
+	assert.Equal(t, "edge-licenses", licenseTokenSecretName(r))
+	r.ko.Spec.LicenseTokenSecretName = aws.String("eksa")
+	assert.Equal(t, "eksa", licenseTokenSecretName(r))
+}
+
+func TestSubscriptionMatches(t *testing.T) {
+	term := &svcsdktypes.EksAnywhereSubscriptionTerm{
+		Duration: 12,
+		Unit:     svcsdktypes.EksAnywhereSubscriptionTermUnitMonths,
+	}
+	tests := []struct {
+		name string
+		sub  svcsdktypes.EksAnywhereSubscription
+		want bool
+	}{
+		{
+			name: "same term and quantity",
+			sub:  svcsdktypes.EksAnywhereSubscription{Term: term, LicenseQuantity: 3, Status: aws.String("ACTIVE")},
+			want: true,
+		},
+		{
+			name: "other duration",
+			sub: svcsdktypes.EksAnywhereSubscription{
+				Term:            &svcsdktypes.EksAnywhereSubscriptionTerm{Duration: 36, Unit: term.Unit},
+				LicenseQuantity: 3,
+			},
+			want: false,
+		},
+		{
+			name: "other quantity",
+			sub:  svcsdktypes.EksAnywhereSubscription{Term: term, LicenseQuantity: 5},
+			want: false,
+		},
+		{
+			name: "deleting",
+			sub:  svcsdktypes.EksAnywhereSubscription{Term: term, LicenseQuantity: 3, Status: aws.String("DELETING")},
+			want: false,
+		},
+		{
+			name: "no term",
+			sub:  svcsdktypes.EksAnywhereSubscription{LicenseQuantity: 3},
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, subscriptionMatches(newTestResource(), &tt.sub))
+		})
+	}
+}
+
+func TestSyncLicenseTokenSecret(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, clientgoscheme.AddToScheme(scheme))
+	require.NoError(t, svcapitypes.AddToScheme(scheme))
+	kc := fake.NewClientBuilder().WithScheme(scheme).Build()
+	kube.SetClient(kc)
+
+	ctx := context.Background()
+	r := newTestResource()
+	secret := &corev1.Secret{}
+	key := types.NamespacedName{Namespace: "infra", Name: "edge-licenses"}
+
+	require.NoError(t, (&resourceManager{}).syncLicenseTokenSecret(ctx, r, []svcsdktypes.License{
+		{Id: aws.String("license-1")},
+	}))
+	assert.Equal(t, []*string{aws.String("license-1")}, r.ko.Status.LicenseIDs)
+	assert.Error(t, kc.Get(ctx, key, secret))
+
+	require.NoError(t, (&resourceManager{}).syncLicenseTokenSecret(ctx, r, []svcsdktypes.License{
+		{Id: aws.String("license-1"), Token: aws.String("token-1")},
+		{Id: aws.String("license-2"), Token: aws.String("token-2")},
+	}))
+	assert.Equal(t, []*string{aws.String("license-1"), aws.String("license-2")}, r.ko.Status.LicenseIDs)
+	require.NoError(t, kc.Get(ctx, key, secret))
+	assert.Equal(t, map[string][]byte{
+		"license-1": []byte("token-1"),
+		"license-2": []byte("token-2"),
+	}, secret.Data)
+
+	require.NoError(t, (&resourceManager{}).deleteLicenseTokenSecret(ctx, r))
+	assert.Error(t, kc.Get(ctx, key, secret))
+}
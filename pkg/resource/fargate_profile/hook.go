@@ -19,6 +19,7 @@ import (
 
 	corev1 "k8s.io/api/core/v1"
 
+	svcapitypes "github.com/aws-controllers-k8s/eks-controller/apis/v1alpha1"
 	"github.com/aws-controllers-k8s/eks-controller/pkg/tags"
 	ackcompare "github.com/aws-controllers-k8s/runtime/pkg/compare"
 	ackcondition "github.com/aws-controllers-k8s/runtime/pkg/condition"
@@ -30,7 +31,8 @@ import (
 
 var (
 	UnableToUpdateError = "Changes to FargateProfile resources are not" +
-		" currently possible. To update the resource, delete and re-create it"
+		" currently possible. To update the resource, delete and re-create it," +
+		" or set spec.replacementStrategy to " + svcapitypes.FargateProfileReplacementStrategyCreateBeforeDestroy
 )
 
 var (
@@ -74,6 +76,13 @@ func (rm *resourceManager) customUpdate(
 	exit := rlog.Trace("rm.customUpdate")
 	defer exit(err)
 
+	if replacementInProgress(latest) || (len(replacementFieldChanges(delta)) > 0 &&
+		replacementStrategy(desired) == svcapitypes.FargateProfileReplacementStrategyCreateBeforeDestroy) {
+		updated = &resource{ko: desired.ko.DeepCopy()}
+		updated.SetStatus(latest)
+		return rm.replaceFargateProfile(ctx, desired, latest, updated)
+	}
+
 	if delta.DifferentAt("Spec.Tags") {
 
 		if err := tags.SyncTags(
@@ -0,0 +1,400 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package fargate_profile
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	ackcompare "github.com/aws-controllers-k8s/runtime/pkg/compare"
+	ackcondition "github.com/aws-controllers-k8s/runtime/pkg/condition"
+	ackrequeue "github.com/aws-controllers-k8s/runtime/pkg/requeue"
+	ackrtlog "github.com/aws-controllers-k8s/runtime/pkg/runtime/log"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	svcsdk "github.com/aws/aws-sdk-go-v2/service/eks"
+	svcsdktypes "github.com/aws/aws-sdk-go-v2/service/eks/types"
+	"github.com/aws/smithy-go"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	svcapitypes "github.com/aws-controllers-k8s/eks-controller/apis/v1alpha1"
+)
+
+// The phases of a create-before-destroy replacement of a Fargate profile.
+const (
+	// ReplacementPhaseCreating means the new Fargate profile is being
+	// created, the replacement waits for it to be active.
+	ReplacementPhaseCreating = "Creating"
+	// ReplacementPhaseDeletingPrevious means the resource points at the new
+	// Fargate profile and the previous one is being deleted.
+	ReplacementPhaseDeletingPrevious = "DeletingPrevious"
+)
+
+// maxFargateProfileNameLength is the maximum length of a Fargate profile
+// name.
+const maxFargateProfileNameLength = 100
+
+// replacementFields are the fields of a Fargate profile that can only be
+// changed by replacing the profile.
+var replacementFields = []string{
+	"Spec.PodExecutionRoleARN",
+	"Spec.Selectors",
+	"Spec.Subnets",
+}
+
+// requeueWaitWhileReplacing returns a `ackrequeue.RequeueNeededAfter`
+// explaining the Fargate profile is being replaced.
+func requeueWaitWhileReplacing(phase string) *ackrequeue.RequeueNeededAfter {
+	return ackrequeue.NeededAfter(
+		fmt.Errorf("fargate profile replacement in '%s' phase", phase),
+		ackrequeue.DefaultRequeueAfterDuration,
+	)
+}
+
+// requeueWaitForSibling returns a `ackrequeue.RequeueNeededAfter` explaining
+// another Fargate profile of the cluster is being created or deleted. EKS
+// creates or deletes a single Fargate profile of a cluster at a time.
+func requeueWaitForSibling(name string, status svcsdktypes.FargateProfileStatus) *ackrequeue.RequeueNeededAfter {
+	return ackrequeue.NeededAfter(
+		fmt.Errorf("fargate profile %s is in '%s' state, waiting to replace the profile", name, status),
+		ackrequeue.DefaultRequeueAfterDuration,
+	)
+}
+
+// fargateProfileName returns the name of the Fargate profile backing the
+// supplied resource: the name of the profile that replaced the original one,
+// if any, and `spec.name` otherwise.
+func fargateProfileName(r *resource) *string {
+	if r.ko.Status.CurrentFargateProfileName != nil {
+		return r.ko.Status.CurrentFargateProfileName
+	}
+	return r.ko.Spec.Name
+}
+
+// replacementStrategy returns the replacement strategy of the supplied
+// resource. Unknown values fall back to the default strategy.
+func replacementStrategy(r *resource) string {
+	switch strategy := aws.ToString(r.ko.Spec.ReplacementStrategy); strategy {
+	case svcapitypes.FargateProfileReplacementStrategyNone,
+		svcapitypes.FargateProfileReplacementStrategyCreateBeforeDestroy:
+		return strategy
+	}
+	return svcapitypes.DefaultFargateProfileReplacementStrategy
+}
+
+// replacementInProgress returns true if the supplied resource still points at
+// the Fargate profile being replaced.
+func replacementInProgress(r *resource) bool {
+	replacement := r.ko.Status.Replacement
+	return replacement != nil && aws.ToString(replacement.Phase) != ReplacementPhaseDeletingPrevious
+}
+
+// replacementFieldChanges returns the fields that can only be changed by
+// replacing the profile that differ in the supplied delta.
+func replacementFieldChanges(delta *ackcompare.Delta) []string {
+	changes := []string{}
+	for _, field := range replacementFields {
+		if delta.DifferentAt(field) {
+			changes = append(changes, field)
+		}
+	}
+	return changes
+}
+
+// replacementFargateProfileName returns the name of the Fargate profile
+// replacing the one backing the supplied desired resource. The name is
+// `spec.name` suffixed with a hash of the fields that can only be changed by
+// replacing the profile, so that it is the same for every attempt to create
+// the same profile.
+func replacementFargateProfileName(desired *resource) (string, error) {
+	spec := desired.ko.Spec
+	data, err := json.Marshal([]interface{}{
+		spec.PodExecutionRoleARN, spec.Selectors, spec.Subnets,
+	})
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(data)
+	suffix := "-" + hex.EncodeToString(sum[:])[:8]
+	base := aws.ToString(spec.Name)
+	if len(base)+len(suffix) > maxFargateProfileNameLength {
+		base = base[:maxFargateProfileNameLength-len(suffix)]
+	}
+	return base + suffix, nil
+}
+
+// replaceFargateProfile moves the create-before-destroy replacement of the
+// latest Fargate profile forward by one step, recording its progress in the
+// status of the returned resource:
+//
+//  1. a Fargate profile is created from the desired spec, under a new name,
+//  2. once it is active, the resource is pointed at the new profile and the
+//     previous one is deleted. The replacement is complete when it is gone,
+//     see syncReplacement.
+//
+// EKS creates or deletes a single Fargate profile of a cluster at a time, so
+// each step waits for the other profiles of the cluster to be stable.
+func (rm *resourceManager) replaceFargateProfile(
+	ctx context.Context,
+	desired *resource,
+	latest *resource,
+	updated *resource,
+) (*resource, error) {
+	rlog := ackrtlog.FromContext(ctx)
+	replacement := updated.ko.Status.Replacement
+	if replacement == nil {
+		if status := aws.ToString(latest.ko.Status.Status); status != string(svcsdktypes.FargateProfileStatusActive) {
+			return updated, ackrequeue.NeededAfter(
+				fmt.Errorf("profile is in '%s' state, cannot be replaced", status),
+				ackrequeue.DefaultRequeueAfterDuration,
+			)
+		}
+		if err := rm.checkSelectorOverlap(ctx, desired); err != nil {
+			return updated, err
+		}
+		if err := rm.waitForSiblings(ctx, desired.ko.Spec.ClusterName); err != nil {
+			return updated, err
+		}
+		name, err := rm.createReplacementFargateProfile(ctx, desired)
+		if err != nil {
+			return updated, err
+		}
+		rlog.Info("replacing fargate profile", "previous", aws.ToString(fargateProfileName(latest)), "replacement", name)
+		now := metav1.Now()
+		replacement = &svcapitypes.FargateProfileReplacement{
+			FargateProfileName:         aws.String(name),
+			Phase:                      aws.String(ReplacementPhaseCreating),
+			PreviousFargateProfileName: fargateProfileName(latest),
+			StartedAt:                  &now,
+		}
+		updated.ko.Status.Replacement = replacement
+		return returnFargateProfileReplacing(updated, replacement)
+	}
+
+	if aws.ToString(replacement.Phase) != ReplacementPhaseCreating {
+		return returnFargateProfileReplacing(updated, replacement)
+	}
+	profile, err := rm.describeFargateProfile(ctx, desired.ko.Spec.ClusterName, replacement.FargateProfileName)
+	if err != nil {
+		return nil, err
+	}
+	switch profile.Status {
+	case svcsdktypes.FargateProfileStatusCreateFailed:
+		msg := fmt.Sprintf("replacement fargate profile %s failed to create", aws.ToString(replacement.FargateProfileName))
+		ackcondition.SetTerminal(updated, corev1.ConditionTrue, &msg, nil)
+		return updated, nil
+	case svcsdktypes.FargateProfileStatusActive:
+	default:
+		return returnFargateProfileReplacing(updated, replacement)
+	}
+	if err := rm.waitForSiblings(ctx, desired.ko.Spec.ClusterName); err != nil {
+		return updated, err
+	}
+	_, err = rm.sdkapi.DeleteFargateProfile(ctx, &svcsdk.DeleteFargateProfileInput{
+		ClusterName:        desired.ko.Spec.ClusterName,
+		FargateProfileName: replacement.PreviousFargateProfileName,
+	})
+	rm.metrics.RecordAPICall("DELETE", "DeleteFargateProfile", err)
+	if isResourceInUse(err) {
+		return returnFargateProfileReplacing(updated, replacement)
+	}
+	if err != nil && !isNotFound(err) {
+		return nil, err
+	}
+	updated.ko.Status.CurrentFargateProfileName = replacement.FargateProfileName
+	replacement.Phase = aws.String(ReplacementPhaseDeletingPrevious)
+	return returnFargateProfileReplacing(updated, replacement)
+}
+
+// createReplacementFargateProfile creates the Fargate profile replacing the
+// one backing the supplied desired resource and returns its name. A profile
+// already created by a previous attempt is reused.
+func (rm *resourceManager) createReplacementFargateProfile(
+	ctx context.Context,
+	desired *resource,
+) (string, error) {
+	name, err := replacementFargateProfileName(desired)
+	if err != nil {
+		return "", err
+	}
+	input, err := rm.newCreateRequestPayload(ctx, desired)
+	if err != nil {
+		return "", err
+	}
+	input.FargateProfileName = aws.String(name)
+	// The token identifies the creation of the original profile.
+	input.ClientRequestToken = nil
+	_, err = rm.sdkapi.CreateFargateProfile(ctx, input)
+	rm.metrics.RecordAPICall("CREATE", "CreateFargateProfile", err)
+	if isResourceInUse(err) {
+		// Either the profile exists, or another profile of the cluster is
+		// being created or deleted.
+		if _, err := rm.describeFargateProfile(ctx, desired.ko.Spec.ClusterName, &name); err != nil {
+			if isNotFound(err) {
+				return "", requeueWaitWhileReplacing(ReplacementPhaseCreating)
+			}
+			return "", err
+		}
+		return name, nil
+	}
+	if err != nil {
+		return "", err
+	}
+	return name, nil
+}
+
+// waitForSiblings returns a requeue error if a Fargate profile of the
+// supplied cluster is being created or deleted.
+func (rm *resourceManager) waitForSiblings(
+	ctx context.Context,
+	clusterName *string,
+) error {
+	resp, err := rm.sdkapi.ListFargateProfiles(ctx, &svcsdk.ListFargateProfilesInput{
+		ClusterName: clusterName,
+	})
+	rm.metrics.RecordAPICall("READ_MANY", "ListFargateProfiles", err)
+	if err != nil {
+		return err
+	}
+	for _, name := range resp.FargateProfileNames {
+		profile, err := rm.describeFargateProfile(ctx, clusterName, aws.String(name))
+		if isNotFound(err) {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		switch profile.Status {
+		case svcsdktypes.FargateProfileStatusCreating, svcsdktypes.FargateProfileStatusDeleting:
+			return requeueWaitForSibling(name, profile.Status)
+		}
+	}
+	return nil
+}
+
+// syncReplacement completes the replacement of the supplied Fargate profile
+// once the previous profile is deleted. Until then, the resource is marked as
+// not synced.
+func (rm *resourceManager) syncReplacement(
+	ctx context.Context,
+	r *resource,
+) error {
+	replacement := r.ko.Status.Replacement
+	if replacement == nil {
+		return nil
+	}
+	if aws.ToString(replacement.Phase) == ReplacementPhaseDeletingPrevious {
+		_, err := rm.describeFargateProfile(ctx, r.ko.Spec.ClusterName, replacement.PreviousFargateProfileName)
+		if isNotFound(err) {
+			r.ko.Status.Replacement = nil
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+	}
+	msg := fmt.Sprintf("FargateProfile replacement in '%s' phase", aws.ToString(replacement.Phase))
+	ackcondition.SetSynced(r, corev1.ConditionFalse, &msg, nil)
+	return nil
+}
+
+// deleteReplacementFargateProfile makes sure no other Fargate profile of the
+// replacement in flight is being created or deleted before the supplied
+// resource is deleted: the profile created by the replacement, which the
+// resource does not point at yet, is deleted, and the previous profile of a
+// completed replacement is waited for. A requeue error is returned until
+// they are gone.
+func (rm *resourceManager) deleteReplacementFargateProfile(
+	ctx context.Context,
+	r *resource,
+) error {
+	replacement := r.ko.Status.Replacement
+	if replacement == nil {
+		return nil
+	}
+	name := replacement.FargateProfileName
+	if !replacementInProgress(r) {
+		name = replacement.PreviousFargateProfileName
+	}
+	profile, err := rm.describeFargateProfile(ctx, r.ko.Spec.ClusterName, name)
+	if isNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if replacementInProgress(r) && profile.Status != svcsdktypes.FargateProfileStatusCreating &&
+		profile.Status != svcsdktypes.FargateProfileStatusDeleting {
+		_, err = rm.sdkapi.DeleteFargateProfile(ctx, &svcsdk.DeleteFargateProfileInput{
+			ClusterName:        r.ko.Spec.ClusterName,
+			FargateProfileName: name,
+		})
+		rm.metrics.RecordAPICall("DELETE", "DeleteFargateProfile", err)
+		if err != nil && !isNotFound(err) && !isResourceInUse(err) {
+			return err
+		}
+	}
+	return ackrequeue.NeededAfter(
+		fmt.Errorf("waiting for fargate profile %s to be deleted", aws.ToString(name)),
+		ackrequeue.DefaultRequeueAfterDuration,
+	)
+}
+
+// describeFargateProfile returns the Fargate profile with the supplied name.
+func (rm *resourceManager) describeFargateProfile(
+	ctx context.Context,
+	clusterName *string,
+	name *string,
+) (*svcsdktypes.FargateProfile, error) {
+	resp, err := rm.sdkapi.DescribeFargateProfile(ctx, &svcsdk.DescribeFargateProfileInput{
+		ClusterName:        clusterName,
+		FargateProfileName: name,
+	})
+	rm.metrics.RecordAPICall("READ_ONE", "DescribeFargateProfile", err)
+	if err != nil {
+		return nil, err
+	}
+	return resp.FargateProfile, nil
+}
+
+// isNotFound returns true if the supplied error is a ResourceNotFoundException
+// returned by EKS.
+func isNotFound(err error) bool {
+	var awsErr smithy.APIError
+	return errors.As(err, &awsErr) && awsErr.ErrorCode() == "ResourceNotFoundException"
+}
+
+// isResourceInUse returns true if the supplied error is a
+// ResourceInUseException returned by EKS.
+func isResourceInUse(err error) bool {
+	var awsErr smithy.APIError
+	return errors.As(err, &awsErr) && awsErr.ErrorCode() == "ResourceInUseException"
+}
+
+// returnFargateProfileReplacing sets synced to false on the resource and
+// returns a requeue error, to follow the progress of the supplied
+// replacement.
+func returnFargateProfileReplacing(
+	r *resource,
+	replacement *svcapitypes.FargateProfileReplacement,
+) (*resource, error) {
+	phase := aws.ToString(replacement.Phase)
+	msg := fmt.Sprintf("FargateProfile replacement in '%s' phase", phase)
+	ackcondition.SetSynced(r, corev1.ConditionFalse, &msg, nil)
+	return r, requeueWaitWhileReplacing(phase)
+}
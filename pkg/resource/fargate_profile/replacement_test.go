@@ -0,0 +1,212 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package fargate_profile
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	ackv1alpha1 "github.com/aws-controllers-k8s/runtime/apis/core/v1alpha1"
+	ackcondition "github.com/aws-controllers-k8s/runtime/pkg/condition"
+	ackmetrics "github.com/aws-controllers-k8s/runtime/pkg/metrics"
+	ackrequeue "github.com/aws-controllers-k8s/runtime/pkg/requeue"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	svcsdk "github.com/aws/aws-sdk-go-v2/service/eks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+
+	svcapitypes "github.com/aws-controllers-k8s/eks-controller/apis/v1alpha1"
+	"github.com/aws-controllers-k8s/eks-controller/pkg/testutil"
+)
+
+const profilesPath = "/clusters/my-cluster/fargate-profiles"
+
+func newReplacementManager(hc svcsdk.HTTPClient) *resourceManager {
+	return &resourceManager{
+		metrics:      ackmetrics.NewMetrics("eks"),
+		sdkapi:       testutil.NewEKSClient(hc),
+		awsAccountID: "111122223333",
+		awsRegion:    "us-west-2",
+	}
+}
+
+func newReplacementProfile(namespace string) *resource {
+	arn := ackv1alpha1.AWSResourceName("arn:aws:eks:us-west-2:111122223333:fargateprofile/my-cluster/web/id")
+	accountID := ackv1alpha1.AWSAccountID("111122223333")
+	region := ackv1alpha1.AWSRegion("us-west-2")
+	return &resource{ko: &svcapitypes.FargateProfile{
+		Spec: svcapitypes.FargateProfileSpec{
+			Name:                aws.String("web"),
+			ClusterName:         aws.String("my-cluster"),
+			PodExecutionRoleARN: aws.String("arn:aws:iam::111122223333:role/pods"),
+			Subnets:             aws.StringSlice([]string{"subnet-1"}),
+			Selectors: []*svcapitypes.FargateProfileSelector{
+				{Namespace: aws.String(namespace)},
+			},
+		},
+		Status: svcapitypes.FargateProfileStatus{
+			ACKResourceMetadata: &ackv1alpha1.ResourceMetadata{ARN: &arn, OwnerAccountID: &accountID, Region: &region},
+			Status:              aws.String("ACTIVE"),
+		},
+	}}
+}
+
+func describeResponse(name, status string) string {
+	return fmt.Sprintf(`{"fargateProfile": {"fargateProfileName": %q, "clusterName": "my-cluster", "status": %q}}`, name, status)
+}
+
+func TestReplacementFargateProfileName(t *testing.T) {
+	nameA, err := replacementFargateProfileName(newReplacementProfile("web"))
+	require.NoError(t, err)
+	again, err := replacementFargateProfileName(newReplacementProfile("web"))
+	require.NoError(t, err)
+	nameB, err := replacementFargateProfileName(newReplacementProfile("api"))
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(nameA, "web-"))
+	assert.Len(t, nameA, len("web-")+8)
+	assert.Equal(t, nameA, again)
+	assert.NotEqual(t, nameA, nameB)
+
+	r := newReplacementProfile("web")
+	r.ko.Spec.Name = aws.String(strings.Repeat("n", 100))
+	long, err := replacementFargateProfileName(r)
+	require.NoError(t, err)
+	assert.Len(t, long, maxFargateProfileNameLength)
+}
+
+func TestCustomUpdateWithoutReplacementStrategy(t *testing.T) {
+	hc := &testutil.FakeHTTPClient{}
+	rm := newReplacementManager(hc)
+	desired := newReplacementProfile("api")
+	latest := newReplacementProfile("web")
+
+	updated, err := rm.customUpdate(context.TODO(), desired, latest, newResourceDelta(desired, latest))
+	require.NoError(t, err)
+	synced := ackcondition.Synced(updated)
+	require.NotNil(t, synced)
+	assert.Equal(t, corev1.ConditionFalse, synced.Status)
+	assert.Equal(t, UnableToUpdateError, *synced.Message)
+	assert.Empty(t, hc.Requests)
+}
+
+func TestCustomUpdateReplacement(t *testing.T) {
+	desired := newReplacementProfile("api")
+	desired.ko.Spec.ReplacementStrategy = aws.String(svcapitypes.FargateProfileReplacementStrategyCreateBeforeDestroy)
+	latest := newReplacementProfile("web")
+	name, err := replacementFargateProfileName(desired)
+	require.NoError(t, err)
+
+	hc := &testutil.FakeHTTPClient{Responses: map[string]string{
+		"GET " + profilesPath:            `{"fargateProfileNames": ["web", "batch"]}`,
+		"GET " + profilesPath + "/web":   describeResponse("web", "ACTIVE"),
+		"GET " + profilesPath + "/batch": describeResponse("batch", "DELETING"),
+	}}
+	rm := newReplacementManager(hc)
+
+	// The replacement waits for the other profiles of the cluster.
+	_, err = rm.customUpdate(context.TODO(), desired, latest, newResourceDelta(desired, latest))
+	var requeueErr *ackrequeue.RequeueNeededAfter
+	require.True(t, errors.As(err, &requeueErr))
+	assert.Contains(t, err.Error(), "fargate profile batch is in 'DELETING' state")
+	assert.NotContains(t, hc.Requests, "POST "+profilesPath)
+
+	// The new profile is created once they are stable.
+	hc.Requests = nil
+	hc.Responses["GET "+profilesPath+"/batch"] = describeResponse("batch", "ACTIVE")
+	hc.Responses["POST "+profilesPath] = describeResponse(name, "CREATING")
+	updated, err := rm.customUpdate(context.TODO(), desired, latest, newResourceDelta(desired, latest))
+	require.True(t, errors.As(err, &requeueErr))
+	assert.Contains(t, hc.Requests, "POST "+profilesPath)
+	replacement := updated.ko.Status.Replacement
+	require.NotNil(t, replacement)
+	assert.Equal(t, name, *replacement.FargateProfileName)
+	assert.Equal(t, "web", *replacement.PreviousFargateProfileName)
+	assert.Equal(t, ReplacementPhaseCreating, *replacement.Phase)
+
+	// The previous profile is kept until the new one is active.
+	hc.Requests = nil
+	latest.ko.Status.Replacement = replacement
+	hc.Responses["GET "+profilesPath+"/"+name] = describeResponse(name, "CREATING")
+	updated, err = rm.customUpdate(context.TODO(), desired, latest, newResourceDelta(desired, latest))
+	require.True(t, errors.As(err, &requeueErr))
+	assert.Equal(t, []string{"GET " + profilesPath + "/" + name}, hc.Requests)
+	assert.Nil(t, updated.ko.Status.CurrentFargateProfileName)
+
+	// Then the resource points at the new profile and the previous one is
+	// deleted.
+	hc.Requests = nil
+	hc.Responses["GET "+profilesPath+"/"+name] = describeResponse(name, "ACTIVE")
+	hc.Responses["DELETE "+profilesPath+"/web"] = describeResponse("web", "DELETING")
+	updated, err = rm.customUpdate(context.TODO(), desired, latest, newResourceDelta(desired, latest))
+	require.True(t, errors.As(err, &requeueErr))
+	assert.Contains(t, hc.Requests, "DELETE "+profilesPath+"/web")
+	assert.Equal(t, name, *updated.ko.Status.CurrentFargateProfileName)
+	assert.Equal(t, ReplacementPhaseDeletingPrevious, *updated.ko.Status.Replacement.Phase)
+	assert.Equal(t, name, *fargateProfileName(updated))
+}
+
+func TestSyncReplacement(t *testing.T) {
+	hc := &testutil.FakeHTTPClient{Responses: map[string]string{
+		"GET " + profilesPath + "/web": describeResponse("web", "DELETING"),
+	}}
+	rm := newReplacementManager(hc)
+	r := newReplacementProfile("api")
+	r.ko.Status.CurrentFargateProfileName = aws.String("web-1")
+	r.ko.Status.Replacement = &svcapitypes.FargateProfileReplacement{
+		FargateProfileName:         aws.String("web-1"),
+		Phase:                      aws.String(ReplacementPhaseDeletingPrevious),
+		PreviousFargateProfileName: aws.String("web"),
+	}
+
+	require.NoError(t, rm.syncReplacement(context.TODO(), r))
+	assert.NotNil(t, r.ko.Status.Replacement)
+	assert.Equal(t, corev1.ConditionFalse, ackcondition.Synced(r).Status)
+
+	// The replacement is complete once the previous profile is gone.
+	delete(hc.Responses, "GET "+profilesPath+"/web")
+	require.NoError(t, rm.syncReplacement(context.TODO(), r))
+	assert.Nil(t, r.ko.Status.Replacement)
+	assert.Equal(t, "web-1", *r.ko.Status.CurrentFargateProfileName)
+}
+
+func TestDeleteReplacementFargateProfile(t *testing.T) {
+	hc := &testutil.FakeHTTPClient{Responses: map[string]string{
+		"GET " + profilesPath + "/web-1":    describeResponse("web-1", "ACTIVE"),
+		"DELETE " + profilesPath + "/web-1": describeResponse("web-1", "DELETING"),
+	}}
+	rm := newReplacementManager(hc)
+	r := newReplacementProfile("api")
+	require.NoError(t, rm.deleteReplacementFargateProfile(context.TODO(), r))
+	assert.Empty(t, hc.Requests)
+
+	// The profile created by the replacement in flight is deleted first.
+	r.ko.Status.Replacement = &svcapitypes.FargateProfileReplacement{
+		FargateProfileName:         aws.String("web-1"),
+		Phase:                      aws.String(ReplacementPhaseCreating),
+		PreviousFargateProfileName: aws.String("web"),
+	}
+	err := rm.deleteReplacementFargateProfile(context.TODO(), r)
+	var requeueErr *ackrequeue.RequeueNeededAfter
+	require.True(t, errors.As(err, &requeueErr))
+	assert.Contains(t, hc.Requests, "DELETE "+profilesPath+"/web-1")
+
+	hc.Requests = nil
+	delete(hc.Responses, "GET "+profilesPath+"/web-1")
+	require.NoError(t, rm.deleteReplacementFargateProfile(context.TODO(), r))
+	assert.NotContains(t, hc.Requests, "DELETE "+profilesPath+"/web-1")
+}
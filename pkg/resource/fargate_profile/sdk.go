@@ -74,6 +74,7 @@ func (rm *resourceManager) sdkFind(
 	if err != nil {
 		return nil, err
 	}
+	input.FargateProfileName = fargateProfileName(r)
 
 	var resp *svcsdk.DescribeFargateProfileOutput
 	resp, err = rm.sdkapi.DescribeFargateProfile(ctx, input)
@@ -173,6 +174,16 @@ func (rm *resourceManager) sdkFind(
 	}
 
 	rm.setStatusDefaults(ko)
+	// Once replaced, the Fargate profile backing the resource is not named after it.
+	ko.Spec.Name = r.ko.Spec.Name
+	syncHealthConditions(ctx, &resource{ko})
+	if err := rm.syncReplacement(ctx, &resource{ko}); err != nil {
+		return nil, err
+	}
+	if err := rm.syncSelectorOverlap(ctx, &resource{ko}); err != nil {
+		return nil, err
+	}
+
 	return &resource{ko}, nil
 }
 
@@ -215,6 +226,9 @@ func (rm *resourceManager) sdkCreate(
 	defer func() {
 		exit(err)
 	}()
+	if err := rm.checkSelectorOverlap(ctx, desired); err != nil {
+		return nil, err
+	}
 	input, err := rm.newCreateRequestPayload(ctx, desired)
 	if err != nil {
 		return nil, err
@@ -385,11 +399,16 @@ func (rm *resourceManager) sdkDelete(
 	if profileDeleting(r) {
 		return r, requeueWaitWhileDeleting
 	}
+	if err := rm.deleteReplacementFargateProfile(ctx, r); err != nil {
+		return r, err
+	}
 
 	input, err := rm.newDeleteRequestPayload(r)
 	if err != nil {
 		return nil, err
 	}
+	input.FargateProfileName = fargateProfileName(r)
+
 	var resp *svcsdk.DeleteFargateProfileOutput
 	_ = resp
 	resp, err = rm.sdkapi.DeleteFargateProfile(ctx, input)
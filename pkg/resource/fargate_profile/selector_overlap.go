@@ -0,0 +1,411 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package fargate_profile
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	ackv1alpha1 "github.com/aws-controllers-k8s/runtime/apis/core/v1alpha1"
+	ackrequeue "github.com/aws-controllers-k8s/runtime/pkg/requeue"
+	ackrtlog "github.com/aws-controllers-k8s/runtime/pkg/runtime/log"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	svcsdk "github.com/aws/aws-sdk-go-v2/service/eks"
+	svcsdktypes "github.com/aws/aws-sdk-go-v2/service/eks/types"
+	corev1 "k8s.io/api/core/v1"
+	ctrlrtclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	svcapitypes "github.com/aws-controllers-k8s/eks-controller/apis/v1alpha1"
+	"github.com/aws-controllers-k8s/eks-controller/pkg/condition"
+	"github.com/aws-controllers-k8s/eks-controller/pkg/kube"
+)
+
+// selectorOverlapPolicy returns the policy of the
+// SelectorOverlapPolicyAnnotation annotation of the supplied resource.
+func selectorOverlapPolicy(r *resource) string {
+	switch policy := r.ko.Annotations[svcapitypes.SelectorOverlapPolicyAnnotation]; policy {
+	case svcapitypes.SelectorOverlapPolicyIgnore,
+		svcapitypes.SelectorOverlapPolicyWarn,
+		svcapitypes.SelectorOverlapPolicyBlock:
+		return policy
+	}
+	return svcapitypes.DefaultSelectorOverlapPolicy
+}
+
+// patternsOverlap returns true if a value could match both of the supplied
+// patterns. Fargate selectors accept the '*' and '?' wildcards in namespaces
+// and label values.
+func patternsOverlap(a, b string) bool {
+	pa, pb := []rune(a), []rune(b)
+	// overlaps[i][j] caches whether the suffixes pa[i:] and pb[j:] overlap,
+	// 0 meaning unknown, 1 no and 2 yes.
+	overlaps := make([][]byte, len(pa)+1)
+	for i := range overlaps {
+		overlaps[i] = make([]byte, len(pb)+1)
+	}
+	var overlap func(i, j int) bool
+	overlap = func(i, j int) bool {
+		if overlaps[i][j] != 0 {
+			return overlaps[i][j] == 2
+		}
+		var result bool
+		switch {
+		case i < len(pa) && pa[i] == '*':
+			// The wildcard matches nothing, or the next character of the
+			// other pattern.
+			result = overlap(i+1, j) || (j < len(pb) && overlap(i, j+1))
+		case j < len(pb) && pb[j] == '*':
+			result = overlap(i, j+1) || (i < len(pa) && overlap(i+1, j))
+		case i == len(pa) || j == len(pb):
+			result = i == len(pa) && j == len(pb)
+		default:
+			result = (pa[i] == '?' || pb[j] == '?' || pa[i] == pb[j]) && overlap(i+1, j+1)
+		}
+		overlaps[i][j] = 1
+		if result {
+			overlaps[i][j] = 2
+		}
+		return result
+	}
+	return overlap(0, 0)
+}
+
+// selectorsOverlap returns true if a pod could match both of the supplied
+// selectors: their namespaces overlap, and no label they share requires
+// different values.
+func selectorsOverlap(a, b *svcapitypes.FargateProfileSelector) bool {
+	if a == nil || b == nil {
+		return false
+	}
+	if !patternsOverlap(aws.ToString(a.Namespace), aws.ToString(b.Namespace)) {
+		return false
+	}
+	for key, valueA := range a.Labels {
+		if valueB, ok := b.Labels[key]; ok && !patternsOverlap(aws.ToString(valueA), aws.ToString(valueB)) {
+			return false
+		}
+	}
+	return true
+}
+
+// profileSelectorsOverlap returns true if any of the supplied selectors
+// overlaps with any of the other supplied selectors.
+func profileSelectorsOverlap(a, b []*svcapitypes.FargateProfileSelector) bool {
+	for _, selectorA := range a {
+		for _, selectorB := range b {
+			if selectorsOverlap(selectorA, selectorB) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// profileNames returns the names of the Fargate profiles backing the supplied
+// custom resource, including the ones of a replacement in flight.
+func profileNames(ko *svcapitypes.FargateProfile) []string {
+	names := []string{aws.ToString(ko.Spec.Name), aws.ToString(ko.Status.CurrentFargateProfileName)}
+	if replacement := ko.Status.Replacement; replacement != nil {
+		names = append(names,
+			aws.ToString(replacement.FargateProfileName),
+			aws.ToString(replacement.PreviousFargateProfileName),
+		)
+	}
+	return names
+}
+
+// referencesSameCluster returns true if the supplied custom resource belongs
+// to the cluster of the supplied resource, managed by the resource manager.
+// Custom resources naming the cluster must live in the same AWS account and
+// region.
+func (rm *resourceManager) referencesSameCluster(r *resource, other *svcapitypes.FargateProfile) bool {
+	if other.Spec.ClusterName != nil {
+		reference := &ackv1alpha1.ResourceMetadata{OwnerAccountID: &rm.awsAccountID, Region: &rm.awsRegion}
+		return *other.Spec.ClusterName == aws.ToString(r.ko.Spec.ClusterName) &&
+			kube.SameAccountAndRegion(reference, other, other.Status.ACKResourceMetadata)
+	}
+	return clusterRefKey(other.Namespace, other.Spec.ClusterRef) != "" &&
+		clusterRefKey(other.Namespace, other.Spec.ClusterRef) == clusterRefKey(r.ko.Namespace, r.ko.Spec.ClusterRef)
+}
+
+// clusterRefKey returns the namespaced name of the Cluster custom resource
+// the supplied reference points to, or an empty string.
+func clusterRefKey(namespace string, ref *ackv1alpha1.AWSResourceReferenceWrapper) string {
+	if ref == nil || ref.From == nil || ref.From.Name == nil {
+		return ""
+	}
+	if ref.From.Namespace != nil && *ref.From.Namespace != "" {
+		namespace = *ref.From.Namespace
+	}
+	return namespace + "/" + *ref.From.Name
+}
+
+// liveOverlapScanInterval is how long the live Fargate profiles found to
+// overlap with the selectors of a custom resource are remembered, see
+// liveOverlapCache.
+const liveOverlapScanInterval = time.Hour
+
+// liveOverlapScan is the result of a scan of the live Fargate profiles of a
+// cluster for the selectors of a custom resource.
+type liveOverlapScan struct {
+	// key identifies the cluster and selectors of the custom resource.
+	key string
+	// at is the time of the scan.
+	at time.Time
+	// names are the names of the overlapping live profiles.
+	names []string
+}
+
+// liveOverlapCache remembers, per custom resource, the live Fargate profiles
+// found to overlap with its selectors, so that reading a Fargate profile
+// doesn't describe every profile of its cluster. The scan is done again on
+// creation, replacement, or once liveOverlapScanInterval has elapsed. A nil
+// cache remembers nothing.
+type liveOverlapCache struct {
+	sync.Mutex
+	scans map[string]liveOverlapScan
+}
+
+// liveOverlaps is the liveOverlapCache shared by the resource managers.
+var liveOverlaps = newLiveOverlapCache()
+
+// newLiveOverlapCache returns an empty liveOverlapCache.
+func newLiveOverlapCache() *liveOverlapCache {
+	return &liveOverlapCache{scans: map[string]liveOverlapScan{}}
+}
+
+// get returns the names of the live profiles overlapping with the selectors
+// of the supplied resource, and false if they are unknown or too old.
+func (c *liveOverlapCache) get(r *resource, key string) ([]string, bool) {
+	if c == nil {
+		return nil, false
+	}
+	c.Lock()
+	defer c.Unlock()
+	scan, ok := c.scans[r.ko.Namespace+"/"+r.ko.Name]
+	if !ok || scan.key != key || time.Since(scan.at) > liveOverlapScanInterval {
+		return nil, false
+	}
+	return scan.names, true
+}
+
+// set records the names of the live profiles overlapping with the selectors
+// of the supplied resource.
+func (c *liveOverlapCache) set(r *resource, key string, names []string) {
+	if c == nil {
+		return
+	}
+	c.Lock()
+	defer c.Unlock()
+	c.scans[r.ko.Namespace+"/"+r.ko.Name] = liveOverlapScan{key: key, at: time.Now(), names: names}
+}
+
+// forget drops what is remembered for the supplied resource.
+func (c *liveOverlapCache) forget(r *resource) {
+	if c == nil {
+		return
+	}
+	c.Lock()
+	defer c.Unlock()
+	delete(c.scans, r.ko.Namespace+"/"+r.ko.Name)
+}
+
+// liveOverlapKey returns the key of the cluster and selectors of the
+// supplied resource in the liveOverlapCache.
+func liveOverlapKey(r *resource) (string, error) {
+	data, err := json.Marshal([]interface{}{r.ko.Spec.ClusterName, r.ko.Spec.Selectors})
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+// findSelectorOverlaps returns the Fargate profiles of the cluster of the
+// supplied resource whose selectors overlap with its selectors: the other
+// FargateProfile custom resources first, and then the live profiles listed
+// by EKS that do not back any of them. The live profiles are only scanned
+// when rescan is true, or when the liveOverlapCache doesn't know them.
+func (rm *resourceManager) findSelectorOverlaps(
+	ctx context.Context,
+	r *resource,
+	rescan bool,
+) ([]string, error) {
+	overlaps := []string{}
+	known := map[string]bool{}
+	for _, name := range profileNames(r.ko) {
+		known[name] = true
+	}
+
+	// The other profiles are looked up both by cluster name and by cluster
+	// reference.
+	cache, err := kube.ClusterCache(rm.cfg)
+	if err != nil {
+		return nil, err
+	}
+	keys := []string{
+		kube.ClusterIndexKey(r.ko.Namespace, r.ko.Spec.ClusterName, nil),
+		kube.ClusterIndexKey(r.ko.Namespace, nil, r.ko.Spec.ClusterRef),
+	}
+	for _, key := range keys {
+		if key == "" {
+			continue
+		}
+		profiles := &svcapitypes.FargateProfileList{}
+		if err := cache.List(ctx, profiles, ctrlrtclient.MatchingFields{kube.ClusterIndexField: key}); err != nil {
+			return nil, err
+		}
+		for i := range profiles.Items {
+			other := &profiles.Items[i]
+			if (other.Namespace == r.ko.Namespace && other.Name == r.ko.Name) || !rm.referencesSameCluster(r, other) {
+				continue
+			}
+			for _, name := range profileNames(other) {
+				known[name] = true
+			}
+			if profileSelectorsOverlap(r.ko.Spec.Selectors, other.Spec.Selectors) {
+				overlaps = append(overlaps, fmt.Sprintf("FargateProfile %s/%s", other.Namespace, other.Name))
+			}
+		}
+	}
+
+	if r.ko.Spec.ClusterName == nil {
+		return overlaps, nil
+	}
+	key, err := liveOverlapKey(r)
+	if err != nil {
+		return nil, err
+	}
+	names, ok := liveOverlaps.get(r, key)
+	if rescan || !ok {
+		if names, err = rm.scanLiveOverlaps(ctx, r, known); err != nil {
+			return nil, err
+		}
+		liveOverlaps.set(r, key, names)
+	}
+	for _, name := range names {
+		if !known[name] {
+			overlaps = append(overlaps, fmt.Sprintf("fargate profile %s", name))
+		}
+	}
+	sort.Strings(overlaps)
+	return overlaps, nil
+}
+
+// scanLiveOverlaps returns the names of the live Fargate profiles of the
+// cluster of the supplied resource whose selectors overlap with its
+// selectors. The supplied known profiles, which back custom resources, are
+// not described.
+func (rm *resourceManager) scanLiveOverlaps(
+	ctx context.Context,
+	r *resource,
+	known map[string]bool,
+) ([]string, error) {
+	names := []string{}
+	paginator := svcsdk.NewListFargateProfilesPaginator(rm.sdkapi, &svcsdk.ListFargateProfilesInput{
+		ClusterName: r.ko.Spec.ClusterName,
+	})
+	for paginator.HasMorePages() {
+		resp, err := paginator.NextPage(ctx)
+		rm.metrics.RecordAPICall("READ_MANY", "ListFargateProfiles", err)
+		if err != nil {
+			return nil, err
+		}
+		for _, name := range resp.FargateProfileNames {
+			if known[name] {
+				continue
+			}
+			profile, err := rm.describeFargateProfile(ctx, r.ko.Spec.ClusterName, aws.String(name))
+			if isNotFound(err) {
+				continue
+			}
+			if err != nil {
+				return nil, err
+			}
+			if profile.Status == svcsdktypes.FargateProfileStatusDeleting {
+				continue
+			}
+			selectors := make([]*svcapitypes.FargateProfileSelector, 0, len(profile.Selectors))
+			for _, selector := range profile.Selectors {
+				selectors = append(selectors, &svcapitypes.FargateProfileSelector{
+					Labels:    aws.StringMap(selector.Labels),
+					Namespace: selector.Namespace,
+				})
+			}
+			if profileSelectorsOverlap(r.ko.Spec.Selectors, selectors) {
+				names = append(names, name)
+			}
+		}
+	}
+	return names, nil
+}
+
+// syncSelectorOverlap sets the SelectorOverlap condition of the supplied
+// resource if its selectors overlap with the ones of another Fargate profile
+// of its cluster, and removes it otherwise. The live profiles of the cluster
+// are only scanned when the liveOverlapCache doesn't know them.
+func (rm *resourceManager) syncSelectorOverlap(
+	ctx context.Context,
+	r *resource,
+) (err error) {
+	policy := selectorOverlapPolicy(r)
+	if policy == svcapitypes.SelectorOverlapPolicyIgnore || profileDeleting(r) {
+		liveOverlaps.forget(r)
+		condition.Remove(r, svcapitypes.ConditionTypeSelectorOverlap)
+		return nil
+	}
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.syncSelectorOverlap")
+	defer func() { exit(err) }()
+
+	overlaps, err := rm.findSelectorOverlaps(ctx, r, false)
+	if err != nil {
+		return err
+	}
+	if len(overlaps) == 0 {
+		condition.Remove(r, svcapitypes.ConditionTypeSelectorOverlap)
+		return nil
+	}
+	msg := "Selectors overlap with " + strings.Join(overlaps, ", ")
+	condition.Set(r, svcapitypes.ConditionTypeSelectorOverlap, corev1.ConditionTrue, &msg, aws.String(policy))
+	return nil
+}
+
+// checkSelectorOverlap returns a requeue error if the selectors of the
+// supplied desired resource overlap with the ones of another Fargate profile
+// of its cluster and its policy is Block, holding back the creation or
+// replacement of the profile until they no longer do.
+func (rm *resourceManager) checkSelectorOverlap(
+	ctx context.Context,
+	desired *resource,
+) error {
+	if selectorOverlapPolicy(desired) != svcapitypes.SelectorOverlapPolicyBlock {
+		return nil
+	}
+	overlaps, err := rm.findSelectorOverlaps(ctx, desired, true)
+	if err != nil {
+		return err
+	}
+	if len(overlaps) == 0 {
+		return nil
+	}
+	return ackrequeue.NeededAfter(
+		fmt.Errorf("selectors overlap with %s", strings.Join(overlaps, ", ")),
+		ackrequeue.DefaultRequeueAfterDuration,
+	)
+}
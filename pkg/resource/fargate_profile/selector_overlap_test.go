@@ -0,0 +1,250 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package fargate_profile
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	ackv1alpha1 "github.com/aws-controllers-k8s/runtime/apis/core/v1alpha1"
+	ackcondition "github.com/aws-controllers-k8s/runtime/pkg/condition"
+	ackrequeue "github.com/aws-controllers-k8s/runtime/pkg/requeue"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrlrtclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	svcapitypes "github.com/aws-controllers-k8s/eks-controller/apis/v1alpha1"
+	"github.com/aws-controllers-k8s/eks-controller/pkg/kube"
+	"github.com/aws-controllers-k8s/eks-controller/pkg/testutil"
+)
+
+func newSelector(namespace string, labels map[string]string) *svcapitypes.FargateProfileSelector {
+	return &svcapitypes.FargateProfileSelector{
+		Namespace: aws.String(namespace),
+		Labels:    aws.StringMap(labels),
+	}
+}
+
+func TestPatternsOverlap(t *testing.T) {
+	tests := []struct {
+		a    string
+		b    string
+		want bool
+	}{
+		{"web", "web", true},
+		{"web", "api", false},
+		{"", "", true},
+		{"", "*", true},
+		{"", "?", false},
+		{"team-*", "team-a", true},
+		{"team-*", "*-prod", true},
+		{"team-*", "*-team", true},
+		{"a*", "b*", false},
+		{"*a", "*b", false},
+		{"*", "anything", true},
+		{"a?c", "ab?", true},
+		{"a?c", "a?d", false},
+		{"??", "*x*", true},
+		{"?", "x*y", false},
+		{"*a*", "*b*", true},
+		{"a*b*c", "*d*", true},
+		{"ab*", "*c", true},
+		{"abc*", "ab", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.a+" "+tt.b, func(t *testing.T) {
+			assert.Equal(t, tt.want, patternsOverlap(tt.a, tt.b))
+			assert.Equal(t, tt.want, patternsOverlap(tt.b, tt.a))
+		})
+	}
+}
+
+func TestSelectorsOverlap(t *testing.T) {
+	tests := []struct {
+		name string
+		a    *svcapitypes.FargateProfileSelector
+		b    *svcapitypes.FargateProfileSelector
+		want bool
+	}{
+		{"same namespace", newSelector("web", nil), newSelector("web", nil), true},
+		{"different namespaces", newSelector("web", nil), newSelector("api", nil), false},
+		{"namespace wildcard", newSelector("team-*", nil), newSelector("team-a", nil), true},
+		{"namespace wildcards", newSelector("team-*", nil), newSelector("*-prod", nil), true},
+		{"disjoint namespace wildcards", newSelector("team-*", nil), newSelector("ops-*", nil), false},
+		{"subset of labels", newSelector("web", map[string]string{"app": "a"}), newSelector("web", nil), true},
+		{"disjoint labels", newSelector("web", map[string]string{"app": "a"}), newSelector("web", map[string]string{"tier": "b"}), true},
+		{"conflicting labels", newSelector("web", map[string]string{"app": "a"}), newSelector("web", map[string]string{"app": "b"}), false},
+		{"label value wildcard", newSelector("web", map[string]string{"app": "a?"}), newSelector("web", map[string]string{"app": "ab"}), true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, selectorsOverlap(tt.a, tt.b))
+			assert.Equal(t, tt.want, selectorsOverlap(tt.b, tt.a))
+		})
+	}
+}
+
+func newOverlapProfile(name, namespace string, selectors ...*svcapitypes.FargateProfileSelector) *resource {
+	r := newReplacementProfile(namespace)
+	r.ko.ObjectMeta = metav1.ObjectMeta{Name: name, Namespace: "default"}
+	r.ko.Spec.Name = aws.String(name)
+	if len(selectors) > 0 {
+		r.ko.Spec.Selectors = selectors
+	}
+	return r
+}
+
+// newKubeClient returns a fake Kubernetes API client holding the custom
+// resources of the supplied profiles.
+func newKubeClient(profiles ...*resource) ctrlrtclient.Client {
+	objs := make([]ctrlrtclient.Object, 0, len(profiles))
+	for _, r := range profiles {
+		objs = append(objs, r.ko)
+	}
+	return testutil.NewKubeClient(objs...)
+}
+
+// setLiveOverlapCache replaces the liveOverlapCache for the duration of the
+// test.
+func setLiveOverlapCache(t *testing.T, c *liveOverlapCache) {
+	prev := liveOverlaps
+	liveOverlaps = c
+	t.Cleanup(func() { liveOverlaps = prev })
+}
+
+func TestSyncSelectorOverlap(t *testing.T) {
+	// The live profiles are scanned on every read.
+	setLiveOverlapCache(t, nil)
+	r := newOverlapProfile("web", "web")
+	byRef := newOverlapProfile("web-ref", "web")
+	byRef.ko.Spec.ClusterName = nil
+	byRef.ko.Spec.ClusterRef = &ackv1alpha1.AWSResourceReferenceWrapper{
+		From: &ackv1alpha1.AWSResourceReference{Name: aws.String("my-cluster")},
+	}
+	otherCluster := newOverlapProfile("web-other", "web")
+	otherCluster.ko.Spec.ClusterName = aws.String("another-cluster")
+	otherAccountID := ackv1alpha1.AWSAccountID("444455556666")
+	otherAccount := newOverlapProfile("web-other-account", "web")
+	otherAccount.ko.Status.ACKResourceMetadata.OwnerAccountID = &otherAccountID
+	kc := newKubeClient(
+		r,
+		newOverlapProfile("web-cr", "web", newSelector("web", map[string]string{"app": "a"})),
+		newOverlapProfile("api", "api"),
+		otherCluster,
+		otherAccount,
+	)
+	hc := &testutil.FakeHTTPClient{Responses: map[string]string{
+		"GET " + profilesPath: `{"fargateProfileNames": ["web", "web-cr", "live", "batch"]}`,
+		"GET " + profilesPath + "/live": `{"fargateProfile": {"fargateProfileName": "live", "status": "ACTIVE",
+			"selectors": [{"namespace": "w*"}]}}`,
+		"GET " + profilesPath + "/batch": `{"fargateProfile": {"fargateProfileName": "batch", "status": "ACTIVE",
+			"selectors": [{"namespace": "batch"}]}}`,
+	}}
+	rm := newReplacementManager(hc)
+	kube.SetClusterCache(kc)
+
+	require.NoError(t, rm.syncSelectorOverlap(context.TODO(), r))
+	overlap := ackcondition.FirstOfType(r, svcapitypes.ConditionTypeSelectorOverlap)
+	require.NotNil(t, overlap)
+	assert.Equal(t, corev1.ConditionTrue, overlap.Status)
+	assert.Equal(t, svcapitypes.SelectorOverlapPolicyWarn, *overlap.Reason)
+	assert.Equal(t, "Selectors overlap with FargateProfile default/web-cr, fargate profile live", *overlap.Message)
+	// The profiles backing custom resources are not described.
+	assert.NotContains(t, hc.Requests, "GET "+profilesPath+"/web-cr")
+
+	// Profiles referencing the cluster by reference are compared too.
+	r.ko.Spec.ClusterRef = byRef.ko.Spec.ClusterRef
+	kube.SetClusterCache(newKubeClient(r, byRef))
+	hc.Responses["GET "+profilesPath] = `{"fargateProfileNames": ["web"]}`
+	require.NoError(t, rm.syncSelectorOverlap(context.TODO(), r))
+	overlap = ackcondition.FirstOfType(r, svcapitypes.ConditionTypeSelectorOverlap)
+	require.NotNil(t, overlap)
+	assert.Equal(t, "Selectors overlap with FargateProfile default/web-ref", *overlap.Message)
+
+	// The condition is removed once the selectors no longer overlap.
+	kube.SetClusterCache(newKubeClient(r))
+	require.NoError(t, rm.syncSelectorOverlap(context.TODO(), r))
+	assert.Nil(t, ackcondition.FirstOfType(r, svcapitypes.ConditionTypeSelectorOverlap))
+
+	// And the detection can be turned off.
+	kube.SetClusterCache(newKubeClient(r, byRef))
+	hc.Requests = nil
+	r.ko.SetAnnotations(map[string]string{svcapitypes.SelectorOverlapPolicyAnnotation: svcapitypes.SelectorOverlapPolicyIgnore})
+	require.NoError(t, rm.syncSelectorOverlap(context.TODO(), r))
+	assert.Nil(t, ackcondition.FirstOfType(r, svcapitypes.ConditionTypeSelectorOverlap))
+	assert.Empty(t, hc.Requests)
+}
+
+func TestCheckSelectorOverlap(t *testing.T) {
+	desired := newOverlapProfile("web", "web")
+	hc := &testutil.FakeHTTPClient{Responses: map[string]string{
+		"GET " + profilesPath: `{"fargateProfileNames": ["web-cr"]}`,
+	}}
+	rm := newReplacementManager(hc)
+	kube.SetClusterCache(newKubeClient(newOverlapProfile("web-cr", "web")))
+	setLiveOverlapCache(t, newLiveOverlapCache())
+
+	// Overlaps only hold back the profile with the Block policy.
+	require.NoError(t, rm.checkSelectorOverlap(context.TODO(), desired))
+	assert.Empty(t, hc.Requests)
+
+	desired.ko.SetAnnotations(map[string]string{svcapitypes.SelectorOverlapPolicyAnnotation: svcapitypes.SelectorOverlapPolicyBlock})
+	err := rm.checkSelectorOverlap(context.TODO(), desired)
+	var requeueErr *ackrequeue.RequeueNeededAfter
+	require.True(t, errors.As(err, &requeueErr))
+	assert.EqualError(t, requeueErr.Unwrap(), "selectors overlap with FargateProfile default/web-cr")
+
+	desired.ko.Spec.Selectors = []*svcapitypes.FargateProfileSelector{newSelector("api", nil)}
+	require.NoError(t, rm.checkSelectorOverlap(context.TODO(), desired))
+}
+
+func TestSyncSelectorOverlapLiveScans(t *testing.T) {
+	r := newOverlapProfile("web", "web")
+	hc := &testutil.FakeHTTPClient{Responses: map[string]string{
+		"GET " + profilesPath: `{"fargateProfileNames": ["web", "live"]}`,
+		"GET " + profilesPath + "/live": `{"fargateProfile": {"fargateProfileName": "live", "status": "ACTIVE",
+			"selectors": [{"namespace": "w*"}]}}`,
+	}}
+	rm := newReplacementManager(hc)
+	kube.SetClusterCache(newKubeClient(r))
+	setLiveOverlapCache(t, newLiveOverlapCache())
+
+	require.NoError(t, rm.syncSelectorOverlap(context.TODO(), r))
+	assert.Equal(t, []string{"GET " + profilesPath, "GET " + profilesPath + "/live"}, hc.Requests)
+	overlap := ackcondition.FirstOfType(r, svcapitypes.ConditionTypeSelectorOverlap)
+	require.NotNil(t, overlap)
+	assert.Equal(t, "Selectors overlap with fargate profile live", *overlap.Message)
+
+	// The live profiles are not scanned again on the next read.
+	hc.Requests = nil
+	require.NoError(t, rm.syncSelectorOverlap(context.TODO(), r))
+	assert.Empty(t, hc.Requests)
+	assert.NotNil(t, ackcondition.FirstOfType(r, svcapitypes.ConditionTypeSelectorOverlap))
+
+	// They are when the selectors change...
+	r.ko.Spec.Selectors = []*svcapitypes.FargateProfileSelector{newSelector("api", nil)}
+	require.NoError(t, rm.syncSelectorOverlap(context.TODO(), r))
+	assert.Len(t, hc.Requests, 2)
+	assert.Nil(t, ackcondition.FirstOfType(r, svcapitypes.ConditionTypeSelectorOverlap))
+
+	// ...and by the checks done before creating or replacing a profile.
+	hc.Requests = nil
+	r.ko.SetAnnotations(map[string]string{svcapitypes.SelectorOverlapPolicyAnnotation: svcapitypes.SelectorOverlapPolicyBlock})
+	require.NoError(t, rm.checkSelectorOverlap(context.TODO(), r))
+	assert.Len(t, hc.Requests, 2)
+}
@@ -17,7 +17,13 @@ import (
 	"context"
 
 	ackcompare "github.com/aws-controllers-k8s/runtime/pkg/compare"
+	ackcondition "github.com/aws-controllers-k8s/runtime/pkg/condition"
 	ackrtlog "github.com/aws-controllers-k8s/runtime/pkg/runtime/log"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	corev1 "k8s.io/api/core/v1"
+
+	svcapitypes "github.com/aws-controllers-k8s/eks-controller/apis/v1alpha1"
+	"github.com/aws-controllers-k8s/eks-controller/pkg/tags"
 )
 
 // Taken from the list of nodegroup statuses on the boto3 documentation
@@ -30,6 +36,12 @@ const (
 	IdentityProviderConfigType = "oidc"
 )
 
+var (
+	UnableToUpdateError = "Changes to the OIDC configuration of IdentityProviderConfig" +
+		" resources are not possible in place. To update it, delete and re-create the" +
+		" resource, or set spec.replacementStrategy to " + svcapitypes.IdentityProviderConfigReplacementStrategyRecreate
+)
+
 // customCheckRequiredFieldsMissing returns true if there are any fields
 // for the ReadOne Input shape that are required but not present in the
 // resource's Spec or Status
@@ -81,5 +93,24 @@ func (rm *resourceManager) customUpdate(
 	exit := rlog.Trace("rm.customUpdate")
 	defer exit(err)
 
-	return nil, nil
+	updated = &resource{ko: desired.ko.DeepCopy()}
+	updated.SetStatus(latest)
+	if replacementInProgress(latest) || (delta.DifferentAt("Spec.OIDC") &&
+		replacementStrategy(desired) == svcapitypes.IdentityProviderConfigReplacementStrategyRecreate) {
+		return rm.replaceIdentityProviderConfig(ctx, desired, latest, updated)
+	}
+
+	if delta.DifferentAt("Spec.Tags") {
+		if err := tags.SyncTags(
+			ctx, rm.sdkapi, rm.metrics,
+			string(*latest.ko.Status.ACKResourceMetadata.ARN),
+			aws.ToStringMap(desired.ko.Spec.Tags), aws.ToStringMap(latest.ko.Spec.Tags),
+		); err != nil {
+			return nil, err
+		}
+	}
+	if delta.DifferentAt("Spec.OIDC") {
+		ackcondition.SetSynced(updated, corev1.ConditionFalse, &UnableToUpdateError, nil)
+	}
+	return updated, nil
 }
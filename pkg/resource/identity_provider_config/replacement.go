@@ -0,0 +1,211 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package identity_provider_config
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	ackcondition "github.com/aws-controllers-k8s/runtime/pkg/condition"
+	ackrequeue "github.com/aws-controllers-k8s/runtime/pkg/requeue"
+	ackrtlog "github.com/aws-controllers-k8s/runtime/pkg/runtime/log"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	svcsdk "github.com/aws/aws-sdk-go-v2/service/eks"
+	svcsdktypes "github.com/aws/aws-sdk-go-v2/service/eks/types"
+	"github.com/aws/smithy-go"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	svcapitypes "github.com/aws-controllers-k8s/eks-controller/apis/v1alpha1"
+)
+
+// The phases of the replacement of an OIDC identity provider configuration.
+const (
+	// ReplacementPhaseDisassociating means the previous configuration is
+	// being disassociated from the cluster. Once it is gone, the resource is
+	// not found anymore and the new configuration is associated by sdkCreate.
+	ReplacementPhaseDisassociating = "Disassociating"
+	// ReplacementPhaseAssociating means the new configuration is being
+	// associated with the cluster, the replacement waits for it to be active.
+	ReplacementPhaseAssociating = "Associating"
+)
+
+// requeueWaitWhileReplacing returns a `ackrequeue.RequeueNeededAfter`
+// explaining the identity provider configuration is being replaced.
+func requeueWaitWhileReplacing(phase string) *ackrequeue.RequeueNeededAfter {
+	return ackrequeue.NeededAfter(
+		fmt.Errorf("identity provider config replacement in '%s' phase", phase),
+		ackrequeue.DefaultRequeueAfterDuration,
+	)
+}
+
+// replacementStrategy returns the replacement strategy of the supplied
+// resource. Unknown values fall back to the default strategy.
+func replacementStrategy(r *resource) string {
+	switch strategy := aws.ToString(r.ko.Spec.ReplacementStrategy); strategy {
+	case svcapitypes.IdentityProviderConfigReplacementStrategyNone,
+		svcapitypes.IdentityProviderConfigReplacementStrategyRecreate:
+		return strategy
+	}
+	return svcapitypes.DefaultIdentityProviderConfigReplacementStrategy
+}
+
+// replacementInProgress returns true if the previous configuration of the
+// supplied resource is being disassociated.
+func replacementInProgress(r *resource) bool {
+	replacement := r.ko.Status.Replacement
+	return replacement != nil && aws.ToString(replacement.Phase) == ReplacementPhaseDisassociating
+}
+
+// setReplacementUpdate records the supplied disassociation or association
+// update in the supplied replacement.
+func setReplacementUpdate(
+	replacement *svcapitypes.IdentityProviderConfigReplacement,
+	update *svcsdktypes.Update,
+) {
+	if update == nil {
+		return
+	}
+	replacement.UpdateID = update.Id
+	replacement.UpdateStatus = nil
+	if update.Status != "" {
+		replacement.UpdateStatus = aws.String(string(update.Status))
+	}
+}
+
+// recordReplacementAssociation moves the replacement of the supplied resource,
+// if any, to the Associating phase once sdkCreate associated the new
+// configuration with the cluster.
+func recordReplacementAssociation(r *resource, update *svcsdktypes.Update) {
+	replacement := r.ko.Status.Replacement
+	if replacement == nil {
+		return
+	}
+	replacement.Phase = aws.String(ReplacementPhaseAssociating)
+	setReplacementUpdate(replacement, update)
+}
+
+// replaceIdentityProviderConfig disassociates the OIDC configuration of the
+// supplied latest resource from its cluster, to associate the desired one
+// once it is gone. EKS cannot update an OIDC configuration in place.
+func (rm *resourceManager) replaceIdentityProviderConfig(
+	ctx context.Context,
+	desired *resource,
+	latest *resource,
+	updated *resource,
+) (*resource, error) {
+	rlog := ackrtlog.FromContext(ctx)
+	replacement := updated.ko.Status.Replacement
+	if replacement != nil {
+		if aws.ToString(replacement.UpdateStatus) == string(svcsdktypes.UpdateStatusFailed) {
+			// The configuration is still associated, disassociate it again.
+			updated.ko.Status.Replacement = nil
+			return updated, ackrequeue.NeededAfter(
+				fmt.Errorf("disassociation update %s failed", aws.ToString(replacement.UpdateID)),
+				ackrequeue.DefaultRequeueAfterDuration,
+			)
+		}
+		return returnIdentityProviderConfigReplacing(updated, replacement)
+	}
+	if !identityProviderActive(latest) {
+		return updated, ackrequeue.NeededAfter(
+			fmt.Errorf("identity provider config is in '%s' state, cannot be replaced", aws.ToString(latest.ko.Status.Status)),
+			ackrequeue.DefaultRequeueAfterDuration,
+		)
+	}
+
+	input, err := rm.newDeleteRequestPayload(latest)
+	if err != nil {
+		return nil, err
+	}
+	identityProviderConfigType := IdentityProviderConfigType
+	input.IdentityProviderConfig = &svcsdktypes.IdentityProviderConfig{
+		Name: latest.ko.Spec.OIDC.IdentityProviderConfigName,
+		Type: &identityProviderConfigType,
+	}
+	resp, err := rm.sdkapi.DisassociateIdentityProviderConfig(ctx, input)
+	rm.metrics.RecordAPICall("DELETE", "DisassociateIdentityProviderConfig", err)
+	if err != nil {
+		return nil, err
+	}
+	rlog.Info("replacing identity provider config", "update_id", aws.ToString(resp.Update.Id))
+	now := metav1.Now()
+	replacement = &svcapitypes.IdentityProviderConfigReplacement{
+		Phase:     aws.String(ReplacementPhaseDisassociating),
+		StartedAt: &now,
+	}
+	setReplacementUpdate(replacement, resp.Update)
+	updated.ko.Status.Replacement = replacement
+	return returnIdentityProviderConfigReplacing(updated, replacement)
+}
+
+// syncReplacement follows the progress of the replacement of the supplied
+// identity provider configuration: the disassociation update is refreshed,
+// and the replacement completes once the new configuration is active. Until
+// then, the resource is marked as not synced.
+func (rm *resourceManager) syncReplacement(
+	ctx context.Context,
+	r *resource,
+) error {
+	replacement := r.ko.Status.Replacement
+	if replacement == nil {
+		return nil
+	}
+	switch aws.ToString(replacement.Phase) {
+	case ReplacementPhaseAssociating:
+		if identityProviderActive(r) {
+			r.ko.Status.Replacement = nil
+			return nil
+		}
+	case ReplacementPhaseDisassociating:
+		if replacement.UpdateID == nil {
+			break
+		}
+		resp, err := rm.sdkapi.DescribeUpdate(ctx, &svcsdk.DescribeUpdateInput{
+			Name:     r.ko.Spec.ClusterName,
+			UpdateId: replacement.UpdateID,
+		})
+		rm.metrics.RecordAPICall("READ_ONE", "DescribeUpdate", err)
+		if err != nil && !isNotFound(err) {
+			return err
+		}
+		if err == nil {
+			setReplacementUpdate(replacement, resp.Update)
+		}
+	}
+	msg := fmt.Sprintf("IdentityProviderConfig replacement in '%s' phase", aws.ToString(replacement.Phase))
+	ackcondition.SetSynced(r, corev1.ConditionFalse, &msg, nil)
+	return nil
+}
+
+// isNotFound returns true if the supplied error is a ResourceNotFoundException
+// returned by EKS.
+func isNotFound(err error) bool {
+	var awsErr smithy.APIError
+	return errors.As(err, &awsErr) && awsErr.ErrorCode() == "ResourceNotFoundException"
+}
+
+// returnIdentityProviderConfigReplacing sets synced to false on the resource
+// and returns a requeue error, to follow the progress of the supplied
+// replacement.
+func returnIdentityProviderConfigReplacing(
+	r *resource,
+	replacement *svcapitypes.IdentityProviderConfigReplacement,
+) (*resource, error) {
+	phase := aws.ToString(replacement.Phase)
+	msg := fmt.Sprintf("IdentityProviderConfig replacement in '%s' phase", phase)
+	ackcondition.SetSynced(r, corev1.ConditionFalse, &msg, nil)
+	return r, requeueWaitWhileReplacing(phase)
+}
@@ -0,0 +1,168 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package identity_provider_config
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	ackv1alpha1 "github.com/aws-controllers-k8s/runtime/apis/core/v1alpha1"
+	ackcondition "github.com/aws-controllers-k8s/runtime/pkg/condition"
+	ackmetrics "github.com/aws-controllers-k8s/runtime/pkg/metrics"
+	ackrequeue "github.com/aws-controllers-k8s/runtime/pkg/requeue"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	svcsdk "github.com/aws/aws-sdk-go-v2/service/eks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+
+	svcapitypes "github.com/aws-controllers-k8s/eks-controller/apis/v1alpha1"
+	"github.com/aws-controllers-k8s/eks-controller/pkg/testutil"
+)
+
+const configsPath = "/clusters/my-cluster/identity-provider-configs"
+
+func newReplacementManager(hc svcsdk.HTTPClient) *resourceManager {
+	return &resourceManager{
+		metrics: ackmetrics.NewMetrics("eks"),
+		sdkapi:  testutil.NewEKSClient(hc),
+	}
+}
+
+func newReplacementConfig(issuerURL string) *resource {
+	arn := ackv1alpha1.AWSResourceName("arn:aws:eks:us-west-2:111122223333:identityproviderconfig/my-cluster/oidc/okta/id")
+	return &resource{ko: &svcapitypes.IdentityProviderConfig{
+		Spec: svcapitypes.IdentityProviderConfigSpec{
+			ClusterName: aws.String("my-cluster"),
+			OIDC: &svcapitypes.OIDCIdentityProviderConfigRequest{
+				ClientID:                   aws.String("kubernetes"),
+				IdentityProviderConfigName: aws.String("okta"),
+				IssuerURL:                  aws.String(issuerURL),
+			},
+			Tags: aws.StringMap(map[string]string{"team": "a"}),
+		},
+		Status: svcapitypes.IdentityProviderConfigStatus{
+			ACKResourceMetadata: &ackv1alpha1.ResourceMetadata{ARN: &arn},
+			Status:              aws.String(StatusActive),
+		},
+	}}
+}
+
+func TestCustomUpdateWithoutReplacementStrategy(t *testing.T) {
+	hc := &testutil.FakeHTTPClient{}
+	rm := newReplacementManager(hc)
+	desired := newReplacementConfig("https://new.example.com")
+	latest := newReplacementConfig("https://old.example.com")
+
+	updated, err := rm.customUpdate(context.TODO(), desired, latest, newResourceDelta(desired, latest))
+	require.NoError(t, err)
+	synced := ackcondition.Synced(updated)
+	require.NotNil(t, synced)
+	assert.Equal(t, corev1.ConditionFalse, synced.Status)
+	assert.Equal(t, UnableToUpdateError, *synced.Message)
+	assert.Empty(t, hc.Requests)
+}
+
+func TestCustomUpdateTags(t *testing.T) {
+	hc := &testutil.FakeHTTPClient{}
+	rm := newReplacementManager(hc)
+	desired := newReplacementConfig("https://old.example.com")
+	desired.ko.Spec.Tags["team"] = aws.String("b")
+	latest := newReplacementConfig("https://old.example.com")
+	hc.Responses = map[string]string{
+		"POST /tags/" + string(*latest.ko.Status.ACKResourceMetadata.ARN): `{}`,
+	}
+
+	updated, err := rm.customUpdate(context.TODO(), desired, latest, newResourceDelta(desired, latest))
+	require.NoError(t, err)
+	assert.Nil(t, ackcondition.Synced(updated))
+	assert.Len(t, hc.Requests, 1)
+	assert.True(t, strings.HasPrefix(hc.Requests[0], "POST /tags/"))
+}
+
+func TestCustomUpdateReplacement(t *testing.T) {
+	desired := newReplacementConfig("https://new.example.com")
+	desired.ko.Spec.ReplacementStrategy = aws.String(svcapitypes.IdentityProviderConfigReplacementStrategyRecreate)
+	latest := newReplacementConfig("https://old.example.com")
+	hc := &testutil.FakeHTTPClient{Responses: map[string]string{
+		"POST " + configsPath + "/disassociate": `{"update": {"id": "u-1", "status": "InProgress"}}`,
+	}}
+	rm := newReplacementManager(hc)
+
+	// The previous configuration is disassociated first.
+	updated, err := rm.customUpdate(context.TODO(), desired, latest, newResourceDelta(desired, latest))
+	var requeueErr *ackrequeue.RequeueNeededAfter
+	require.True(t, errors.As(err, &requeueErr))
+	assert.Equal(t, []string{"POST " + configsPath + "/disassociate"}, hc.Requests)
+	replacement := updated.ko.Status.Replacement
+	require.NotNil(t, replacement)
+	assert.Equal(t, ReplacementPhaseDisassociating, *replacement.Phase)
+	assert.Equal(t, "u-1", *replacement.UpdateID)
+	assert.Equal(t, "InProgress", *replacement.UpdateStatus)
+	assert.Equal(t, corev1.ConditionFalse, ackcondition.Synced(updated).Status)
+
+	// Then the replacement waits for it to go away.
+	hc.Requests = nil
+	latest.ko.Status.Replacement = replacement
+	latest.ko.Status.Status = aws.String(StatusDeleting)
+	_, err = rm.customUpdate(context.TODO(), desired, latest, newResourceDelta(desired, latest))
+	require.True(t, errors.As(err, &requeueErr))
+	assert.Empty(t, hc.Requests)
+
+	// A failed disassociation is retried.
+	replacement.UpdateStatus = aws.String("Failed")
+	latest.ko.Status.Status = aws.String(StatusActive)
+	updated, err = rm.customUpdate(context.TODO(), desired, latest, newResourceDelta(desired, latest))
+	require.True(t, errors.As(err, &requeueErr))
+	assert.Contains(t, err.Error(), "disassociation update u-1 failed")
+	assert.Nil(t, updated.ko.Status.Replacement)
+}
+
+func TestSyncReplacement(t *testing.T) {
+	hc := &testutil.FakeHTTPClient{Responses: map[string]string{
+		"GET /clusters/my-cluster/updates/u-1": `{"update": {"id": "u-1", "status": "Successful"}}`,
+	}}
+	rm := newReplacementManager(hc)
+	r := newReplacementConfig("https://old.example.com")
+	r.ko.Status.Status = aws.String(StatusDeleting)
+	r.ko.Status.Replacement = &svcapitypes.IdentityProviderConfigReplacement{
+		Phase:        aws.String(ReplacementPhaseDisassociating),
+		UpdateID:     aws.String("u-1"),
+		UpdateStatus: aws.String("InProgress"),
+	}
+
+	require.NoError(t, rm.syncReplacement(context.TODO(), r))
+	assert.Equal(t, "Successful", *r.ko.Status.Replacement.UpdateStatus)
+	assert.Equal(t, corev1.ConditionFalse, ackcondition.Synced(r).Status)
+
+	// The new configuration is associated by sdkCreate once the previous one
+	// is gone.
+	hc.Responses["POST "+configsPath+"/associate"] = `{"update": {"id": "u-2", "status": "InProgress"}}`
+	desired := newReplacementConfig("https://new.example.com")
+	desired.ko.Status.Replacement = r.ko.Status.Replacement
+	created, err := rm.sdkCreate(context.TODO(), desired)
+	require.NoError(t, err)
+	assert.Equal(t, ReplacementPhaseAssociating, *created.ko.Status.Replacement.Phase)
+	assert.Equal(t, "u-2", *created.ko.Status.Replacement.UpdateID)
+
+	// The replacement is complete once the new configuration is active.
+	r = created
+	require.NoError(t, rm.syncReplacement(context.TODO(), r))
+	assert.NotNil(t, r.ko.Status.Replacement)
+	r.ko.Status.Status = aws.String(StatusActive)
+	require.NoError(t, rm.syncReplacement(context.TODO(), r))
+	assert.Nil(t, r.ko.Status.Replacement)
+}
@@ -129,6 +129,10 @@ func (rm *resourceManager) sdkFind(
 	rm.setStatusDefaults(ko)
 	if resp.IdentityProviderConfig.Oidc != nil {
 		ko.Spec.Tags = aws.StringMap(resp.IdentityProviderConfig.Oidc.Tags)
+		if resp.IdentityProviderConfig.Oidc.IdentityProviderConfigArn != nil {
+			arn := ackv1alpha1.AWSResourceName(*resp.IdentityProviderConfig.Oidc.IdentityProviderConfigArn)
+			ko.Status.ACKResourceMetadata.ARN = &arn
+		}
 	}
 	temp := string(resp.IdentityProviderConfig.Oidc.Status)
 	ko.Status.Status = &temp
@@ -139,6 +143,9 @@ func (rm *resourceManager) sdkFind(
 	} else {
 		ackcondition.SetSynced(&resource{ko}, corev1.ConditionTrue, nil, nil)
 	}
+	if err := rm.syncReplacement(ctx, &resource{ko}); err != nil {
+		return nil, err
+	}
 
 	return &resource{ko}, nil
 }
@@ -203,6 +210,7 @@ func (rm *resourceManager) sdkCreate(
 	rm.setStatusDefaults(ko)
 	identityProviderStatus := StatusCreating
 	ko.Status.Status = &identityProviderStatus
+	recordReplacementAssociation(&resource{ko}, resp.Update)
 
 	// Setting resource synced condition to false will trigger a requeue of
 	// the resource. No need to return a requeue error here.
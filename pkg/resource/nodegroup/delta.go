@@ -127,6 +127,48 @@ func newResourceDelta(
 			delta.Add("Spec.Name", a.ko.Spec.Name, b.ko.Spec.Name)
 		}
 	}
+	if ackcompare.HasNilDifference(a.ko.Spec.NodeRepairConfig, b.ko.Spec.NodeRepairConfig) {
+		delta.Add("Spec.NodeRepairConfig", a.ko.Spec.NodeRepairConfig, b.ko.Spec.NodeRepairConfig)
+	} else if a.ko.Spec.NodeRepairConfig != nil && b.ko.Spec.NodeRepairConfig != nil {
+		if ackcompare.HasNilDifference(a.ko.Spec.NodeRepairConfig.Enabled, b.ko.Spec.NodeRepairConfig.Enabled) {
+			delta.Add("Spec.NodeRepairConfig.Enabled", a.ko.Spec.NodeRepairConfig.Enabled, b.ko.Spec.NodeRepairConfig.Enabled)
+		} else if a.ko.Spec.NodeRepairConfig.Enabled != nil && b.ko.Spec.NodeRepairConfig.Enabled != nil {
+			if *a.ko.Spec.NodeRepairConfig.Enabled != *b.ko.Spec.NodeRepairConfig.Enabled {
+				delta.Add("Spec.NodeRepairConfig.Enabled", a.ko.Spec.NodeRepairConfig.Enabled, b.ko.Spec.NodeRepairConfig.Enabled)
+			}
+		}
+		if ackcompare.HasNilDifference(a.ko.Spec.NodeRepairConfig.MaxParallelNodesRepairedCount, b.ko.Spec.NodeRepairConfig.MaxParallelNodesRepairedCount) {
+			delta.Add("Spec.NodeRepairConfig.MaxParallelNodesRepairedCount", a.ko.Spec.NodeRepairConfig.MaxParallelNodesRepairedCount, b.ko.Spec.NodeRepairConfig.MaxParallelNodesRepairedCount)
+		} else if a.ko.Spec.NodeRepairConfig.MaxParallelNodesRepairedCount != nil && b.ko.Spec.NodeRepairConfig.MaxParallelNodesRepairedCount != nil {
+			if *a.ko.Spec.NodeRepairConfig.MaxParallelNodesRepairedCount != *b.ko.Spec.NodeRepairConfig.MaxParallelNodesRepairedCount {
+				delta.Add("Spec.NodeRepairConfig.MaxParallelNodesRepairedCount", a.ko.Spec.NodeRepairConfig.MaxParallelNodesRepairedCount, b.ko.Spec.NodeRepairConfig.MaxParallelNodesRepairedCount)
+			}
+		}
+		if ackcompare.HasNilDifference(a.ko.Spec.NodeRepairConfig.MaxParallelNodesRepairedPercentage, b.ko.Spec.NodeRepairConfig.MaxParallelNodesRepairedPercentage) {
+			delta.Add("Spec.NodeRepairConfig.MaxParallelNodesRepairedPercentage", a.ko.Spec.NodeRepairConfig.MaxParallelNodesRepairedPercentage, b.ko.Spec.NodeRepairConfig.MaxParallelNodesRepairedPercentage)
+		} else if a.ko.Spec.NodeRepairConfig.MaxParallelNodesRepairedPercentage != nil && b.ko.Spec.NodeRepairConfig.MaxParallelNodesRepairedPercentage != nil {
+			if *a.ko.Spec.NodeRepairConfig.MaxParallelNodesRepairedPercentage != *b.ko.Spec.NodeRepairConfig.MaxParallelNodesRepairedPercentage {
+				delta.Add("Spec.NodeRepairConfig.MaxParallelNodesRepairedPercentage", a.ko.Spec.NodeRepairConfig.MaxParallelNodesRepairedPercentage, b.ko.Spec.NodeRepairConfig.MaxParallelNodesRepairedPercentage)
+			}
+		}
+		if ackcompare.HasNilDifference(a.ko.Spec.NodeRepairConfig.MaxUnhealthyNodeThresholdCount, b.ko.Spec.NodeRepairConfig.MaxUnhealthyNodeThresholdCount) {
+			delta.Add("Spec.NodeRepairConfig.MaxUnhealthyNodeThresholdCount", a.ko.Spec.NodeRepairConfig.MaxUnhealthyNodeThresholdCount, b.ko.Spec.NodeRepairConfig.MaxUnhealthyNodeThresholdCount)
+		} else if a.ko.Spec.NodeRepairConfig.MaxUnhealthyNodeThresholdCount != nil && b.ko.Spec.NodeRepairConfig.MaxUnhealthyNodeThresholdCount != nil {
+			if *a.ko.Spec.NodeRepairConfig.MaxUnhealthyNodeThresholdCount != *b.ko.Spec.NodeRepairConfig.MaxUnhealthyNodeThresholdCount {
+				delta.Add("Spec.NodeRepairConfig.MaxUnhealthyNodeThresholdCount", a.ko.Spec.NodeRepairConfig.MaxUnhealthyNodeThresholdCount, b.ko.Spec.NodeRepairConfig.MaxUnhealthyNodeThresholdCount)
+			}
+		}
+		if ackcompare.HasNilDifference(a.ko.Spec.NodeRepairConfig.MaxUnhealthyNodeThresholdPercentage, b.ko.Spec.NodeRepairConfig.MaxUnhealthyNodeThresholdPercentage) {
+			delta.Add("Spec.NodeRepairConfig.MaxUnhealthyNodeThresholdPercentage", a.ko.Spec.NodeRepairConfig.MaxUnhealthyNodeThresholdPercentage, b.ko.Spec.NodeRepairConfig.MaxUnhealthyNodeThresholdPercentage)
+		} else if a.ko.Spec.NodeRepairConfig.MaxUnhealthyNodeThresholdPercentage != nil && b.ko.Spec.NodeRepairConfig.MaxUnhealthyNodeThresholdPercentage != nil {
+			if *a.ko.Spec.NodeRepairConfig.MaxUnhealthyNodeThresholdPercentage != *b.ko.Spec.NodeRepairConfig.MaxUnhealthyNodeThresholdPercentage {
+				delta.Add("Spec.NodeRepairConfig.MaxUnhealthyNodeThresholdPercentage", a.ko.Spec.NodeRepairConfig.MaxUnhealthyNodeThresholdPercentage, b.ko.Spec.NodeRepairConfig.MaxUnhealthyNodeThresholdPercentage)
+			}
+		}
+		if !equality.Semantic.Equalities.DeepEqual(a.ko.Spec.NodeRepairConfig.NodeRepairConfigOverrides, b.ko.Spec.NodeRepairConfig.NodeRepairConfigOverrides) {
+			delta.Add("Spec.NodeRepairConfig.NodeRepairConfigOverrides", a.ko.Spec.NodeRepairConfig.NodeRepairConfigOverrides, b.ko.Spec.NodeRepairConfig.NodeRepairConfigOverrides)
+		}
+	}
 	if ackcompare.HasNilDifference(a.ko.Spec.NodeRole, b.ko.Spec.NodeRole) {
 		delta.Add("Spec.NodeRole", a.ko.Spec.NodeRole, b.ko.Spec.NodeRole)
 	} else if a.ko.Spec.NodeRole != nil && b.ko.Spec.NodeRole != nil {
@@ -213,6 +255,45 @@ func newResourceDelta(
 			}
 		}
 	}
+	if ackcompare.HasNilDifference(a.ko.Spec.WarmPoolConfig, b.ko.Spec.WarmPoolConfig) {
+		delta.Add("Spec.WarmPoolConfig", a.ko.Spec.WarmPoolConfig, b.ko.Spec.WarmPoolConfig)
+	} else if a.ko.Spec.WarmPoolConfig != nil && b.ko.Spec.WarmPoolConfig != nil {
+		if ackcompare.HasNilDifference(a.ko.Spec.WarmPoolConfig.Enabled, b.ko.Spec.WarmPoolConfig.Enabled) {
+			delta.Add("Spec.WarmPoolConfig.Enabled", a.ko.Spec.WarmPoolConfig.Enabled, b.ko.Spec.WarmPoolConfig.Enabled)
+		} else if a.ko.Spec.WarmPoolConfig.Enabled != nil && b.ko.Spec.WarmPoolConfig.Enabled != nil {
+			if *a.ko.Spec.WarmPoolConfig.Enabled != *b.ko.Spec.WarmPoolConfig.Enabled {
+				delta.Add("Spec.WarmPoolConfig.Enabled", a.ko.Spec.WarmPoolConfig.Enabled, b.ko.Spec.WarmPoolConfig.Enabled)
+			}
+		}
+		if ackcompare.HasNilDifference(a.ko.Spec.WarmPoolConfig.MaxGroupPreparedCapacity, b.ko.Spec.WarmPoolConfig.MaxGroupPreparedCapacity) {
+			delta.Add("Spec.WarmPoolConfig.MaxGroupPreparedCapacity", a.ko.Spec.WarmPoolConfig.MaxGroupPreparedCapacity, b.ko.Spec.WarmPoolConfig.MaxGroupPreparedCapacity)
+		} else if a.ko.Spec.WarmPoolConfig.MaxGroupPreparedCapacity != nil && b.ko.Spec.WarmPoolConfig.MaxGroupPreparedCapacity != nil {
+			if *a.ko.Spec.WarmPoolConfig.MaxGroupPreparedCapacity != *b.ko.Spec.WarmPoolConfig.MaxGroupPreparedCapacity {
+				delta.Add("Spec.WarmPoolConfig.MaxGroupPreparedCapacity", a.ko.Spec.WarmPoolConfig.MaxGroupPreparedCapacity, b.ko.Spec.WarmPoolConfig.MaxGroupPreparedCapacity)
+			}
+		}
+		if ackcompare.HasNilDifference(a.ko.Spec.WarmPoolConfig.MinSize, b.ko.Spec.WarmPoolConfig.MinSize) {
+			delta.Add("Spec.WarmPoolConfig.MinSize", a.ko.Spec.WarmPoolConfig.MinSize, b.ko.Spec.WarmPoolConfig.MinSize)
+		} else if a.ko.Spec.WarmPoolConfig.MinSize != nil && b.ko.Spec.WarmPoolConfig.MinSize != nil {
+			if *a.ko.Spec.WarmPoolConfig.MinSize != *b.ko.Spec.WarmPoolConfig.MinSize {
+				delta.Add("Spec.WarmPoolConfig.MinSize", a.ko.Spec.WarmPoolConfig.MinSize, b.ko.Spec.WarmPoolConfig.MinSize)
+			}
+		}
+		if ackcompare.HasNilDifference(a.ko.Spec.WarmPoolConfig.PoolState, b.ko.Spec.WarmPoolConfig.PoolState) {
+			delta.Add("Spec.WarmPoolConfig.PoolState", a.ko.Spec.WarmPoolConfig.PoolState, b.ko.Spec.WarmPoolConfig.PoolState)
+		} else if a.ko.Spec.WarmPoolConfig.PoolState != nil && b.ko.Spec.WarmPoolConfig.PoolState != nil {
+			if *a.ko.Spec.WarmPoolConfig.PoolState != *b.ko.Spec.WarmPoolConfig.PoolState {
+				delta.Add("Spec.WarmPoolConfig.PoolState", a.ko.Spec.WarmPoolConfig.PoolState, b.ko.Spec.WarmPoolConfig.PoolState)
+			}
+		}
+		if ackcompare.HasNilDifference(a.ko.Spec.WarmPoolConfig.ReuseOnScaleIn, b.ko.Spec.WarmPoolConfig.ReuseOnScaleIn) {
+			delta.Add("Spec.WarmPoolConfig.ReuseOnScaleIn", a.ko.Spec.WarmPoolConfig.ReuseOnScaleIn, b.ko.Spec.WarmPoolConfig.ReuseOnScaleIn)
+		} else if a.ko.Spec.WarmPoolConfig.ReuseOnScaleIn != nil && b.ko.Spec.WarmPoolConfig.ReuseOnScaleIn != nil {
+			if *a.ko.Spec.WarmPoolConfig.ReuseOnScaleIn != *b.ko.Spec.WarmPoolConfig.ReuseOnScaleIn {
+				delta.Add("Spec.WarmPoolConfig.ReuseOnScaleIn", a.ko.Spec.WarmPoolConfig.ReuseOnScaleIn, b.ko.Spec.WarmPoolConfig.ReuseOnScaleIn)
+			}
+		}
+	}
 
 	customPostCompare(delta, a, b)
 	return delta
@@ -0,0 +1,106 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package nodegroup
+
+import (
+	"context"
+
+	ackcompare "github.com/aws-controllers-k8s/runtime/pkg/compare"
+	ackrtlog "github.com/aws-controllers-k8s/runtime/pkg/runtime/log"
+	"github.com/aws/aws-sdk-go-v2/aws"
+
+	"github.com/aws-controllers-k8s/eks-controller/pkg/dryrun"
+)
+
+// clearDryRunPlan removes the plan of a previous dry-run update from the
+// supplied nodegroup.
+func clearDryRunPlan(r *resource) {
+	dryrun.Reset(r, &r.ko.Status.DryRunPlan)
+}
+
+// planUpdate computes the calls customUpdate would make to bring the latest
+// nodegroup to the desired state, and publishes them in the status of the
+// returned resource instead of making them.
+//
+// The configuration and version updates are both planned, although
+// customUpdate only starts the version update once the configuration update
+// is complete. Updates held outside of the maintenance window are planned
+// too.
+func (rm *resourceManager) planUpdate(
+	ctx context.Context,
+	desired *resource,
+	latest *resource,
+	delta *ackcompare.Delta,
+) (updated *resource, err error) {
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.planUpdate")
+	defer func() { exit(err) }()
+
+	updatedRes := rm.concreteResource(desired.DeepCopy())
+	updatedRes.SetStatus(latest)
+
+	plan := dryrun.NewPlan()
+	// The update calls are made through a copy of the manager whose
+	// mutating calls are recorded in the plan instead of being sent.
+	dry := *rm
+	dry.sdkapi = plan.Client(rm.sdkapi)
+	mr := plan.Metrics(rm.metrics)
+	if delta.DifferentAt("Spec.Tags") {
+		err := dryrun.SyncTags(
+			ctx, dry.sdkapi, mr,
+			string(*latest.ko.Status.ACKResourceMetadata.ARN),
+			aws.ToStringMap(desired.ko.Spec.Tags), aws.ToStringMap(latest.ko.Spec.Tags),
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// A replacement creates a node group from the desired spec, the latest
+	// node group is not updated.
+	if changes := replacementFieldChanges(delta); len(changes) > 0 {
+		if err := validateReplacement(desired, changes); err != nil {
+			return nil, err
+		}
+		if _, err := dry.createReplacementNodegroup(ctx, desired); err != nil && !dryrun.IsNotSent(err) {
+			return nil, err
+		}
+		plan.Publish(updatedRes, &updatedRes.ko.Status.DryRunPlan)
+		return updatedRes, nil
+	}
+
+	if delta.DifferentAt("Spec.Labels") || delta.DifferentAt("Spec.Taints") ||
+		delta.DifferentAt("Spec.UpdateConfig") || delta.DifferentAt("Spec.NodeRepairConfig") ||
+		delta.DifferentAt("Spec.WarmPoolConfig") || delta.DifferentAt("Spec.ScalingConfig") {
+		if err := dry.updateConfig(ctx, delta, desired, latest, true); err != nil && !dryrun.IsNotSent(err) {
+			return nil, err
+		}
+	}
+
+	if delta.DifferentAt("Spec.Version") || delta.DifferentAt("Spec.ReleaseVersion") || delta.DifferentAt("Spec.LaunchTemplate") {
+		if err := validateVersionUpdate(desired); err != nil {
+			return nil, err
+		}
+		// Version updates of custom AMI nodegroups are only sent for launch
+		// template changes.
+		if !isAMITypeCustom(desired) || delta.DifferentAt("Spec.LaunchTemplate") {
+			if err := dry.updateVersion(ctx, delta, withResolvedLaunchTemplateVersion(desired, latest)); err != nil && !dryrun.IsNotSent(err) {
+				return nil, err
+			}
+		}
+	}
+
+	plan.Publish(updatedRes, &updatedRes.ko.Status.DryRunPlan)
+	return updatedRes, nil
+}
@@ -0,0 +1,142 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package nodegroup
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	ackv1alpha1 "github.com/aws-controllers-k8s/runtime/apis/core/v1alpha1"
+	ackcondition "github.com/aws-controllers-k8s/runtime/pkg/condition"
+	ackmetrics "github.com/aws-controllers-k8s/runtime/pkg/metrics"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	svcapitypes "github.com/aws-controllers-k8s/eks-controller/apis/v1alpha1"
+	"github.com/aws-controllers-k8s/eks-controller/pkg/testutil"
+)
+
+// offlineHTTPClient fails every request, so that a test notices any call
+// actually sent to EKS.
+type offlineHTTPClient struct {
+	requests int
+}
+
+func (c *offlineHTTPClient) Do(*http.Request) (*http.Response, error) {
+	c.requests++
+	return nil, errors.New("no network in tests")
+}
+
+func TestPlanUpdate(t *testing.T) {
+	hc := &offlineHTTPClient{}
+	rm := &resourceManager{
+		metrics: ackmetrics.NewMetrics("eks"),
+		sdkapi:  testutil.NewEKSClient(hc),
+	}
+	arn := ackv1alpha1.AWSResourceName("arn:aws:eks:us-west-2:111122223333:nodegroup/my-cluster/my-nodegroup/id")
+	newNodegroup := func(version string, maxSize int64, labels map[string]*string, tags map[string]*string) *resource {
+		return &resource{ko: &svcapitypes.Nodegroup{
+			ObjectMeta: metav1.ObjectMeta{
+				Annotations: map[string]string{svcapitypes.DryRunAnnotation: "true"},
+			},
+			Spec: svcapitypes.NodegroupSpec{
+				Name:          aws.String("my-nodegroup"),
+				ClusterName:   aws.String("my-cluster"),
+				Version:       aws.String(version),
+				Labels:        labels,
+				Tags:          tags,
+				ScalingConfig: &svcapitypes.NodegroupScalingConfig{MinSize: aws.Int64(1), MaxSize: aws.Int64(maxSize), DesiredSize: aws.Int64(1)},
+			},
+			Status: svcapitypes.NodegroupStatus{
+				ACKResourceMetadata: &ackv1alpha1.ResourceMetadata{ARN: &arn},
+				Status:              aws.String(StatusActive),
+			},
+		}}
+	}
+	latest := newNodegroup("1.29", 3,
+		map[string]*string{"tier": aws.String("web"), "old": aws.String("x")},
+		map[string]*string{"team": aws.String("a")},
+	)
+	desired := newNodegroup("1.30", 5,
+		map[string]*string{"tier": aws.String("web")},
+		map[string]*string{"team": aws.String("b")},
+	)
+	delta := newResourceDelta(desired, latest)
+
+	updated, err := rm.customUpdate(context.TODO(), desired, latest, delta)
+	require.NoError(t, err)
+	assert.Equal(t, 0, hc.requests)
+	require.Len(t, updated.ko.Status.DryRunPlan, 3)
+	assert.Equal(t, `TagResource {"ResourceArn":"`+string(arn)+`","Tags":{"team":"b"}}`, *updated.ko.Status.DryRunPlan[0])
+	assert.Equal(t,
+		`UpdateNodegroupConfig {"ClusterName":"my-cluster","Labels":{"AddOrUpdateLabels":{"tier":"web"},"RemoveLabels":["old"]},`+
+			`"NodegroupName":"my-nodegroup","ScalingConfig":{"DesiredSize":1,"MaxSize":5,"MinSize":1}}`,
+		*updated.ko.Status.DryRunPlan[1],
+	)
+	assert.Equal(t,
+		`UpdateNodegroupVersion {"ClusterName":"my-cluster","Force":false,"NodegroupName":"my-nodegroup","Version":"1.30"}`,
+		*updated.ko.Status.DryRunPlan[2],
+	)
+
+	cond := ackcondition.FirstOfType(updated, svcapitypes.ConditionTypeDryRun)
+	require.NotNil(t, cond)
+	assert.Equal(t, corev1.ConditionTrue, cond.Status)
+	assert.Equal(t, corev1.ConditionFalse, ackcondition.Synced(updated).Status)
+
+	clearDryRunPlan(updated)
+	assert.Nil(t, updated.ko.Status.DryRunPlan)
+	assert.Nil(t, ackcondition.FirstOfType(updated, svcapitypes.ConditionTypeDryRun))
+}
+
+func TestPlanUpdateWarmPoolConfig(t *testing.T) {
+	hc := &offlineHTTPClient{}
+	rm := &resourceManager{
+		metrics: ackmetrics.NewMetrics("eks"),
+		sdkapi:  testutil.NewEKSClient(hc),
+	}
+	newNodegroup := func(warmPool *svcapitypes.WarmPoolConfig) *resource {
+		return &resource{ko: &svcapitypes.Nodegroup{
+			ObjectMeta: metav1.ObjectMeta{
+				Annotations: map[string]string{svcapitypes.DryRunAnnotation: "true"},
+			},
+			Spec: svcapitypes.NodegroupSpec{
+				Name:           aws.String("my-nodegroup"),
+				ClusterName:    aws.String("my-cluster"),
+				WarmPoolConfig: warmPool,
+			},
+			Status: svcapitypes.NodegroupStatus{
+				Status: aws.String(StatusActive),
+			},
+		}}
+	}
+	latest := newNodegroup(&svcapitypes.WarmPoolConfig{Enabled: aws.Bool(true), PoolState: aws.String("STOPPED")})
+	desired := newNodegroup(&svcapitypes.WarmPoolConfig{Enabled: aws.Bool(true), PoolState: aws.String("RUNNING")})
+	delta := newResourceDelta(desired, latest)
+	require.True(t, delta.DifferentAt("Spec.WarmPoolConfig.PoolState"))
+
+	updated, err := rm.customUpdate(context.TODO(), desired, latest, delta)
+	require.NoError(t, err)
+	assert.Equal(t, 0, hc.requests)
+	require.Len(t, updated.ko.Status.DryRunPlan, 1)
+	assert.Equal(t,
+		`UpdateNodegroupConfig {"ClusterName":"my-cluster","NodegroupName":"my-nodegroup",`+
+			`"WarmPoolConfig":{"Enabled":true,"PoolState":"RUNNING"}}`,
+		*updated.ko.Status.DryRunPlan[0],
+	)
+}
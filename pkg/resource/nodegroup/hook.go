@@ -15,8 +15,10 @@ package nodegroup
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"reflect"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
@@ -33,6 +35,8 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	svcapitypes "github.com/aws-controllers-k8s/eks-controller/apis/v1alpha1"
+	"github.com/aws-controllers-k8s/eks-controller/pkg/dryrun"
+	"github.com/aws-controllers-k8s/eks-controller/pkg/maintenance"
 	"github.com/aws-controllers-k8s/eks-controller/pkg/tags"
 	"github.com/aws-controllers-k8s/eks-controller/pkg/util"
 )
@@ -146,25 +150,16 @@ func customPostCompare(
 	a *resource,
 	b *resource,
 ) {
-	// We only want to compare the desiredSize field if and only if the
-	// desiredSize is managed by the controller, meaning that in the case
-	// where the desiredSize is managed by an external entity, we do not
-	// want to compare the desiredSize field.
-	// When managed by an external entity, an annotation is set on the
-	// nodegroup resource to indicate that the desiredSize is managed
-	// externally.
-	if isManagedByExternalAutoscaler(a.ko) && delta.DifferentAt("Spec.ScalingConfig.DesiredSize") {
-		// We need to unset the desiredSize field in the delta so that the
-		// controller does not attempt to reconcile the desiredSize of the
-		// nodegroup.
-		newDiffs := make([]*ackcompare.Difference, 0)
-		for _, d := range delta.Differences {
-			if !d.Path.Contains("Spec.ScalingConfig.DesiredSize") {
-				newDiffs = append(newDiffs, d)
-			}
-		}
-		delta.Differences = newDiffs
-	}
+	// We only want to compare the labels, taints and scaling fields owned by
+	// the controller, meaning that in the case where they are managed by an
+	// external entity, such as the desiredSize with an external autoscaler,
+	// we do not want to compare them.
+	// The ownership is set with annotations on the nodegroup resource.
+	// The scaling configuration compared is the one of the active scaling
+	// schedule, if any.
+	compareScalingSchedules(delta, a, b)
+	compareOwnedFields(delta, a, b)
+	compareLaunchTemplateVersion(delta, a, b)
 }
 
 // requeueWaitUntilCanModify returns a `ackrequeue.RequeueNeededAfter` struct
@@ -249,6 +244,28 @@ func returnNodegroupUpdating(r *resource) (*resource, error) {
 	return r, requeueAfterAsyncUpdate()
 }
 
+// maintenanceOperation returns the description of the disruptive change in
+// the supplied delta, held outside of the maintenance window of the
+// nodegroup, or an empty string if the delta has none.
+func maintenanceOperation(delta *ackcompare.Delta) string {
+	if delta.DifferentAt("Spec.ScalingConfig") {
+		return "Nodegroup scaling change"
+	}
+	if delta.DifferentAt("Spec.Version") || delta.DifferentAt("Spec.ReleaseVersion") || delta.DifferentAt("Spec.LaunchTemplate") {
+		return "Nodegroup version update"
+	}
+	return ""
+}
+
+// syncMaintenanceWindowCondition removes the WaitingForMaintenanceWindow
+// condition of the latest nodegroup when no disruptive change is left to
+// reconcile it with the desired nodegroup.
+func syncMaintenanceWindowCondition(desired, latest *resource) {
+	if maintenanceOperation(newResourceDelta(desired, latest)) == "" {
+		maintenance.Clear(latest)
+	}
+}
+
 func (rm *resourceManager) customUpdate(
 	ctx context.Context,
 	desired *resource,
@@ -259,6 +276,34 @@ func (rm *resourceManager) customUpdate(
 	exit := rlog.Trace("rm.customUpdate")
 	defer exit(err)
 
+	// The scaling configuration in effect is reconciled in place of the one
+	// of the spec while a scaling schedule is active. The spec is left as is.
+	// Once the nodegroup is updated, it is requeued at the next activation or
+	// end of a scaling schedule.
+	var scheduleBoundary time.Time
+	if len(desired.ko.Spec.ScalingSchedules) > 0 {
+		scalingConfig := desired.ko.Spec.ScalingConfig
+		defer func() {
+			if updated == nil {
+				return
+			}
+			updated.ko.Spec.ScalingConfig = scalingConfig.DeepCopy()
+			updated.ko.Status.NextScalingScheduleEvaluation = nil
+			if err == nil && !scheduleBoundary.IsZero() {
+				updated.ko.Status.NextScalingScheduleEvaluation = aws.String(scheduleBoundary.Format(time.RFC3339))
+				err = requeueAtScalingScheduleBoundary(scheduleBoundary)
+			}
+		}()
+		desired, scheduleBoundary, err = applyScalingSchedules(desired, latest)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if dryrun.Enabled(desired.ko) {
+		return rm.planUpdate(ctx, desired, latest, delta)
+	}
+
 	if delta.DifferentAt("Spec.Tags") {
 		err := tags.SyncTags(
 			ctx, rm.sdkapi, rm.metrics,
@@ -280,6 +325,9 @@ func (rm *resourceManager) customUpdate(
 	// obtain correct spec fields and then copy the status from latest.
 	updatedRes := rm.concreteResource(desired.DeepCopy())
 	updatedRes.SetStatus(latest)
+	if changes := replacementFieldChanges(delta); len(changes) > 0 || replacementInProgress(latest) {
+		return rm.replaceNodegroup(ctx, desired, latest, updatedRes, changes)
+	}
 	if nodegroupDeleting(latest) {
 		msg := "Nodegroup is currently being deleted"
 		ackcondition.SetSynced(updatedRes, corev1.ConditionFalse, &msg, nil)
@@ -295,13 +343,32 @@ func (rm *resourceManager) customUpdate(
 		return updatedRes, requeueWaitUntilCanModify(latest)
 	}
 
+	// Scaling changes and version or launch template rollouts replace or
+	// drain nodes, they are held outside of the maintenance window of the
+	// nodegroup. The other changes are applied right away.
+	var windowErr error
+	if operation := maintenanceOperation(delta); operation != "" {
+		windowErr = maintenance.Check(ctx, desired.ko, updatedRes, operation)
+	}
+	var requeueErr *ackrequeue.RequeueNeededAfter
+	if windowErr != nil && !errors.As(windowErr, &requeueErr) {
+		return nil, windowErr
+	}
+
 	if delta.DifferentAt("Spec.Labels") || delta.DifferentAt("Spec.Taints") ||
-		delta.DifferentAt("Spec.ScalingConfig") || delta.DifferentAt("Spec.UpdateConfig") {
-		if err := rm.updateConfig(ctx, delta, desired, latest); err != nil {
+		delta.DifferentAt("Spec.UpdateConfig") || delta.DifferentAt("Spec.NodeRepairConfig") ||
+		delta.DifferentAt("Spec.WarmPoolConfig") ||
+		(delta.DifferentAt("Spec.ScalingConfig") && windowErr == nil) {
+		if err := rm.updateConfig(ctx, delta, desired, latest, windowErr == nil); err != nil {
 			return nil, err
 		}
 		return returnNodegroupUpdating(updatedRes)
 	}
+	if windowErr != nil {
+		msg := "Nodegroup update held until the maintenance window opens"
+		ackcondition.SetSynced(updatedRes, corev1.ConditionFalse, &msg, nil)
+		return updatedRes, windowErr
+	}
 
 	// At the stage we know that at least one of Version, ReleaseVersion or
 	// LaunchTemplate has changed. The API does not allow using LaunchTemplate
@@ -325,31 +392,8 @@ func (rm *resourceManager) customUpdate(
 		// The first case is not applicable here as it's counterintuitive in a declarative
 		// model to not provide a desired state and have the controller trigger a blind update.
 
-		// We need to set a terminal condition if the user provides both a version and release version
-		// and they do not match. This is needed because the controller could potentially start alternating
-		// between the non-matching version and release version in the spec and the observed state.
-		if desired.ko.Spec.Version != nil && desired.ko.Spec.ReleaseVersion != nil &&
-			*desired.ko.Spec.Version != "" && *desired.ko.Spec.ReleaseVersion != "" {
-
-			if !isAMITypeBottlerocket(desired.ko.Spec.AMIType) && !isAMITypeCustom(desired) {
-				// First parse the user provided release version and desired release
-				desiredReleaseVersionTrimmed, err := util.GetEKSVersionFromReleaseVersion(*desired.ko.Spec.ReleaseVersion)
-				if err != nil {
-					return nil, ackerr.NewTerminalError(err)
-				}
-
-				// Set a terminal condition if the release version and version do not match.
-				// e.g if the user provides a release version of 1.16.8-20211201 and a version of 1.17
-				// They will either need to provide one of the following:
-				// 2. A version
-				// 1. A release version
-				// 3. A version and release version that matches (e.g 1.16 and 1.16.8-20211201)
-				if desiredReleaseVersionTrimmed != *desired.ko.Spec.Version {
-					return nil, ackerr.NewTerminalError(
-						fmt.Errorf("version and release version do not match: %s and %s", *desired.ko.Spec.Version, desiredReleaseVersionTrimmed),
-					)
-				}
-			}
+		if err := validateVersionUpdate(desired); err != nil {
+			return nil, err
 		}
 
 		// Check if this is a custom AMI with LaunchTemplate scenario
@@ -365,7 +409,7 @@ func (rm *resourceManager) customUpdate(
 			}
 		}
 
-		if err := rm.updateVersion(ctx, delta, desired); err != nil {
+		if err := rm.updateVersion(ctx, delta, withResolvedLaunchTemplateVersion(desired, latest)); err != nil {
 			return nil, err
 		}
 		return returnNodegroupUpdating(updatedRes)
@@ -375,6 +419,39 @@ func (rm *resourceManager) customUpdate(
 	return updatedRes, nil
 }
 
+// validateVersionUpdate returns a terminal error if the desired version and
+// release version of a nodegroup cannot be sent together to
+// UpdateNodegroupVersion.
+func validateVersionUpdate(desired *resource) error {
+	// We need to set a terminal condition if the user provides both a version and release version
+	// and they do not match. This is needed because the controller could potentially start alternating
+	// between the non-matching version and release version in the spec and the observed state.
+	if desired.ko.Spec.Version != nil && desired.ko.Spec.ReleaseVersion != nil &&
+		*desired.ko.Spec.Version != "" && *desired.ko.Spec.ReleaseVersion != "" {
+
+		if !isAMITypeBottlerocket(desired.ko.Spec.AMIType) && !isAMITypeCustom(desired) {
+			// First parse the user provided release version and desired release
+			desiredReleaseVersionTrimmed, err := util.GetEKSVersionFromReleaseVersion(*desired.ko.Spec.ReleaseVersion)
+			if err != nil {
+				return ackerr.NewTerminalError(err)
+			}
+
+			// Set a terminal condition if the release version and version do not match.
+			// e.g if the user provides a release version of 1.16.8-20211201 and a version of 1.17
+			// They will either need to provide one of the following:
+			// 2. A version
+			// 1. A release version
+			// 3. A version and release version that matches (e.g 1.16 and 1.16.8-20211201)
+			if desiredReleaseVersionTrimmed != *desired.ko.Spec.Version {
+				return ackerr.NewTerminalError(
+					fmt.Errorf("version and release version do not match: %s and %s", *desired.ko.Spec.Version, desiredReleaseVersionTrimmed),
+				)
+			}
+		}
+	}
+	return nil
+}
+
 // Bottlerocket AMI types do not follow the same versioning scheme as other AMI types.
 // For more information, see https://github.com/awslabs/amazon-eks-ami/releases
 // and https://github.com/bottlerocket-os/bottlerocket/releases
@@ -416,17 +493,20 @@ func newUpdateLabelsPayload(
 	desired *resource,
 	latest *resource,
 ) *svcsdktypes.UpdateLabelsPayload {
+	// The labels not owned by the controller are left as is.
+	owned := ownedLabelKeys(desired.ko)
 	payload := svcsdktypes.UpdateLabelsPayload{
-		AddOrUpdateLabels: aws.ToStringMap(desired.ko.Spec.Labels),
+		AddOrUpdateLabels: aws.ToStringMap(ownedLabels(desired.ko.Spec.Labels, owned)),
 		RemoveLabels:      make([]string, 0),
 	}
 
-	for latestKey := range latest.ko.Spec.Labels {
+	for latestKey := range ownedLabels(latest.ko.Spec.Labels, owned) {
 		if _, isDesired := desired.ko.Spec.Labels[latestKey]; !isDesired {
 			toRemove := latestKey
 			payload.RemoveLabels = append(payload.RemoveLabels, toRemove)
 		}
 	}
+	sort.Strings(payload.RemoveLabels)
 
 	// Payload must have at least one update
 	if len(payload.AddOrUpdateLabels) == 0 && len(payload.RemoveLabels) == 0 {
@@ -460,18 +540,21 @@ func newUpdateTaintsPayload(
 	desired *resource,
 	latest *resource,
 ) *svcsdktypes.UpdateTaintsPayload {
+	// The taints not owned by the controller are left as is.
+	owned := ownedTaintKeys(desired.ko)
+	desiredTaints := ownedTaints(desired.ko.Spec.Taints, owned)
 	payload := svcsdktypes.UpdateTaintsPayload{
-		AddOrUpdateTaints: make([]svcsdktypes.Taint, len(desired.ko.Spec.Taints)),
+		AddOrUpdateTaints: make([]svcsdktypes.Taint, len(desiredTaints)),
 		RemoveTaints:      make([]svcsdktypes.Taint, 0),
 	}
 
 	// Add all the desired and existing taints
-	for i, t := range desired.ko.Spec.Taints {
+	for i, t := range desiredTaints {
 		payload.AddOrUpdateTaints[i] = newTaint(*t)
 	}
 
 	// Check for existing taints that are not desired
-	for _, inLatest := range latest.ko.Spec.Taints {
+	for _, inLatest := range ownedTaints(latest.ko.Spec.Taints, owned) {
 		exists := false
 		for _, inDesired := range desired.ko.Spec.Taints {
 			if *inDesired.Key != *inLatest.Key {
@@ -500,7 +583,7 @@ func newUpdateNodegroupVersionPayload(
 	desired *resource,
 ) *svcsdk.UpdateNodegroupVersionInput {
 	input := &svcsdk.UpdateNodegroupVersionInput{
-		NodegroupName: desired.ko.Spec.Name,
+		NodegroupName: nodegroupName(desired),
 		ClusterName:   desired.ko.Spec.ClusterName,
 	}
 
@@ -606,6 +689,19 @@ func (rm *resourceManager) newUpdateScalingConfigPayload(
 	if err != nil {
 		return nil, err
 	}
+	// The fields not owned by the controller are sent with their observed
+	// value.
+	if observed := latest.ko.Spec.ScalingConfig; observed != nil {
+		if !ownsScalingField(desired.ko, ScalingFieldMinSize) && observed.MinSize != nil {
+			sc.MinSize = aws.Int32(int32(*observed.MinSize))
+		}
+		if !ownsScalingField(desired.ko, ScalingFieldMaxSize) && observed.MaxSize != nil {
+			sc.MaxSize = aws.Int32(int32(*observed.MaxSize))
+		}
+		if !ownsScalingField(desired.ko, ScalingFieldDesiredSize) && observed.DesiredSize != nil {
+			sc.DesiredSize = aws.Int32(int32(*observed.DesiredSize))
+		}
+	}
 	// We need to default the desiredSize to the current observed
 	// value in the case where the desiredSize is managed externally.
 	isManagedExternally := isManagedByExternalAutoscaler(desired.ko)
@@ -622,29 +718,38 @@ func (rm *resourceManager) newUpdateScalingConfigPayload(
 			"ack_desired_size", desired.ko.Spec.ScalingConfig.DesiredSize,
 		)
 		temp := int32(*latest.ko.Spec.ScalingConfig.DesiredSize)
+		// A scaling schedule may have moved the bounds past the observed
+		// desired size.
+		if len(desired.ko.Spec.ScalingSchedules) > 0 {
+			temp = min(max(temp, aws.ToInt32(sc.MinSize)), aws.ToInt32(sc.MaxSize))
+		}
 		sc.DesiredSize = &temp
 	}
 	return sc, nil
 }
 
+// updateConfig updates the labels, taints, update, node repair and warm pool
+// configuration of the nodegroup, along with its scaling configuration when
+// withScaling is true.
 func (rm *resourceManager) updateConfig(
 	ctx context.Context,
 	delta *ackcompare.Delta,
 	desired *resource,
 	latest *resource,
+	withScaling bool,
 ) (err error) {
 	rlog := ackrtlog.FromContext(ctx)
 	exit := rlog.Trace("rm.updateConfig")
 	defer exit(err)
 
 	input := &svcsdk.UpdateNodegroupConfigInput{
-		NodegroupName: desired.ko.Spec.Name,
+		NodegroupName: nodegroupName(desired),
 		ClusterName:   desired.ko.Spec.ClusterName,
 		Labels:        newUpdateLabelsPayload(desired, latest),
 		Taints:        newUpdateTaintsPayload(desired, latest),
 	}
 
-	if desired.ko.Spec.ScalingConfig != nil {
+	if withScaling && desired.ko.Spec.ScalingConfig != nil {
 		input.ScalingConfig, err = rm.newUpdateScalingConfigPayload(desired, latest)
 		if err != nil {
 			return err
@@ -658,6 +763,20 @@ func (rm *resourceManager) updateConfig(
 		}
 	}
 
+	if desired.ko.Spec.NodeRepairConfig != nil {
+		input.NodeRepairConfig, err = rm.newNodeRepairConfig(desired)
+		if err != nil {
+			return err
+		}
+	}
+
+	if desired.ko.Spec.WarmPoolConfig != nil {
+		input.WarmPoolConfig, err = rm.newWarmPoolConfig(desired)
+		if err != nil {
+			return err
+		}
+	}
+
 	_, err = rm.sdkapi.UpdateNodegroupConfig(ctx, input)
 	rm.metrics.RecordAPICall("UPDATE", "UpdateNodegroupConfig", err)
 	if err != nil {
@@ -20,15 +20,18 @@ import (
 	"testing"
 
 	ackcompare "github.com/aws-controllers-k8s/runtime/pkg/compare"
+	ackcondition "github.com/aws-controllers-k8s/runtime/pkg/condition"
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/eks"
 	svcsdktypes "github.com/aws/aws-sdk-go-v2/service/eks/types"
 	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/log/zap"
 
 	"github.com/aws-controllers-k8s/eks-controller/apis/v1alpha1"
 	svcapitypes "github.com/aws-controllers-k8s/eks-controller/apis/v1alpha1"
+	"github.com/aws-controllers-k8s/eks-controller/pkg/condition"
 )
 
 func TestTaints(t *testing.T) {
@@ -785,3 +788,202 @@ func Test_AMITypeBottlerocket(t *testing.T) {
 		})
 	}
 }
+
+func newNodeRepairConfigNodegroup(repair *v1alpha1.NodeRepairConfig) *resource {
+	return &resource{ko: &v1alpha1.Nodegroup{
+		Spec: v1alpha1.NodegroupSpec{
+			Name:             aws.String("my-nodegroup"),
+			ClusterName:      aws.String("my-cluster"),
+			NodeRepairConfig: repair,
+		},
+	}}
+}
+
+func Test_newNodeRepairConfig(t *testing.T) {
+	rm := &resourceManager{}
+	got, err := rm.newNodeRepairConfig(newNodeRepairConfigNodegroup(&v1alpha1.NodeRepairConfig{
+		Enabled:                       aws.Bool(true),
+		MaxParallelNodesRepairedCount: aws.Int64(2),
+		NodeRepairConfigOverrides: []*v1alpha1.NodeRepairConfigOverrides{{
+			MinRepairWaitTimeMins:   aws.Int64(10),
+			NodeMonitoringCondition: aws.String("AcceleratedHardwareReady"),
+			NodeUnhealthyReason:     aws.String("NvidiaXID13Error"),
+			RepairAction:            aws.String("Reboot"),
+		}},
+	}))
+	assert.NoError(t, err)
+	assert.Equal(t, &svcsdktypes.NodeRepairConfig{
+		Enabled:                       aws.Bool(true),
+		MaxParallelNodesRepairedCount: aws.Int32(2),
+		NodeRepairConfigOverrides: []svcsdktypes.NodeRepairConfigOverrides{{
+			MinRepairWaitTimeMins:   aws.Int32(10),
+			NodeMonitoringCondition: aws.String("AcceleratedHardwareReady"),
+			NodeUnhealthyReason:     aws.String("NvidiaXID13Error"),
+			RepairAction:            svcsdktypes.RepairActionReboot,
+		}},
+	}, got)
+
+	_, err = rm.newNodeRepairConfig(newNodeRepairConfigNodegroup(&v1alpha1.NodeRepairConfig{
+		MaxUnhealthyNodeThresholdCount: aws.Int64(math.MaxInt32 + 1),
+	}))
+	assert.Error(t, err)
+}
+
+func Test_lateInitializeNodeRepairConfig(t *testing.T) {
+	rm := &resourceManager{}
+	observed := newNodeRepairConfigNodegroup(&v1alpha1.NodeRepairConfig{
+		Enabled:                            aws.Bool(true),
+		MaxParallelNodesRepairedPercentage: aws.Int64(10),
+		MaxUnhealthyNodeThresholdCount:     aws.Int64(5),
+	})
+
+	tests := []struct {
+		name    string
+		desired *v1alpha1.NodeRepairConfig
+		want    *v1alpha1.NodeRepairConfig
+	}{
+		{
+			name: "unset configuration is initialized from the server",
+			want: observed.ko.Spec.NodeRepairConfig,
+		},
+		{
+			name:    "unset fields are initialized from the server",
+			desired: &v1alpha1.NodeRepairConfig{Enabled: aws.Bool(true), MaxUnhealthyNodeThresholdCount: aws.Int64(3)},
+			want: &v1alpha1.NodeRepairConfig{
+				Enabled:                            aws.Bool(true),
+				MaxParallelNodesRepairedPercentage: aws.Int64(10),
+				MaxUnhealthyNodeThresholdCount:     aws.Int64(3),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			latest := newNodeRepairConfigNodegroup(tt.desired)
+			got := rm.lateInitializeFromReadOneOutput(observed, latest).(*resource)
+			assert.Equal(t, tt.want, got.ko.Spec.NodeRepairConfig)
+			assert.False(t, newResourceDelta(got, observed).DifferentAt("Spec.NodeRepairConfig.Enabled"))
+		})
+	}
+}
+
+func Test_compareNodeRepairConfig(t *testing.T) {
+	a := newNodeRepairConfigNodegroup(&v1alpha1.NodeRepairConfig{
+		Enabled: aws.Bool(true),
+		NodeRepairConfigOverrides: []*v1alpha1.NodeRepairConfigOverrides{{
+			NodeMonitoringCondition: aws.String("KernelReady"),
+			RepairAction:            aws.String("Replace"),
+		}},
+	})
+	b := newNodeRepairConfigNodegroup(a.ko.Spec.NodeRepairConfig.DeepCopy())
+	assert.False(t, newResourceDelta(a, b).DifferentAt("Spec.NodeRepairConfig"))
+
+	b.ko.Spec.NodeRepairConfig.NodeRepairConfigOverrides[0].RepairAction = aws.String("Reboot")
+	assert.True(t, newResourceDelta(a, b).DifferentAt("Spec.NodeRepairConfig.NodeRepairConfigOverrides"))
+
+	b.ko.Spec.NodeRepairConfig = nil
+	assert.True(t, newResourceDelta(a, b).DifferentAt("Spec.NodeRepairConfig"))
+}
+
+func Test_newWarmPoolConfig(t *testing.T) {
+	rm := &resourceManager{}
+	r := newNodeRepairConfigNodegroup(nil)
+	r.ko.Spec.WarmPoolConfig = &v1alpha1.WarmPoolConfig{
+		Enabled:        aws.Bool(true),
+		MinSize:        aws.Int64(2),
+		PoolState:      aws.String("HIBERNATED"),
+		ReuseOnScaleIn: aws.Bool(true),
+	}
+	got, err := rm.newWarmPoolConfig(r)
+	assert.NoError(t, err)
+	assert.Equal(t, &svcsdktypes.WarmPoolConfig{
+		Enabled:        aws.Bool(true),
+		MinSize:        aws.Int32(2),
+		PoolState:      svcsdktypes.WarmPoolStateHibernated,
+		ReuseOnScaleIn: aws.Bool(true),
+	}, got)
+
+	r.ko.Spec.WarmPoolConfig.MaxGroupPreparedCapacity = aws.Int64(math.MaxInt32 + 1)
+	_, err = rm.newWarmPoolConfig(r)
+	assert.Error(t, err)
+}
+
+func Test_lateInitializeWarmPoolConfig(t *testing.T) {
+	rm := &resourceManager{}
+	observed := newNodeRepairConfigNodegroup(nil)
+	observed.ko.Spec.WarmPoolConfig = &v1alpha1.WarmPoolConfig{
+		Enabled:        aws.Bool(true),
+		MinSize:        aws.Int64(0),
+		PoolState:      aws.String("STOPPED"),
+		ReuseOnScaleIn: aws.Bool(false),
+	}
+	latest := newNodeRepairConfigNodegroup(nil)
+	latest.ko.Spec.WarmPoolConfig = &v1alpha1.WarmPoolConfig{
+		Enabled: aws.Bool(true),
+		MinSize: aws.Int64(1),
+	}
+
+	got := rm.lateInitializeFromReadOneOutput(observed, latest).(*resource)
+	assert.Equal(t, &v1alpha1.WarmPoolConfig{
+		Enabled:        aws.Bool(true),
+		MinSize:        aws.Int64(1),
+		PoolState:      aws.String("STOPPED"),
+		ReuseOnScaleIn: aws.Bool(false),
+	}, got.ko.Spec.WarmPoolConfig)
+
+	delta := newResourceDelta(got, observed)
+	assert.True(t, delta.DifferentAt("Spec.WarmPoolConfig.MinSize"))
+	assert.False(t, delta.DifferentAt("Spec.WarmPoolConfig.PoolState"))
+}
+
+func TestSyncMaintenanceWindowCondition(t *testing.T) {
+	tests := []struct {
+		name        string
+		desired     func(*v1alpha1.Nodegroup)
+		wantCleared bool
+	}{
+		{
+			name:        "no change",
+			desired:     func(*v1alpha1.Nodegroup) {},
+			wantCleared: true,
+		},
+		{
+			name: "non-disruptive change",
+			desired: func(ng *v1alpha1.Nodegroup) {
+				ng.Spec.Labels = map[string]*string{"team": aws.String("a")}
+			},
+			wantCleared: true,
+		},
+		{
+			name: "version update",
+			desired: func(ng *v1alpha1.Nodegroup) {
+				ng.Spec.Version = aws.String("1.31")
+			},
+			wantCleared: false,
+		},
+		{
+			name: "scaling change",
+			desired: func(ng *v1alpha1.Nodegroup) {
+				ng.Spec.ScalingConfig.MaxSize = aws.Int64(5)
+			},
+			wantCleared: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			latest := &resource{ko: &v1alpha1.Nodegroup{}}
+			latest.ko.Spec.Version = aws.String("1.30")
+			latest.ko.Spec.ScalingConfig = &v1alpha1.NodegroupScalingConfig{
+				MinSize: aws.Int64(1),
+				MaxSize: aws.Int64(3),
+			}
+			msg := "Nodegroup version update held until the maintenance window opens"
+			condition.Set(latest, v1alpha1.ConditionTypeWaitingForMaintenanceWindow, corev1.ConditionTrue, &msg, nil)
+			desired := &resource{ko: latest.ko.DeepCopy()}
+			tt.desired(desired.ko)
+
+			syncMaintenanceWindowCondition(desired, latest)
+			cond := ackcondition.FirstOfType(latest, v1alpha1.ConditionTypeWaitingForMaintenanceWindow)
+			assert.Equal(t, tt.wantCleared, cond == nil)
+		})
+	}
+}
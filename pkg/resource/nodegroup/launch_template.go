@@ -0,0 +1,151 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package nodegroup
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"sync"
+
+	ackcompare "github.com/aws-controllers-k8s/runtime/pkg/compare"
+	ackrtlog "github.com/aws-controllers-k8s/runtime/pkg/runtime/log"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	svcec2 "github.com/aws/aws-sdk-go-v2/service/ec2"
+
+	svcapitypes "github.com/aws-controllers-k8s/eks-controller/apis/v1alpha1"
+)
+
+// The symbolic launch template versions EKS accepts.
+const (
+	LaunchTemplateVersionLatest  = "$Latest"
+	LaunchTemplateVersionDefault = "$Default"
+)
+
+// ec2Clients caches the EC2 client of each resource manager. The resource
+// managers are cached by the manager factory for the lifetime of the
+// controller, and so are their EC2 clients.
+var ec2Clients sync.Map
+
+// ec2Client returns the EC2 client used to resolve the versions of launch
+// templates, built from the client configuration of the resource manager on
+// first use.
+func (rm *resourceManager) ec2Client() *svcec2.Client {
+	if c, ok := ec2Clients.Load(rm); ok {
+		return c.(*svcec2.Client)
+	}
+	c, _ := ec2Clients.LoadOrStore(rm, svcec2.NewFromConfig(rm.clientcfg))
+	return c.(*svcec2.Client)
+}
+
+// hasSymbolicLaunchTemplateVersion returns true if the launch template version
+// of the nodegroup is omitted, $Latest or $Default, which EKS resolves to a
+// version number when the nodegroup is created or updated.
+func hasSymbolicLaunchTemplateVersion(r *resource) bool {
+	lt := r.ko.Spec.LaunchTemplate
+	if lt == nil {
+		return false
+	}
+	version := aws.ToString(lt.Version)
+	return version == "" || version == LaunchTemplateVersionLatest || version == LaunchTemplateVersionDefault
+}
+
+// resolveLaunchTemplateVersionEnabled returns false if the resolution of the
+// launch template version is turned off with the
+// ResolveLaunchTemplateVersionAnnotation annotation.
+func resolveLaunchTemplateVersionEnabled(r *resource) bool {
+	value, ok := r.ko.Annotations[svcapitypes.ResolveLaunchTemplateVersionAnnotation]
+	if !ok {
+		return svcapitypes.DefaultResolveLaunchTemplateVersion
+	}
+	enabled, err := strconv.ParseBool(value)
+	if err != nil {
+		return svcapitypes.DefaultResolveLaunchTemplateVersion
+	}
+	return enabled
+}
+
+// syncResolvedLaunchTemplateVersion sets the version number the launch
+// template version of the desired nodegroup resolves to in the status of the
+// observed one.
+func (rm *resourceManager) syncResolvedLaunchTemplateVersion(
+	ctx context.Context,
+	desired *resource,
+	observed *resource,
+) (err error) {
+	observed.ko.Status.ResolvedLaunchTemplateVersion = nil
+	if !hasSymbolicLaunchTemplateVersion(desired) || !resolveLaunchTemplateVersionEnabled(desired) {
+		return nil
+	}
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("rm.syncResolvedLaunchTemplateVersion")
+	defer func() { exit(err) }()
+
+	lt := desired.ko.Spec.LaunchTemplate
+	version := aws.ToString(lt.Version)
+	if version == "" {
+		version = LaunchTemplateVersionDefault
+	}
+	input := &svcec2.DescribeLaunchTemplateVersionsInput{
+		Versions: []string{version},
+	}
+	if lt.ID != nil {
+		input.LaunchTemplateId = lt.ID
+	} else {
+		input.LaunchTemplateName = lt.Name
+	}
+	resp, err := rm.ec2Client().DescribeLaunchTemplateVersions(ctx, input)
+	rm.metrics.RecordAPICall("READ_ONE", "DescribeLaunchTemplateVersions", err)
+	if err != nil {
+		return err
+	}
+	if len(resp.LaunchTemplateVersions) == 0 || resp.LaunchTemplateVersions[0].VersionNumber == nil {
+		return fmt.Errorf("launch template version %s not found", version)
+	}
+	resolved := strconv.FormatInt(*resp.LaunchTemplateVersions[0].VersionNumber, 10)
+	observed.ko.Status.ResolvedLaunchTemplateVersion = &resolved
+	return nil
+}
+
+// compareLaunchTemplateVersion compares the omitted, $Latest or $Default
+// launch template version of a with the version b runs through the version it
+// resolves to. Without resolution, they are never different.
+func compareLaunchTemplateVersion(
+	delta *ackcompare.Delta,
+	a *resource,
+	b *resource,
+) {
+	if !hasSymbolicLaunchTemplateVersion(a) || b.ko.Spec.LaunchTemplate == nil {
+		return
+	}
+	removeDifferences(delta, "Spec.LaunchTemplate.Version")
+	resolved := b.ko.Status.ResolvedLaunchTemplateVersion
+	if resolved != nil && *resolved != aws.ToString(b.ko.Spec.LaunchTemplate.Version) {
+		delta.Add("Spec.LaunchTemplate.Version", a.ko.Spec.LaunchTemplate.Version, b.ko.Spec.LaunchTemplate.Version)
+	}
+}
+
+// withResolvedLaunchTemplateVersion returns a copy of the desired nodegroup
+// with the omitted, $Latest or $Default launch template version replaced by
+// the version it resolves to, so that the nodegroup is rolled out to the
+// version reported in the status.
+func withResolvedLaunchTemplateVersion(desired *resource, latest *resource) *resource {
+	resolved := latest.ko.Status.ResolvedLaunchTemplateVersion
+	if !hasSymbolicLaunchTemplateVersion(desired) || resolved == nil {
+		return desired
+	}
+	res := &resource{desired.ko.DeepCopy()}
+	res.ko.Spec.LaunchTemplate.Version = aws.String(*resolved)
+	return res
+}
@@ -0,0 +1,145 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package nodegroup
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	svcapitypes "github.com/aws-controllers-k8s/eks-controller/apis/v1alpha1"
+	"github.com/aws-controllers-k8s/eks-controller/pkg/testutil"
+)
+
+const describeLaunchTemplateVersionsResponse = `<DescribeLaunchTemplateVersionsResponse xmlns="http://ec2.amazonaws.com/doc/2016-11-15/">
+    <launchTemplateVersionSet>
+        <item>
+            <launchTemplateId>lt-1</launchTemplateId>
+            <versionNumber>7</versionNumber>
+        </item>
+    </launchTemplateVersionSet>
+</DescribeLaunchTemplateVersionsResponse>`
+
+func newLaunchTemplateNodegroup(version *string) *resource {
+	r := newReplacementNodegroup("m5.large", 2)
+	r.ko.Spec.LaunchTemplate = &svcapitypes.LaunchTemplateSpecification{
+		ID:      aws.String("lt-1"),
+		Version: version,
+	}
+	return r
+}
+
+func TestHasSymbolicLaunchTemplateVersion(t *testing.T) {
+	assert.False(t, hasSymbolicLaunchTemplateVersion(newReplacementNodegroup("m5.large", 2)))
+	assert.True(t, hasSymbolicLaunchTemplateVersion(newLaunchTemplateNodegroup(nil)))
+	assert.True(t, hasSymbolicLaunchTemplateVersion(newLaunchTemplateNodegroup(aws.String("$Latest"))))
+	assert.True(t, hasSymbolicLaunchTemplateVersion(newLaunchTemplateNodegroup(aws.String("$Default"))))
+	assert.False(t, hasSymbolicLaunchTemplateVersion(newLaunchTemplateNodegroup(aws.String("3"))))
+}
+
+func TestSyncResolvedLaunchTemplateVersion(t *testing.T) {
+	hc := &testutil.FakeHTTPClient{Responses: map[string]string{
+		"POST /": describeLaunchTemplateVersionsResponse,
+	}}
+	rm := newReplacementManager(hc)
+	rm.clientcfg = testutil.NewClientConfig(hc)
+	desired := newLaunchTemplateNodegroup(aws.String("$Latest"))
+	observed := newLaunchTemplateNodegroup(aws.String("6"))
+
+	require.NoError(t, rm.syncResolvedLaunchTemplateVersion(context.TODO(), desired, observed))
+	assert.Equal(t, "7", *observed.ko.Status.ResolvedLaunchTemplateVersion)
+	assert.Equal(t, []string{"POST /"}, hc.Requests)
+
+	// Pinned versions are not resolved.
+	hc.Requests = nil
+	desired.ko.Spec.LaunchTemplate.Version = aws.String("6")
+	require.NoError(t, rm.syncResolvedLaunchTemplateVersion(context.TODO(), desired, observed))
+	assert.Nil(t, observed.ko.Status.ResolvedLaunchTemplateVersion)
+	assert.Empty(t, hc.Requests)
+
+	// Nor are symbolic versions when the resolution is turned off.
+	desired.ko.Spec.LaunchTemplate.Version = aws.String("$Latest")
+	desired.ko.SetAnnotations(map[string]string{svcapitypes.ResolveLaunchTemplateVersionAnnotation: "false"})
+	require.NoError(t, rm.syncResolvedLaunchTemplateVersion(context.TODO(), desired, observed))
+	assert.Nil(t, observed.ko.Status.ResolvedLaunchTemplateVersion)
+	assert.Empty(t, hc.Requests)
+}
+
+func TestCompareLaunchTemplateVersion(t *testing.T) {
+	tests := []struct {
+		name     string
+		desired  *string
+		observed string
+		resolved *string
+		wantDiff bool
+	}{
+		{
+			name:     "resolved version rolled out",
+			desired:  aws.String("$Latest"),
+			observed: "7",
+			resolved: aws.String("7"),
+		},
+		{
+			name:     "newer resolved version",
+			desired:  aws.String("$Latest"),
+			observed: "6",
+			resolved: aws.String("7"),
+			wantDiff: true,
+		},
+		{
+			name:     "omitted version",
+			observed: "6",
+			resolved: aws.String("7"),
+			wantDiff: true,
+		},
+		{
+			name:     "resolution turned off",
+			desired:  aws.String("$Default"),
+			observed: "6",
+		},
+		{
+			name:     "pinned version",
+			desired:  aws.String("7"),
+			observed: "6",
+			wantDiff: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			desired := newLaunchTemplateNodegroup(tt.desired)
+			latest := newLaunchTemplateNodegroup(aws.String(tt.observed))
+			latest.ko.Status.ResolvedLaunchTemplateVersion = tt.resolved
+			delta := newResourceDelta(desired, latest)
+			assert.Equal(t, tt.wantDiff, delta.DifferentAt("Spec.LaunchTemplate.Version"))
+		})
+	}
+}
+
+func TestWithResolvedLaunchTemplateVersion(t *testing.T) {
+	desired := newLaunchTemplateNodegroup(aws.String("$Latest"))
+	latest := newLaunchTemplateNodegroup(aws.String("6"))
+	assert.Same(t, desired, withResolvedLaunchTemplateVersion(desired, latest))
+
+	latest.ko.Status.ResolvedLaunchTemplateVersion = aws.String("7")
+	got := withResolvedLaunchTemplateVersion(desired, latest)
+	assert.Equal(t, "7", *got.ko.Spec.LaunchTemplate.Version)
+	assert.Equal(t, "$Latest", *desired.ko.Spec.LaunchTemplate.Version)
+
+	payload := newUpdateNodegroupVersionPayload(newResourceDelta(desired, latest), got)
+	require.NotNil(t, payload.LaunchTemplate)
+	assert.Equal(t, "7", *payload.LaunchTemplate.Version)
+}
@@ -50,7 +50,7 @@ var (
 // +kubebuilder:rbac:groups=eks.services.k8s.aws,resources=nodegroups,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=eks.services.k8s.aws,resources=nodegroups/status,verbs=get;update;patch
 
-var lateInitializeFieldNames = []string{}
+var lateInitializeFieldNames = []string{"NodeRepairConfig", "NodeRepairConfig.Enabled", "NodeRepairConfig.MaxParallelNodesRepairedCount", "NodeRepairConfig.MaxParallelNodesRepairedPercentage", "NodeRepairConfig.MaxUnhealthyNodeThresholdCount", "NodeRepairConfig.MaxUnhealthyNodeThresholdPercentage", "WarmPoolConfig", "WarmPoolConfig.MaxGroupPreparedCapacity", "WarmPoolConfig.MinSize", "WarmPoolConfig.PoolState", "WarmPoolConfig.ReuseOnScaleIn"}
 
 // resourceManager is responsible for providing a consistent way to perform
 // CRUD operations in a backend AWS service API for Book custom resources.
@@ -260,7 +260,60 @@ func (rm *resourceManager) lateInitializeFromReadOneOutput(
 	observed acktypes.AWSResource,
 	latest acktypes.AWSResource,
 ) acktypes.AWSResource {
-	return latest
+	observedKo := rm.concreteResource(observed).ko.DeepCopy()
+	latestKo := rm.concreteResource(latest).ko.DeepCopy()
+	if observedKo.Spec.NodeRepairConfig != nil && latestKo.Spec.NodeRepairConfig == nil {
+		latestKo.Spec.NodeRepairConfig = observedKo.Spec.NodeRepairConfig
+	}
+	if observedKo.Spec.NodeRepairConfig != nil && latestKo.Spec.NodeRepairConfig != nil {
+		if observedKo.Spec.NodeRepairConfig.Enabled != nil && latestKo.Spec.NodeRepairConfig.Enabled == nil {
+			latestKo.Spec.NodeRepairConfig.Enabled = observedKo.Spec.NodeRepairConfig.Enabled
+		}
+	}
+	if observedKo.Spec.NodeRepairConfig != nil && latestKo.Spec.NodeRepairConfig != nil {
+		if observedKo.Spec.NodeRepairConfig.MaxParallelNodesRepairedCount != nil && latestKo.Spec.NodeRepairConfig.MaxParallelNodesRepairedCount == nil {
+			latestKo.Spec.NodeRepairConfig.MaxParallelNodesRepairedCount = observedKo.Spec.NodeRepairConfig.MaxParallelNodesRepairedCount
+		}
+	}
+	if observedKo.Spec.NodeRepairConfig != nil && latestKo.Spec.NodeRepairConfig != nil {
+		if observedKo.Spec.NodeRepairConfig.MaxParallelNodesRepairedPercentage != nil && latestKo.Spec.NodeRepairConfig.MaxParallelNodesRepairedPercentage == nil {
+			latestKo.Spec.NodeRepairConfig.MaxParallelNodesRepairedPercentage = observedKo.Spec.NodeRepairConfig.MaxParallelNodesRepairedPercentage
+		}
+	}
+	if observedKo.Spec.NodeRepairConfig != nil && latestKo.Spec.NodeRepairConfig != nil {
+		if observedKo.Spec.NodeRepairConfig.MaxUnhealthyNodeThresholdCount != nil && latestKo.Spec.NodeRepairConfig.MaxUnhealthyNodeThresholdCount == nil {
+			latestKo.Spec.NodeRepairConfig.MaxUnhealthyNodeThresholdCount = observedKo.Spec.NodeRepairConfig.MaxUnhealthyNodeThresholdCount
+		}
+	}
+	if observedKo.Spec.NodeRepairConfig != nil && latestKo.Spec.NodeRepairConfig != nil {
+		if observedKo.Spec.NodeRepairConfig.MaxUnhealthyNodeThresholdPercentage != nil && latestKo.Spec.NodeRepairConfig.MaxUnhealthyNodeThresholdPercentage == nil {
+			latestKo.Spec.NodeRepairConfig.MaxUnhealthyNodeThresholdPercentage = observedKo.Spec.NodeRepairConfig.MaxUnhealthyNodeThresholdPercentage
+		}
+	}
+	if observedKo.Spec.WarmPoolConfig != nil && latestKo.Spec.WarmPoolConfig == nil {
+		latestKo.Spec.WarmPoolConfig = observedKo.Spec.WarmPoolConfig
+	}
+	if observedKo.Spec.WarmPoolConfig != nil && latestKo.Spec.WarmPoolConfig != nil {
+		if observedKo.Spec.WarmPoolConfig.MaxGroupPreparedCapacity != nil && latestKo.Spec.WarmPoolConfig.MaxGroupPreparedCapacity == nil {
+			latestKo.Spec.WarmPoolConfig.MaxGroupPreparedCapacity = observedKo.Spec.WarmPoolConfig.MaxGroupPreparedCapacity
+		}
+	}
+	if observedKo.Spec.WarmPoolConfig != nil && latestKo.Spec.WarmPoolConfig != nil {
+		if observedKo.Spec.WarmPoolConfig.MinSize != nil && latestKo.Spec.WarmPoolConfig.MinSize == nil {
+			latestKo.Spec.WarmPoolConfig.MinSize = observedKo.Spec.WarmPoolConfig.MinSize
+		}
+	}
+	if observedKo.Spec.WarmPoolConfig != nil && latestKo.Spec.WarmPoolConfig != nil {
+		if observedKo.Spec.WarmPoolConfig.PoolState != nil && latestKo.Spec.WarmPoolConfig.PoolState == nil {
+			latestKo.Spec.WarmPoolConfig.PoolState = observedKo.Spec.WarmPoolConfig.PoolState
+		}
+	}
+	if observedKo.Spec.WarmPoolConfig != nil && latestKo.Spec.WarmPoolConfig != nil {
+		if observedKo.Spec.WarmPoolConfig.ReuseOnScaleIn != nil && latestKo.Spec.WarmPoolConfig.ReuseOnScaleIn == nil {
+			latestKo.Spec.WarmPoolConfig.ReuseOnScaleIn = observedKo.Spec.WarmPoolConfig.ReuseOnScaleIn
+		}
+	}
+	return &resource{latestKo}
 }
 
 // IsSynced returns true if the resource is synced.
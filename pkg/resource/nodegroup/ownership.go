@@ -0,0 +1,174 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package nodegroup
+
+import (
+	"reflect"
+	"strings"
+
+	ackcompare "github.com/aws-controllers-k8s/runtime/pkg/compare"
+	"github.com/aws/aws-sdk-go-v2/aws"
+
+	svcapitypes "github.com/aws-controllers-k8s/eks-controller/apis/v1alpha1"
+)
+
+// The fields of the scaling configuration listed in the
+// OwnedScalingFieldsAnnotation annotation.
+const (
+	ScalingFieldMinSize     = "minSize"
+	ScalingFieldMaxSize     = "maxSize"
+	ScalingFieldDesiredSize = "desiredSize"
+)
+
+// ownedKeys is the set of keys of a nodegroup owned by the controller. A nil
+// set stands for all the keys.
+type ownedKeys map[string]struct{}
+
+// owns returns true if the controller owns the supplied key.
+func (o ownedKeys) owns(key string) bool {
+	if o == nil {
+		return true
+	}
+	_, ok := o[key]
+	return ok
+}
+
+// ownedKeysFromAnnotation returns the keys listed in the supplied annotation
+// of the nodegroup, or nil if the annotation is not set.
+func ownedKeysFromAnnotation(ko *svcapitypes.Nodegroup, annotation string) ownedKeys {
+	value, ok := ko.Annotations[annotation]
+	if !ok {
+		return nil
+	}
+	keys := ownedKeys{}
+	for _, key := range strings.Split(value, ",") {
+		if key = strings.TrimSpace(key); key != "" {
+			keys[key] = struct{}{}
+		}
+	}
+	return keys
+}
+
+// ownedLabelKeys returns the label keys of the nodegroup owned by the
+// controller.
+func ownedLabelKeys(ko *svcapitypes.Nodegroup) ownedKeys {
+	return ownedKeysFromAnnotation(ko, svcapitypes.OwnedLabelKeysAnnotation)
+}
+
+// ownedTaintKeys returns the taint keys of the nodegroup owned by the
+// controller.
+func ownedTaintKeys(ko *svcapitypes.Nodegroup) ownedKeys {
+	return ownedKeysFromAnnotation(ko, svcapitypes.OwnedTaintKeysAnnotation)
+}
+
+// ownsScalingField returns true if the controller owns the supplied field of
+// the scaling configuration of the nodegroup.
+func ownsScalingField(ko *svcapitypes.Nodegroup, field string) bool {
+	if field == ScalingFieldDesiredSize && isManagedByExternalAutoscaler(ko) {
+		return false
+	}
+	return ownedKeysFromAnnotation(ko, svcapitypes.OwnedScalingFieldsAnnotation).owns(field)
+}
+
+// ownedLabels returns the labels owned by the controller.
+func ownedLabels(labels map[string]*string, owned ownedKeys) map[string]*string {
+	if owned == nil {
+		return labels
+	}
+	res := map[string]*string{}
+	for key, value := range labels {
+		if owned.owns(key) {
+			res[key] = value
+		}
+	}
+	return res
+}
+
+// ownedTaints returns the taints owned by the controller.
+func ownedTaints(taints []*svcapitypes.Taint, owned ownedKeys) []*svcapitypes.Taint {
+	if owned == nil {
+		return taints
+	}
+	res := make([]*svcapitypes.Taint, 0, len(taints))
+	for _, taint := range taints {
+		if owned.owns(aws.ToString(taint.Key)) {
+			res = append(res, taint)
+		}
+	}
+	return res
+}
+
+// equalTaints returns true if the supplied taints are the same, in any order.
+func equalTaints(a, b []*svcapitypes.Taint) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for _, taintA := range a {
+		matched := false
+		for _, taintB := range b {
+			if reflect.DeepEqual(taintA, taintB) {
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			return false
+		}
+	}
+	return true
+}
+
+// removeDifferences removes the differences at the supplied path from the
+// delta.
+func removeDifferences(delta *ackcompare.Delta, path string) {
+	newDiffs := make([]*ackcompare.Difference, 0, len(delta.Differences))
+	for _, d := range delta.Differences {
+		if !d.Path.Contains(path) {
+			newDiffs = append(newDiffs, d)
+		}
+	}
+	delta.Differences = newDiffs
+}
+
+// compareOwnedFields restricts the differences of the labels, taints and
+// scaling configuration of the nodegroups to the ones owned by the controller.
+func compareOwnedFields(
+	delta *ackcompare.Delta,
+	a *resource,
+	b *resource,
+) {
+	if owned := ownedLabelKeys(a.ko); owned != nil && delta.DifferentAt("Spec.Labels") {
+		removeDifferences(delta, "Spec.Labels")
+		labelsA := ownedLabels(a.ko.Spec.Labels, owned)
+		labelsB := ownedLabels(b.ko.Spec.Labels, owned)
+		if !ackcompare.MapStringStringPEqual(labelsA, labelsB) {
+			delta.Add("Spec.Labels", a.ko.Spec.Labels, b.ko.Spec.Labels)
+		}
+	}
+	if owned := ownedTaintKeys(a.ko); owned != nil && delta.DifferentAt("Spec.Taints") {
+		removeDifferences(delta, "Spec.Taints")
+		if !equalTaints(ownedTaints(a.ko.Spec.Taints, owned), ownedTaints(b.ko.Spec.Taints, owned)) {
+			delta.Add("Spec.Taints", a.ko.Spec.Taints, b.ko.Spec.Taints)
+		}
+	}
+	for field, path := range map[string]string{
+		ScalingFieldMinSize:     "Spec.ScalingConfig.MinSize",
+		ScalingFieldMaxSize:     "Spec.ScalingConfig.MaxSize",
+		ScalingFieldDesiredSize: "Spec.ScalingConfig.DesiredSize",
+	} {
+		if !ownsScalingField(a.ko, field) && delta.DifferentAt(path) {
+			removeDifferences(delta, path)
+		}
+	}
+}
@@ -0,0 +1,171 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package nodegroup
+
+import (
+	"io"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	svcsdktypes "github.com/aws/aws-sdk-go-v2/service/eks/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"sigs.k8s.io/controller-runtime/pkg/log/zap"
+
+	svcapitypes "github.com/aws-controllers-k8s/eks-controller/apis/v1alpha1"
+)
+
+func newOwnershipNodegroup(annotations map[string]string, labels map[string]string, taints ...string) *resource {
+	ko := newNodegroupWithScalingConfig(2, 4, 1)
+	ko.SetAnnotations(annotations)
+	ko.Spec.Labels = aws.StringMap(labels)
+	for _, key := range taints {
+		ko.Spec.Taints = append(ko.Spec.Taints, &svcapitypes.Taint{
+			Key: aws.String(key), Value: aws.String("v"), Effect: aws.String("NO_SCHEDULE"),
+		})
+	}
+	return &resource{ko}
+}
+
+func TestOwnedKeysFromAnnotation(t *testing.T) {
+	r := newOwnershipNodegroup(nil, nil)
+	assert.Nil(t, ownedLabelKeys(r.ko))
+	assert.True(t, ownedLabelKeys(r.ko).owns("team"))
+
+	r.ko.SetAnnotations(map[string]string{svcapitypes.OwnedLabelKeysAnnotation: " team, env ,"})
+	owned := ownedLabelKeys(r.ko)
+	assert.Len(t, owned, 2)
+	assert.True(t, owned.owns("team"))
+	assert.True(t, owned.owns("env"))
+	assert.False(t, owned.owns("pool"))
+
+	r.ko.SetAnnotations(map[string]string{svcapitypes.OwnedLabelKeysAnnotation: ""})
+	assert.False(t, ownedLabelKeys(r.ko).owns("team"))
+}
+
+func TestOwnsScalingField(t *testing.T) {
+	r := newOwnershipNodegroup(nil, nil)
+	assert.True(t, ownsScalingField(r.ko, ScalingFieldDesiredSize))
+
+	r.ko.SetAnnotations(map[string]string{
+		svcapitypes.DesiredSizeManagedByAnnotation: svcapitypes.DesiredSizeManagedByExternalAutoscaler,
+	})
+	assert.False(t, ownsScalingField(r.ko, ScalingFieldDesiredSize))
+	assert.True(t, ownsScalingField(r.ko, ScalingFieldMinSize))
+
+	r.ko.SetAnnotations(map[string]string{svcapitypes.OwnedScalingFieldsAnnotation: "desiredSize"})
+	assert.True(t, ownsScalingField(r.ko, ScalingFieldDesiredSize))
+	assert.False(t, ownsScalingField(r.ko, ScalingFieldMinSize))
+	assert.False(t, ownsScalingField(r.ko, ScalingFieldMaxSize))
+}
+
+func TestCompareOwnedFields(t *testing.T) {
+	annotations := map[string]string{
+		svcapitypes.OwnedLabelKeysAnnotation:     "team",
+		svcapitypes.OwnedTaintKeysAnnotation:     "dedicated",
+		svcapitypes.OwnedScalingFieldsAnnotation: "desiredSize",
+	}
+	tests := []struct {
+		name     string
+		desired  *resource
+		latest   *resource
+		wantDiff []string
+	}{
+		{
+			name:    "only unowned fields differ",
+			desired: newOwnershipNodegroup(annotations, map[string]string{"team": "a"}, "dedicated"),
+			latest: func() *resource {
+				r := newOwnershipNodegroup(nil, map[string]string{"team": "a", "pool": "spot"}, "dedicated", "gpu")
+				r.ko.Spec.ScalingConfig = newScalingConfig(2, 10, 0)
+				return r
+			}(),
+		},
+		{
+			name:     "owned label differs",
+			desired:  newOwnershipNodegroup(annotations, map[string]string{"team": "a"}),
+			latest:   newOwnershipNodegroup(nil, map[string]string{"team": "b", "pool": "spot"}),
+			wantDiff: []string{"Spec.Labels"},
+		},
+		{
+			name:     "owned taint missing",
+			desired:  newOwnershipNodegroup(annotations, nil, "dedicated"),
+			latest:   newOwnershipNodegroup(nil, nil, "gpu"),
+			wantDiff: []string{"Spec.Taints"},
+		},
+		{
+			name:    "owned scaling field differs",
+			desired: newOwnershipNodegroup(annotations, nil),
+			latest: func() *resource {
+				r := newOwnershipNodegroup(nil, nil)
+				r.ko.Spec.ScalingConfig = newScalingConfig(3, 10, 0)
+				return r
+			}(),
+			wantDiff: []string{"Spec.ScalingConfig.DesiredSize"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			delta := newResourceDelta(tt.desired, tt.latest)
+			assert.Len(t, delta.Differences, len(tt.wantDiff))
+			for _, path := range tt.wantDiff {
+				assert.True(t, delta.DifferentAt(path), path)
+			}
+		})
+	}
+}
+
+func TestNewUpdateLabelsPayloadOwnedKeys(t *testing.T) {
+	annotations := map[string]string{svcapitypes.OwnedLabelKeysAnnotation: "team,env"}
+	desired := newOwnershipNodegroup(annotations, map[string]string{"team": "a", "pool": "on-demand"})
+	latest := newOwnershipNodegroup(nil, map[string]string{"team": "b", "env": "dev", "pool": "spot"})
+
+	payload := newUpdateLabelsPayload(desired, latest)
+	require.NotNil(t, payload)
+	assert.Equal(t, map[string]string{"team": "a"}, payload.AddOrUpdateLabels)
+	assert.Equal(t, []string{"env"}, payload.RemoveLabels)
+
+	// Unowned labels are never removed.
+	desired = newOwnershipNodegroup(annotations, nil)
+	latest = newOwnershipNodegroup(nil, map[string]string{"pool": "spot"})
+	assert.Nil(t, newUpdateLabelsPayload(desired, latest))
+}
+
+func TestNewUpdateTaintsPayloadOwnedKeys(t *testing.T) {
+	annotations := map[string]string{svcapitypes.OwnedTaintKeysAnnotation: "dedicated,batch"}
+	desired := newOwnershipNodegroup(annotations, nil, "dedicated", "gpu")
+	latest := newOwnershipNodegroup(nil, nil, "batch", "gpu", "spot")
+
+	payload := newUpdateTaintsPayload(desired, latest)
+	require.NotNil(t, payload)
+	require.Len(t, payload.AddOrUpdateTaints, 1)
+	assert.Equal(t, "dedicated", *payload.AddOrUpdateTaints[0].Key)
+	require.Len(t, payload.RemoveTaints, 1)
+	assert.Equal(t, "batch", *payload.RemoveTaints[0].Key)
+}
+
+func TestNewUpdateScalingConfigPayloadOwnedFields(t *testing.T) {
+	rm := resourceManager{
+		log: zap.New(zap.UseFlagOptions(&zap.Options{DestWriter: io.Discard})),
+	}
+	desired := newOwnershipNodegroup(map[string]string{svcapitypes.OwnedScalingFieldsAnnotation: "maxSize"}, nil)
+	desired.ko.Spec.ScalingConfig = newScalingConfig(5, 8, 3)
+	latest := newOwnershipNodegroup(nil, nil)
+	latest.ko.Spec.ScalingConfig = newScalingConfig(2, 4, 1)
+
+	got, err := rm.newUpdateScalingConfigPayload(desired, latest)
+	require.NoError(t, err)
+	assert.Equal(t, &svcsdktypes.NodegroupScalingConfig{
+		DesiredSize: aws.Int32(2), MaxSize: aws.Int32(8), MinSize: aws.Int32(1),
+	}, got)
+}
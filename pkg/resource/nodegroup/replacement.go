@@ -0,0 +1,444 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package nodegroup
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	ackcompare "github.com/aws-controllers-k8s/runtime/pkg/compare"
+	ackcondition "github.com/aws-controllers-k8s/runtime/pkg/condition"
+	ackerr "github.com/aws-controllers-k8s/runtime/pkg/errors"
+	ackrequeue "github.com/aws-controllers-k8s/runtime/pkg/requeue"
+	ackrtlog "github.com/aws-controllers-k8s/runtime/pkg/runtime/log"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	svcsdk "github.com/aws/aws-sdk-go-v2/service/eks"
+	svcsdktypes "github.com/aws/aws-sdk-go-v2/service/eks/types"
+	"github.com/aws/smithy-go"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	svcapitypes "github.com/aws-controllers-k8s/eks-controller/apis/v1alpha1"
+	"github.com/aws-controllers-k8s/eks-controller/pkg/condition"
+)
+
+// The phases of a blue/green replacement of a node group.
+const (
+	// ReplacementPhaseCreating means the new node group is being created, the
+	// replacement waits for it to be active and healthy.
+	ReplacementPhaseCreating = "Creating"
+	// ReplacementPhaseScalingDown means the previous node group is being
+	// scaled down to zero nodes.
+	ReplacementPhaseScalingDown = "ScalingDown"
+	// ReplacementPhaseDeletingPrevious means the resource points at the new
+	// node group and the previous one is being deleted.
+	ReplacementPhaseDeletingPrevious = "DeletingPrevious"
+)
+
+// maxNodegroupNameLength is the maximum length of a node group name.
+const maxNodegroupNameLength = 63
+
+// replacementFields are the fields of a node group EKS cannot update in
+// place. Changing any of them requires a new node group.
+var replacementFields = []string{
+	"Spec.AMIType",
+	"Spec.CapacityType",
+	"Spec.DiskSize",
+	"Spec.InstanceTypes",
+	"Spec.NodeRole",
+	"Spec.RemoteAccess",
+	"Spec.Subnets",
+}
+
+// requeueWaitWhileReplacing returns a `ackrequeue.RequeueNeededAfter`
+// explaining the node group is being replaced.
+func requeueWaitWhileReplacing(phase string) *ackrequeue.RequeueNeededAfter {
+	return ackrequeue.NeededAfter(
+		fmt.Errorf("nodegroup replacement in '%s' phase", phase),
+		RequeueAfterUpdateDuration,
+	)
+}
+
+// nodegroupName returns the name of the node group backing the supplied
+// resource: the name of the node group that replaced the original one, if
+// any, and `spec.name` otherwise.
+func nodegroupName(r *resource) *string {
+	if r.ko.Status.CurrentNodegroupName != nil {
+		return r.ko.Status.CurrentNodegroupName
+	}
+	return r.ko.Spec.Name
+}
+
+// replacementStrategy returns the replacement strategy of the supplied
+// resource. Unknown values fall back to the default strategy.
+func replacementStrategy(r *resource) string {
+	switch strategy := aws.ToString(r.ko.Spec.ReplacementStrategy); strategy {
+	case svcapitypes.NodegroupReplacementStrategyNone,
+		svcapitypes.NodegroupReplacementStrategyBlueGreen:
+		return strategy
+	}
+	return svcapitypes.DefaultNodegroupReplacementStrategy
+}
+
+// replacementInProgress returns true if the supplied resource still points at
+// the node group being replaced.
+func replacementInProgress(r *resource) bool {
+	replacement := r.ko.Status.Replacement
+	return replacement != nil && aws.ToString(replacement.Phase) != ReplacementPhaseDeletingPrevious
+}
+
+// replacementFieldChanges returns the fields EKS cannot update in place that
+// differ in the supplied delta.
+func replacementFieldChanges(delta *ackcompare.Delta) []string {
+	changes := []string{}
+	for _, field := range replacementFields {
+		if delta.DifferentAt(field) {
+			changes = append(changes, field)
+		}
+	}
+	return changes
+}
+
+// replacementNodegroupName returns the name of the node group replacing the
+// one backing the supplied desired resource. The name is `spec.name`
+// suffixed with a hash of the fields EKS cannot update in place, so that it
+// is the same for every attempt to create the same node group.
+func replacementNodegroupName(desired *resource) (string, error) {
+	spec := desired.ko.Spec
+	data, err := json.Marshal([]interface{}{
+		spec.AMIType, spec.CapacityType, spec.DiskSize, spec.InstanceTypes,
+		spec.NodeRole, spec.RemoteAccess, spec.Subnets,
+	})
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(data)
+	suffix := "-" + hex.EncodeToString(sum[:])[:8]
+	base := aws.ToString(spec.Name)
+	if len(base)+len(suffix) > maxNodegroupNameLength {
+		base = base[:maxNodegroupNameLength-len(suffix)]
+	}
+	return base + suffix, nil
+}
+
+// validateReplacement returns a terminal error if the supplied fields EKS
+// cannot update in place changed and the replacement strategy of the supplied
+// desired resource is not BlueGreen.
+func validateReplacement(desired *resource, changes []string) error {
+	if replacementStrategy(desired) == svcapitypes.NodegroupReplacementStrategyBlueGreen {
+		return nil
+	}
+	return ackerr.NewTerminalError(fmt.Errorf(
+		"%s cannot be updated in place, set spec.replacementStrategy to %s to replace the nodegroup",
+		strings.Join(changes, ", "), svcapitypes.NodegroupReplacementStrategyBlueGreen,
+	))
+}
+
+// replaceNodegroup moves the blue/green replacement of the latest node group
+// forward by one step, recording its progress in the status of the returned
+// resource:
+//
+//  1. a node group is created from the desired spec, under a new name,
+//  2. once it is active and healthy, the previous node group is scaled down
+//     to zero nodes,
+//  3. once the previous node group is scaled down, the resource is pointed
+//     at the new node group and the previous one is deleted. The replacement
+//     is complete when it is gone, see syncReplacement.
+//
+// A new node group which failed to create, or was created from a spec that
+// changed since, is deleted and the replacement starts over.
+func (rm *resourceManager) replaceNodegroup(
+	ctx context.Context,
+	desired *resource,
+	latest *resource,
+	updated *resource,
+	changes []string,
+) (*resource, error) {
+	rlog := ackrtlog.FromContext(ctx)
+	replacement := updated.ko.Status.Replacement
+	if replacement == nil {
+		if err := validateReplacement(desired, changes); err != nil {
+			return nil, err
+		}
+		if !nodegroupActive(latest) {
+			return updated, requeueWaitUntilCanModify(latest)
+		}
+		name, err := rm.createReplacementNodegroup(ctx, desired)
+		if err != nil {
+			return nil, err
+		}
+		rlog.Info("replacing nodegroup", "previous", aws.ToString(nodegroupName(latest)), "replacement", name)
+		now := metav1.Now()
+		replacement = &svcapitypes.NodegroupReplacement{
+			NodegroupName:         aws.String(name),
+			Phase:                 aws.String(ReplacementPhaseCreating),
+			PreviousNodegroupName: nodegroupName(latest),
+			StartedAt:             &now,
+		}
+		updated.ko.Status.Replacement = replacement
+		return returnNodegroupReplacing(updated, replacement)
+	}
+
+	switch aws.ToString(replacement.Phase) {
+	case ReplacementPhaseCreating:
+		ng, err := rm.describeNodegroup(ctx, desired.ko.Spec.ClusterName, replacement.NodegroupName)
+		if isNotFound(err) {
+			// The replacement node group is gone, start over.
+			updated.ko.Status.Replacement = nil
+			return updated, requeueWaitWhileReplacing(ReplacementPhaseCreating)
+		}
+		if err != nil {
+			return nil, err
+		}
+		name, err := replacementNodegroupName(desired)
+		if err != nil {
+			return nil, err
+		}
+		stale := name != aws.ToString(replacement.NodegroupName)
+		switch ng.Status {
+		case svcsdktypes.NodegroupStatusCreateFailed:
+			// The failed node group is deleted, a fixed spec gets a new
+			// replacement.
+			if err := rm.deleteReplacementNodegroup(ctx, updated); err != nil {
+				return nil, err
+			}
+			updated.ko.Status.Replacement = nil
+			condition.Remove(updated, svcapitypes.ConditionTypeReplacementUnhealthy)
+			msg := fmt.Sprintf("replacement nodegroup %s failed to create and is deleted", aws.ToString(replacement.NodegroupName))
+			ackcondition.SetTerminal(updated, corev1.ConditionTrue, &msg, nil)
+			return updated, nil
+		case svcsdktypes.NodegroupStatusCreating:
+			return returnNodegroupReplacing(updated, replacement)
+		}
+		if stale {
+			// The desired spec changed while the replacement node group was
+			// created, it is deleted and the replacement starts over.
+			rlog.Info("restarting nodegroup replacement", "replacement", aws.ToString(replacement.NodegroupName))
+			if err := rm.deleteReplacementNodegroup(ctx, updated); err != nil {
+				return nil, err
+			}
+			updated.ko.Status.Replacement = nil
+			condition.Remove(updated, svcapitypes.ConditionTypeReplacementUnhealthy)
+			return updated, requeueWaitWhileReplacing(ReplacementPhaseCreating)
+		}
+		if ng.Status != svcsdktypes.NodegroupStatusActive {
+			return returnNodegroupReplacing(updated, replacement)
+		}
+		if ng.Health != nil && len(ng.Health.Issues) > 0 {
+			setReplacementUnhealthy(updated, replacement, ng.Health.Issues)
+			return returnNodegroupReplacing(updated, replacement)
+		}
+		condition.Remove(updated, svcapitypes.ConditionTypeReplacementUnhealthy)
+		if !nodegroupActive(latest) {
+			return returnNodegroupReplacing(updated, replacement)
+		}
+		if err := rm.scaleDownNodegroup(ctx, latest); err != nil {
+			return nil, err
+		}
+		replacement.Phase = aws.String(ReplacementPhaseScalingDown)
+		return returnNodegroupReplacing(updated, replacement)
+	case ReplacementPhaseScalingDown:
+		scaledDown := latest.ko.Spec.ScalingConfig != nil &&
+			aws.ToInt64(latest.ko.Spec.ScalingConfig.DesiredSize) == 0
+		if !nodegroupActive(latest) || !scaledDown {
+			return returnNodegroupReplacing(updated, replacement)
+		}
+		_, err := rm.sdkapi.DeleteNodegroup(ctx, &svcsdk.DeleteNodegroupInput{
+			ClusterName:   desired.ko.Spec.ClusterName,
+			NodegroupName: replacement.PreviousNodegroupName,
+		})
+		rm.metrics.RecordAPICall("DELETE", "DeleteNodegroup", err)
+		if err != nil && !isNotFound(err) {
+			return nil, err
+		}
+		updated.ko.Status.CurrentNodegroupName = replacement.NodegroupName
+		replacement.Phase = aws.String(ReplacementPhaseDeletingPrevious)
+		return returnNodegroupReplacing(updated, replacement)
+	}
+	return returnNodegroupReplacing(updated, replacement)
+}
+
+// setReplacementUnhealthy sets the ReplacementUnhealthy condition on the
+// supplied resource, reporting the supplied health issues of its replacement
+// node group.
+func setReplacementUnhealthy(
+	r *resource,
+	replacement *svcapitypes.NodegroupReplacement,
+	issues []svcsdktypes.Issue,
+) {
+	reason := string(issues[0].Code)
+	if len(issues) > 1 {
+		reason = "MultipleIssues"
+	}
+	details := make([]string, 0, len(issues))
+	for _, issue := range issues {
+		details = append(details, fmt.Sprintf("%s: %s", issue.Code, aws.ToString(issue.Message)))
+	}
+	msg := fmt.Sprintf(
+		"replacement nodegroup %s is unhealthy: %s",
+		aws.ToString(replacement.NodegroupName), strings.Join(details, "; "),
+	)
+	condition.Set(r, svcapitypes.ConditionTypeReplacementUnhealthy, corev1.ConditionTrue, &msg, &reason)
+}
+
+// createReplacementNodegroup creates the node group replacing the one backing
+// the supplied desired resource and returns its name. A node group already
+// created by a previous attempt is reused.
+func (rm *resourceManager) createReplacementNodegroup(
+	ctx context.Context,
+	desired *resource,
+) (string, error) {
+	name, err := replacementNodegroupName(desired)
+	if err != nil {
+		return "", err
+	}
+	input, err := rm.newCreateRequestPayload(ctx, desired)
+	if err != nil {
+		return "", err
+	}
+	input.NodegroupName = aws.String(name)
+	// The token identifies the creation of the original node group.
+	input.ClientRequestToken = nil
+	_, err = rm.sdkapi.CreateNodegroup(ctx, input)
+	rm.metrics.RecordAPICall("CREATE", "CreateNodegroup", err)
+	var awsErr smithy.APIError
+	if err != nil && !(errors.As(err, &awsErr) && awsErr.ErrorCode() == "ResourceInUseException") {
+		return "", err
+	}
+	return name, nil
+}
+
+// scaleDownNodegroup scales the supplied node group down to zero nodes,
+// keeping its maximum size which must stay positive.
+func (rm *resourceManager) scaleDownNodegroup(
+	ctx context.Context,
+	r *resource,
+) error {
+	maxSize := int32(1)
+	if r.ko.Spec.ScalingConfig != nil && aws.ToInt64(r.ko.Spec.ScalingConfig.MaxSize) > 0 {
+		maxSize = int32(aws.ToInt64(r.ko.Spec.ScalingConfig.MaxSize))
+	}
+	_, err := rm.sdkapi.UpdateNodegroupConfig(ctx, &svcsdk.UpdateNodegroupConfigInput{
+		ClusterName:   r.ko.Spec.ClusterName,
+		NodegroupName: nodegroupName(r),
+		ScalingConfig: &svcsdktypes.NodegroupScalingConfig{
+			DesiredSize: aws.Int32(0),
+			MinSize:     aws.Int32(0),
+			MaxSize:     aws.Int32(maxSize),
+		},
+	})
+	rm.metrics.RecordAPICall("UPDATE", "UpdateNodegroupConfig", err)
+	return err
+}
+
+// syncReplacement completes the replacement of the supplied node group once
+// the previous node group is deleted. Until then, the resource is marked as
+// not synced.
+func (rm *resourceManager) syncReplacement(
+	ctx context.Context,
+	r *resource,
+) error {
+	replacement := r.ko.Status.Replacement
+	if replacement == nil || aws.ToString(replacement.Phase) != ReplacementPhaseCreating {
+		condition.Remove(r, svcapitypes.ConditionTypeReplacementUnhealthy)
+	}
+	if replacement == nil {
+		return nil
+	}
+	if aws.ToString(replacement.Phase) == ReplacementPhaseDeletingPrevious {
+		_, err := rm.describeNodegroup(ctx, r.ko.Spec.ClusterName, replacement.PreviousNodegroupName)
+		if isNotFound(err) {
+			r.ko.Status.Replacement = nil
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+	}
+	msg := fmt.Sprintf("Nodegroup replacement in '%s' phase", aws.ToString(replacement.Phase))
+	ackcondition.SetSynced(r, corev1.ConditionFalse, &msg, nil)
+	return nil
+}
+
+// deleteReplacementNodegroup deletes the node group created by a replacement
+// which the supplied resource does not point at yet.
+func (rm *resourceManager) deleteReplacementNodegroup(
+	ctx context.Context,
+	r *resource,
+) error {
+	if !replacementInProgress(r) {
+		return nil
+	}
+	replacement := r.ko.Status.Replacement
+	ng, err := rm.describeNodegroup(ctx, r.ko.Spec.ClusterName, replacement.NodegroupName)
+	if isNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if ng.Status == svcsdktypes.NodegroupStatusDeleting {
+		return nil
+	}
+	_, err = rm.sdkapi.DeleteNodegroup(ctx, &svcsdk.DeleteNodegroupInput{
+		ClusterName:   r.ko.Spec.ClusterName,
+		NodegroupName: replacement.NodegroupName,
+	})
+	rm.metrics.RecordAPICall("DELETE", "DeleteNodegroup", err)
+	if err != nil && !isNotFound(err) {
+		return err
+	}
+	return nil
+}
+
+// describeNodegroup returns the node group with the supplied name.
+func (rm *resourceManager) describeNodegroup(
+	ctx context.Context,
+	clusterName *string,
+	name *string,
+) (*svcsdktypes.Nodegroup, error) {
+	resp, err := rm.sdkapi.DescribeNodegroup(ctx, &svcsdk.DescribeNodegroupInput{
+		ClusterName:   clusterName,
+		NodegroupName: name,
+	})
+	rm.metrics.RecordAPICall("READ_ONE", "DescribeNodegroup", err)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Nodegroup, nil
+}
+
+// isNotFound returns true if the supplied error is a ResourceNotFoundException
+// returned by EKS.
+func isNotFound(err error) bool {
+	var awsErr smithy.APIError
+	return errors.As(err, &awsErr) && awsErr.ErrorCode() == "ResourceNotFoundException"
+}
+
+// returnNodegroupReplacing sets synced to false on the resource and returns a
+// requeue error, to follow the progress of the supplied replacement.
+func returnNodegroupReplacing(
+	r *resource,
+	replacement *svcapitypes.NodegroupReplacement,
+) (*resource, error) {
+	phase := aws.ToString(replacement.Phase)
+	msg := fmt.Sprintf("Nodegroup replacement in '%s' phase", phase)
+	ackcondition.SetSynced(r, corev1.ConditionFalse, &msg, nil)
+	return r, requeueWaitWhileReplacing(phase)
+}
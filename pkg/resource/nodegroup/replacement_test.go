@@ -0,0 +1,270 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package nodegroup
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	ackv1alpha1 "github.com/aws-controllers-k8s/runtime/apis/core/v1alpha1"
+	ackcondition "github.com/aws-controllers-k8s/runtime/pkg/condition"
+	ackerr "github.com/aws-controllers-k8s/runtime/pkg/errors"
+	ackmetrics "github.com/aws-controllers-k8s/runtime/pkg/metrics"
+	ackrequeue "github.com/aws-controllers-k8s/runtime/pkg/requeue"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	svcsdk "github.com/aws/aws-sdk-go-v2/service/eks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+
+	svcapitypes "github.com/aws-controllers-k8s/eks-controller/apis/v1alpha1"
+	"github.com/aws-controllers-k8s/eks-controller/pkg/testutil"
+)
+
+func newReplacementManager(hc svcsdk.HTTPClient) *resourceManager {
+	return &resourceManager{
+		metrics: ackmetrics.NewMetrics("eks"),
+		sdkapi:  testutil.NewEKSClient(hc),
+	}
+}
+
+func newReplacementNodegroup(instanceType string, desiredSize int64) *resource {
+	arn := ackv1alpha1.AWSResourceName("arn:aws:eks:us-west-2:111122223333:nodegroup/my-cluster/workers/id")
+	return &resource{ko: &svcapitypes.Nodegroup{
+		Spec: svcapitypes.NodegroupSpec{
+			Name:          aws.String("workers"),
+			ClusterName:   aws.String("my-cluster"),
+			NodeRole:      aws.String("arn:aws:iam::111122223333:role/nodes"),
+			Subnets:       aws.StringSlice([]string{"subnet-1"}),
+			InstanceTypes: aws.StringSlice([]string{instanceType}),
+			ScalingConfig: &svcapitypes.NodegroupScalingConfig{
+				MinSize: aws.Int64(0), MaxSize: aws.Int64(3), DesiredSize: aws.Int64(desiredSize),
+			},
+		},
+		Status: svcapitypes.NodegroupStatus{
+			ACKResourceMetadata: &ackv1alpha1.ResourceMetadata{ARN: &arn},
+			Status:              aws.String(StatusActive),
+		},
+	}}
+}
+
+func TestReplacementNodegroupName(t *testing.T) {
+	a := newReplacementNodegroup("m5.large", 1)
+	b := newReplacementNodegroup("m6i.large", 1)
+
+	nameA, err := replacementNodegroupName(a)
+	require.NoError(t, err)
+	again, err := replacementNodegroupName(newReplacementNodegroup("m5.large", 2))
+	require.NoError(t, err)
+	nameB, err := replacementNodegroupName(b)
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(nameA, "workers-"))
+	assert.Len(t, nameA, len("workers-")+8)
+	assert.Equal(t, nameA, again)
+	assert.NotEqual(t, nameA, nameB)
+
+	a.ko.Spec.Name = aws.String(strings.Repeat("n", 63))
+	long, err := replacementNodegroupName(a)
+	require.NoError(t, err)
+	assert.Len(t, long, maxNodegroupNameLength)
+}
+
+func TestReplacementStrategy(t *testing.T) {
+	r := newReplacementNodegroup("m5.large", 1)
+	assert.Equal(t, svcapitypes.NodegroupReplacementStrategyNone, replacementStrategy(r))
+	r.ko.Spec.ReplacementStrategy = aws.String("bluegreen")
+	assert.Equal(t, svcapitypes.NodegroupReplacementStrategyNone, replacementStrategy(r))
+	r.ko.Spec.ReplacementStrategy = aws.String(svcapitypes.NodegroupReplacementStrategyBlueGreen)
+	assert.Equal(t, svcapitypes.NodegroupReplacementStrategyBlueGreen, replacementStrategy(r))
+}
+
+func TestNodegroupName(t *testing.T) {
+	r := newReplacementNodegroup("m5.large", 1)
+	assert.Equal(t, "workers", *nodegroupName(r))
+	r.ko.Status.CurrentNodegroupName = aws.String("workers-0123abcd")
+	assert.Equal(t, "workers-0123abcd", *nodegroupName(r))
+}
+
+func TestCustomUpdateImmutableFieldWithoutReplacement(t *testing.T) {
+	hc := &testutil.FakeHTTPClient{}
+	rm := newReplacementManager(hc)
+	latest := newReplacementNodegroup("m5.large", 1)
+	desired := newReplacementNodegroup("m6i.large", 1)
+
+	_, err := rm.customUpdate(context.TODO(), desired, latest, newResourceDelta(desired, latest))
+	var terminalErr *ackerr.TerminalError
+	require.True(t, errors.As(err, &terminalErr))
+	assert.Contains(t, err.Error(), "Spec.InstanceTypes cannot be updated in place")
+	assert.Empty(t, hc.Requests)
+}
+
+func TestCustomUpdateBlueGreenReplacement(t *testing.T) {
+	latest := newReplacementNodegroup("m5.large", 2)
+	desired := newReplacementNodegroup("m6i.large", 2)
+	desired.ko.Spec.ReplacementStrategy = aws.String(svcapitypes.NodegroupReplacementStrategyBlueGreen)
+	name, err := replacementNodegroupName(desired)
+	require.NoError(t, err)
+	newPath := "/clusters/my-cluster/node-groups/" + name
+
+	hc := &testutil.FakeHTTPClient{Responses: map[string]string{
+		"POST /clusters/my-cluster/node-groups": `{"nodegroup": {"status": "CREATING"}}`,
+		"GET " + newPath:                        `{"nodegroup": {"status": "CREATING"}}`,
+	}}
+	rm := newReplacementManager(hc)
+	update := func() *resource {
+		delta := newResourceDelta(desired, latest)
+		updated, err := rm.customUpdate(context.TODO(), desired, latest, delta)
+		var requeueErr *ackrequeue.RequeueNeededAfter
+		require.True(t, errors.As(err, &requeueErr))
+		latest.ko.Status = *updated.ko.Status.DeepCopy()
+		desired.ko.Status = *updated.ko.Status.DeepCopy()
+		return updated
+	}
+
+	// The replacement node group is created.
+	updated := update()
+	require.NotNil(t, updated.ko.Status.Replacement)
+	assert.Equal(t, name, *updated.ko.Status.Replacement.NodegroupName)
+	assert.Equal(t, "workers", *updated.ko.Status.Replacement.PreviousNodegroupName)
+	assert.Equal(t, ReplacementPhaseCreating, *updated.ko.Status.Replacement.Phase)
+	assert.Equal(t, []string{"POST /clusters/my-cluster/node-groups"}, hc.Requests)
+
+	// It is not active yet.
+	hc.Requests = nil
+	updated = update()
+	assert.Equal(t, ReplacementPhaseCreating, *updated.ko.Status.Replacement.Phase)
+	assert.Equal(t, []string{"GET " + newPath}, hc.Requests)
+
+	// It is active and healthy, the previous node group is scaled down.
+	hc.Requests = nil
+	hc.Responses["GET "+newPath] = `{"nodegroup": {"status": "ACTIVE", "health": {"issues": []}}}`
+	hc.Responses["POST /clusters/my-cluster/node-groups/workers/update-config"] = `{"update": {"id": "u"}}`
+	updated = update()
+	assert.Equal(t, ReplacementPhaseScalingDown, *updated.ko.Status.Replacement.Phase)
+	assert.Equal(t, []string{"GET " + newPath, "POST /clusters/my-cluster/node-groups/workers/update-config"}, hc.Requests)
+	assert.Nil(t, updated.ko.Status.CurrentNodegroupName)
+
+	// Once scaled down, the previous node group is deleted and the resource
+	// points at the new one.
+	hc.Requests = nil
+	latest.ko.Spec.ScalingConfig.DesiredSize = aws.Int64(0)
+	hc.Responses["DELETE /clusters/my-cluster/node-groups/workers"] = `{"nodegroup": {"status": "DELETING"}}`
+	updated = update()
+	assert.Equal(t, ReplacementPhaseDeletingPrevious, *updated.ko.Status.Replacement.Phase)
+	assert.Equal(t, name, *updated.ko.Status.CurrentNodegroupName)
+	assert.Equal(t, []string{"DELETE /clusters/my-cluster/node-groups/workers"}, hc.Requests)
+
+	// The replacement completes once the previous node group is gone.
+	hc.Requests = nil
+	hc.Responses["GET /clusters/my-cluster/node-groups/workers"] = `{"nodegroup": {"status": "DELETING"}}`
+	require.NoError(t, rm.syncReplacement(context.TODO(), updated))
+	assert.NotNil(t, updated.ko.Status.Replacement)
+	delete(hc.Responses, "GET /clusters/my-cluster/node-groups/workers")
+	require.NoError(t, rm.syncReplacement(context.TODO(), updated))
+	assert.Nil(t, updated.ko.Status.Replacement)
+	assert.Equal(t, name, *nodegroupName(updated))
+}
+
+func TestCustomUpdateReplacementCreating(t *testing.T) {
+	latest := newReplacementNodegroup("m5.large", 2)
+	desired := newReplacementNodegroup("m6i.large", 2)
+	desired.ko.Spec.ReplacementStrategy = aws.String(svcapitypes.NodegroupReplacementStrategyBlueGreen)
+	name, err := replacementNodegroupName(desired)
+	require.NoError(t, err)
+	newPath := "/clusters/my-cluster/node-groups/" + name
+	replacing := func(nodegroupName string) {
+		replacement := &svcapitypes.NodegroupReplacement{
+			NodegroupName:         aws.String(nodegroupName),
+			Phase:                 aws.String(ReplacementPhaseCreating),
+			PreviousNodegroupName: aws.String("workers"),
+		}
+		latest.ko.Status.Replacement = replacement
+		desired.ko.Status.Replacement = replacement.DeepCopy()
+	}
+	update := func(rm *resourceManager) (*resource, error) {
+		return rm.customUpdate(context.TODO(), desired, latest, newResourceDelta(desired, latest))
+	}
+
+	// An unhealthy replacement node group is reported and waited for.
+	replacing(name)
+	hc := &testutil.FakeHTTPClient{Responses: map[string]string{
+		"GET " + newPath: `{"nodegroup": {"status": "ACTIVE", "health": {"issues": [
+			{"code": "Ec2SubnetInvalidConfiguration", "message": "bad subnet"}
+		]}}}`,
+	}}
+	updated, err := update(newReplacementManager(hc))
+	var requeueErr *ackrequeue.RequeueNeededAfter
+	require.True(t, errors.As(err, &requeueErr))
+	assert.Equal(t, ReplacementPhaseCreating, *updated.ko.Status.Replacement.Phase)
+	unhealthy := ackcondition.FirstOfType(updated, svcapitypes.ConditionTypeReplacementUnhealthy)
+	require.NotNil(t, unhealthy)
+	assert.Equal(t, corev1.ConditionTrue, unhealthy.Status)
+	assert.Equal(t, "Ec2SubnetInvalidConfiguration", *unhealthy.Reason)
+	assert.Contains(t, *unhealthy.Message, "bad subnet")
+
+	// A replacement node group which failed to create is deleted.
+	hc = &testutil.FakeHTTPClient{Responses: map[string]string{
+		"GET " + newPath:    `{"nodegroup": {"status": "CREATE_FAILED"}}`,
+		"DELETE " + newPath: `{"nodegroup": {"status": "DELETING"}}`,
+	}}
+	desired.ko.Status.Conditions = updated.ko.Status.Conditions
+	updated, err = update(newReplacementManager(hc))
+	require.NoError(t, err)
+	assert.Nil(t, updated.ko.Status.Replacement)
+	assert.Nil(t, ackcondition.FirstOfType(updated, svcapitypes.ConditionTypeReplacementUnhealthy))
+	require.NotNil(t, ackcondition.Terminal(updated))
+	assert.Contains(t, hc.Requests, "DELETE "+newPath)
+
+	// A replacement node group created from a previous spec is deleted, and
+	// the replacement starts over.
+	stalePath := "/clusters/my-cluster/node-groups/workers-0badcafe"
+	replacing("workers-0badcafe")
+	desired.ko.Status.Conditions = nil
+	hc = &testutil.FakeHTTPClient{Responses: map[string]string{
+		"GET " + stalePath:    `{"nodegroup": {"status": "ACTIVE"}}`,
+		"DELETE " + stalePath: `{"nodegroup": {"status": "DELETING"}}`,
+	}}
+	updated, err = update(newReplacementManager(hc))
+	require.True(t, errors.As(err, &requeueErr))
+	assert.Nil(t, updated.ko.Status.Replacement)
+	assert.Contains(t, hc.Requests, "DELETE "+stalePath)
+
+	// A replacement node group which is gone is created again.
+	replacing(name)
+	hc = &testutil.FakeHTTPClient{}
+	updated, err = update(newReplacementManager(hc))
+	require.True(t, errors.As(err, &requeueErr))
+	assert.Nil(t, updated.ko.Status.Replacement)
+}
+
+func TestPlanUpdateReplacement(t *testing.T) {
+	hc := &offlineHTTPClient{}
+	rm := newReplacementManager(hc)
+	latest := newReplacementNodegroup("m5.large", 1)
+	desired := newReplacementNodegroup("m6i.large", 1)
+	desired.ko.Annotations = map[string]string{svcapitypes.DryRunAnnotation: "true"}
+	desired.ko.Spec.ReplacementStrategy = aws.String(svcapitypes.NodegroupReplacementStrategyBlueGreen)
+	name, err := replacementNodegroupName(desired)
+	require.NoError(t, err)
+
+	updated, err := rm.customUpdate(context.TODO(), desired, latest, newResourceDelta(desired, latest))
+	require.NoError(t, err)
+	assert.Equal(t, 0, hc.requests)
+	require.Len(t, updated.ko.Status.DryRunPlan, 1)
+	assert.True(t, strings.HasPrefix(*updated.ko.Status.DryRunPlan[0], "CreateNodegroup "))
+	assert.Contains(t, *updated.ko.Status.DryRunPlan[0], `"NodegroupName":"`+name+`"`)
+	assert.Nil(t, updated.ko.Status.Replacement)
+}
@@ -0,0 +1,275 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package nodegroup
+
+import (
+	"fmt"
+	"time"
+
+	ackcompare "github.com/aws-controllers-k8s/runtime/pkg/compare"
+	ackerr "github.com/aws-controllers-k8s/runtime/pkg/errors"
+	ackrequeue "github.com/aws-controllers-k8s/runtime/pkg/requeue"
+	"github.com/robfig/cron/v3"
+
+	svcapitypes "github.com/aws-controllers-k8s/eks-controller/apis/v1alpha1"
+)
+
+// now is the clock used to evaluate the scaling schedules, replaced in tests.
+var now = time.Now
+
+// scalingSchedule is a parsed NodegroupScalingSchedule.
+type scalingSchedule struct {
+	spec     *svcapitypes.NodegroupScalingSchedule
+	schedule cron.Schedule
+	duration time.Duration
+	location *time.Location
+}
+
+// parseScalingSchedule returns the scaling schedule described by the supplied
+// spec.
+func parseScalingSchedule(spec *svcapitypes.NodegroupScalingSchedule) (*scalingSchedule, error) {
+	if spec == nil || spec.Name == nil || spec.Schedule == nil || spec.Duration == nil {
+		return nil, fmt.Errorf("scaling schedule requires a name, a schedule and a duration")
+	}
+	schedule, err := cron.ParseStandard(*spec.Schedule)
+	if err != nil {
+		return nil, fmt.Errorf("invalid scaling schedule %q: %w", *spec.Name, err)
+	}
+	if _, ok := schedule.(cron.ConstantDelaySchedule); ok {
+		return nil, fmt.Errorf("invalid scaling schedule %q: @every is not supported", *spec.Name)
+	}
+	duration, err := time.ParseDuration(*spec.Duration)
+	if err != nil {
+		return nil, fmt.Errorf("invalid scaling schedule %q duration: %w", *spec.Name, err)
+	}
+	if duration <= 0 {
+		return nil, fmt.Errorf("invalid scaling schedule %q duration %q: must be positive", *spec.Name, *spec.Duration)
+	}
+	location := time.UTC
+	if spec.TimeZone != nil && *spec.TimeZone != "" {
+		location, err = time.LoadLocation(*spec.TimeZone)
+		if err != nil {
+			return nil, fmt.Errorf("invalid scaling schedule %q time zone: %w", *spec.Name, err)
+		}
+	}
+	return &scalingSchedule{spec: spec, schedule: schedule, duration: duration, location: location}, nil
+}
+
+// next returns whether the schedule is active at the supplied time. If it is,
+// the returned time is when it ends, otherwise it is when it is activated
+// next. The returned time is zero if the schedule is never activated.
+func (s *scalingSchedule) next(t time.Time) (bool, time.Time) {
+	t = t.In(s.location)
+	// The schedule is active if it was activated less than a duration ago.
+	activation := s.schedule.Next(t.Add(-s.duration))
+	if activation.IsZero() {
+		return false, activation
+	}
+	if !activation.After(t) {
+		return true, activation.Add(s.duration)
+	}
+	return false, activation
+}
+
+// activeScalingSchedule returns the first scaling schedule of the supplied
+// resource active at the supplied time, or nil if none is. The returned time
+// is the next activation or end of any of the schedules, when the active one
+// may change, or zero if none ever changes.
+func activeScalingSchedule(
+	r *resource,
+	t time.Time,
+) (*svcapitypes.NodegroupScalingSchedule, time.Time, error) {
+	var active *svcapitypes.NodegroupScalingSchedule
+	var boundary time.Time
+	for _, spec := range r.ko.Spec.ScalingSchedules {
+		s, err := parseScalingSchedule(spec)
+		if err != nil {
+			return nil, time.Time{}, err
+		}
+		isActive, next := s.next(t)
+		if isActive && active == nil {
+			active = spec
+		}
+		if !next.IsZero() && (boundary.IsZero() || next.Before(boundary)) {
+			boundary = next
+		}
+	}
+	return active, boundary, nil
+}
+
+// syncActiveScalingSchedule sets the name of the scaling schedule active now
+// in the status of the supplied resource. Invalid schedules are reported by
+// customUpdate.
+func syncActiveScalingSchedule(r *resource) {
+	r.ko.Status.ActiveScalingSchedule = nil
+	active, _, err := activeScalingSchedule(r, now())
+	if err == nil && active != nil {
+		r.ko.Status.ActiveScalingSchedule = active.Name
+	}
+}
+
+// scheduledScalingConfig returns the scaling configuration in effect while the
+// supplied schedule is active. The sizes the schedule leaves unset are taken
+// from base, the desired size being bounded by the minimum and maximum sizes.
+func scheduledScalingConfig(
+	base *svcapitypes.NodegroupScalingConfig,
+	schedule *svcapitypes.NodegroupScalingSchedule,
+	externallyManaged bool,
+) (*svcapitypes.NodegroupScalingConfig, error) {
+	sc := base.DeepCopy()
+	if sc == nil {
+		sc = &svcapitypes.NodegroupScalingConfig{}
+	}
+	if schedule.MinSize != nil {
+		sc.MinSize = schedule.MinSize
+	}
+	if schedule.MaxSize != nil {
+		sc.MaxSize = schedule.MaxSize
+	}
+	if sc.MinSize == nil || sc.MaxSize == nil {
+		return nil, fmt.Errorf("scaling schedule %q requires minSize and maxSize without scalingConfig", *schedule.Name)
+	}
+	if *sc.MinSize > *sc.MaxSize {
+		return nil, fmt.Errorf("scaling schedule %q minSize %d is greater than maxSize %d", *schedule.Name, *sc.MinSize, *sc.MaxSize)
+	}
+	// The desired size of an externally managed nodegroup is left to the
+	// autoscaler, within the bounds of the schedule.
+	if schedule.DesiredSize != nil && !externallyManaged {
+		if *schedule.DesiredSize < *sc.MinSize || *schedule.DesiredSize > *sc.MaxSize {
+			return nil, fmt.Errorf(
+				"scaling schedule %q desiredSize %d is not between minSize %d and maxSize %d",
+				*schedule.Name, *schedule.DesiredSize, *sc.MinSize, *sc.MaxSize,
+			)
+		}
+		sc.DesiredSize = schedule.DesiredSize
+		return sc, nil
+	}
+	desiredSize := *sc.MinSize
+	if sc.DesiredSize != nil {
+		desiredSize = min(max(*sc.DesiredSize, *sc.MinSize), *sc.MaxSize)
+	}
+	sc.DesiredSize = &desiredSize
+	return sc, nil
+}
+
+// applyScalingSchedules returns a copy of the supplied desired resource with
+// the scaling configuration in effect now, along with the time the scaling
+// schedules should be evaluated again, zero if never. Invalid schedules yield
+// a terminal error.
+func applyScalingSchedules(
+	desired *resource,
+	latest *resource,
+) (*resource, time.Time, error) {
+	active, boundary, err := activeScalingSchedule(desired, now())
+	if err != nil {
+		return nil, time.Time{}, ackerr.NewTerminalError(err)
+	}
+	scheduled := &resource{desired.ko.DeepCopy()}
+	if active == nil {
+		return scheduled, boundary, nil
+	}
+	externallyManaged := isManagedByExternalAutoscaler(desired.ko)
+	base := desired.ko.Spec.ScalingConfig
+	if base == nil || externallyManaged {
+		base = mergeScalingConfig(base, latest.ko.Spec.ScalingConfig, externallyManaged)
+	}
+	scheduled.ko.Spec.ScalingConfig, err = scheduledScalingConfig(base, active, externallyManaged)
+	if err != nil {
+		return nil, time.Time{}, ackerr.NewTerminalError(err)
+	}
+	return scheduled, boundary, nil
+}
+
+// mergeScalingConfig returns the desired scaling configuration, defaulting to
+// the observed one. The observed desired size prevails when it is managed by
+// an external autoscaler.
+func mergeScalingConfig(
+	desired *svcapitypes.NodegroupScalingConfig,
+	observed *svcapitypes.NodegroupScalingConfig,
+	externallyManaged bool,
+) *svcapitypes.NodegroupScalingConfig {
+	if desired == nil {
+		return observed
+	}
+	merged := desired.DeepCopy()
+	if externallyManaged && observed != nil && observed.DesiredSize != nil {
+		merged.DesiredSize = observed.DesiredSize
+	}
+	return merged
+}
+
+// compareScalingSchedules replaces the differences of the scaling
+// configuration of a and b with the ones of the scaling configuration in
+// effect now, see applyScalingSchedules. The scaling schedules are reported
+// as different when they are invalid, for customUpdate to return the terminal
+// error, and until customUpdate has requeued the nodegroup at their next
+// activation or end.
+func compareScalingSchedules(
+	delta *ackcompare.Delta,
+	a *resource,
+	b *resource,
+) {
+	if len(a.ko.Spec.ScalingSchedules) == 0 {
+		return
+	}
+	scheduled, boundary, err := applyScalingSchedules(a, b)
+	if err != nil {
+		delta.Add("Spec.ScalingSchedules", a.ko.Spec.ScalingSchedules, b.ko.Spec.ScalingSchedules)
+		return
+	}
+	removeDifferences(delta, "Spec.ScalingConfig")
+	compareScalingConfig(delta, scheduled.ko.Spec.ScalingConfig, b.ko.Spec.ScalingConfig)
+	evaluation := a.ko.Status.NextScalingScheduleEvaluation
+	if !boundary.IsZero() && (evaluation == nil || *evaluation != boundary.Format(time.RFC3339)) {
+		delta.Add("Spec.ScalingSchedules", a.ko.Spec.ScalingSchedules, b.ko.Spec.ScalingSchedules)
+	}
+}
+
+// compareScalingConfig adds the differences between the scaling
+// configurations a and b to the delta, like the generated newResourceDelta.
+func compareScalingConfig(
+	delta *ackcompare.Delta,
+	a *svcapitypes.NodegroupScalingConfig,
+	b *svcapitypes.NodegroupScalingConfig,
+) {
+	if ackcompare.HasNilDifference(a, b) {
+		delta.Add("Spec.ScalingConfig", a, b)
+		return
+	}
+	if a == nil {
+		return
+	}
+	for _, size := range []struct {
+		path string
+		a, b *int64
+	}{
+		{"Spec.ScalingConfig.DesiredSize", a.DesiredSize, b.DesiredSize},
+		{"Spec.ScalingConfig.MaxSize", a.MaxSize, b.MaxSize},
+		{"Spec.ScalingConfig.MinSize", a.MinSize, b.MinSize},
+	} {
+		if ackcompare.HasNilDifference(size.a, size.b) ||
+			(size.a != nil && *size.a != *size.b) {
+			delta.Add(size.path, size.a, size.b)
+		}
+	}
+}
+
+// requeueAtScalingScheduleBoundary returns a `ackrequeue.RequeueNeededAfter`
+// struct timed to the next activation or end of a scaling schedule.
+func requeueAtScalingScheduleBoundary(boundary time.Time) *ackrequeue.RequeueNeededAfter {
+	return ackrequeue.NeededAfter(
+		fmt.Errorf("scaling schedules are evaluated again at %s", boundary.Format(time.RFC3339)),
+		boundary.Sub(now()),
+	)
+}
@@ -0,0 +1,292 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package nodegroup
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	ackerr "github.com/aws-controllers-k8s/runtime/pkg/errors"
+	ackrequeue "github.com/aws-controllers-k8s/runtime/pkg/requeue"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	svcapitypes "github.com/aws-controllers-k8s/eks-controller/apis/v1alpha1"
+	"github.com/aws-controllers-k8s/eks-controller/pkg/testutil"
+)
+
+func newScalingSchedule(name, schedule, duration string) *svcapitypes.NodegroupScalingSchedule {
+	return &svcapitypes.NodegroupScalingSchedule{
+		Name:     aws.String(name),
+		Schedule: aws.String(schedule),
+		Duration: aws.String(duration),
+		MinSize:  aws.Int64(0),
+		MaxSize:  aws.Int64(0),
+	}
+}
+
+func setNow(t *testing.T, value string) {
+	at, err := time.Parse(time.RFC3339, value)
+	require.NoError(t, err)
+	previous := now
+	now = func() time.Time { return at }
+	t.Cleanup(func() { now = previous })
+}
+
+func TestActiveScalingSchedule(t *testing.T) {
+	night := newScalingSchedule("night", "0 20 * * *", "10h")
+	night.TimeZone = aws.String("Europe/Paris")
+	weekend := newScalingSchedule("weekend", "0 0 * * SAT", "48h")
+	r := newReplacementNodegroup("m5.large", 2)
+	r.ko.Spec.ScalingSchedules = []*svcapitypes.NodegroupScalingSchedule{night, weekend}
+
+	tests := []struct {
+		name         string
+		at           string
+		wantActive   *svcapitypes.NodegroupScalingSchedule
+		wantBoundary string
+	}{
+		{
+			name:         "no schedule active",
+			at:           "2026-10-14T12:00:00Z",
+			wantBoundary: "2026-10-14T18:00:00Z",
+		},
+		{
+			name:         "night schedule active",
+			at:           "2026-10-14T19:00:00Z",
+			wantActive:   night,
+			wantBoundary: "2026-10-15T04:00:00Z",
+		},
+		{
+			name:         "first active schedule wins",
+			at:           "2026-10-17T01:00:00Z",
+			wantActive:   night,
+			wantBoundary: "2026-10-17T04:00:00Z",
+		},
+		{
+			name:         "weekend schedule active",
+			at:           "2026-10-17T12:00:00Z",
+			wantActive:   weekend,
+			wantBoundary: "2026-10-17T18:00:00Z",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			at, err := time.Parse(time.RFC3339, tt.at)
+			require.NoError(t, err)
+			active, boundary, err := activeScalingSchedule(r, at)
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantActive, active)
+			assert.Equal(t, tt.wantBoundary, boundary.UTC().Format(time.RFC3339))
+		})
+	}
+
+	r.ko.Spec.ScalingSchedules = []*svcapitypes.NodegroupScalingSchedule{newScalingSchedule("bad", "0 20 * *", "10h")}
+	_, _, err := activeScalingSchedule(r, time.Now())
+	assert.ErrorContains(t, err, `invalid scaling schedule "bad"`)
+}
+
+func TestScheduledScalingConfig(t *testing.T) {
+	base := &svcapitypes.NodegroupScalingConfig{
+		MinSize: aws.Int64(2), DesiredSize: aws.Int64(4), MaxSize: aws.Int64(6),
+	}
+	tests := []struct {
+		name              string
+		base              *svcapitypes.NodegroupScalingConfig
+		schedule          *svcapitypes.NodegroupScalingSchedule
+		externallyManaged bool
+		want              *svcapitypes.NodegroupScalingConfig
+		wantErr           string
+	}{
+		{
+			name:     "all sizes set",
+			base:     base,
+			schedule: &svcapitypes.NodegroupScalingSchedule{Name: aws.String("s"), MinSize: aws.Int64(0), DesiredSize: aws.Int64(1), MaxSize: aws.Int64(2)},
+			want:     &svcapitypes.NodegroupScalingConfig{MinSize: aws.Int64(0), DesiredSize: aws.Int64(1), MaxSize: aws.Int64(2)},
+		},
+		{
+			name:     "desired size bounded by the schedule",
+			base:     base,
+			schedule: &svcapitypes.NodegroupScalingSchedule{Name: aws.String("s"), MinSize: aws.Int64(0), MaxSize: aws.Int64(0)},
+			want:     &svcapitypes.NodegroupScalingConfig{MinSize: aws.Int64(0), DesiredSize: aws.Int64(0), MaxSize: aws.Int64(0)},
+		},
+		{
+			name:     "sizes defaulted to the scaling config",
+			base:     base,
+			schedule: &svcapitypes.NodegroupScalingSchedule{Name: aws.String("s"), MinSize: aws.Int64(5)},
+			want:     &svcapitypes.NodegroupScalingConfig{MinSize: aws.Int64(5), DesiredSize: aws.Int64(5), MaxSize: aws.Int64(6)},
+		},
+		{
+			name:              "desired size left to the external autoscaler",
+			base:              base,
+			schedule:          &svcapitypes.NodegroupScalingSchedule{Name: aws.String("s"), DesiredSize: aws.Int64(3)},
+			externallyManaged: true,
+			want:              &svcapitypes.NodegroupScalingConfig{MinSize: aws.Int64(2), DesiredSize: aws.Int64(4), MaxSize: aws.Int64(6)},
+		},
+		{
+			name:     "no scaling config",
+			schedule: &svcapitypes.NodegroupScalingSchedule{Name: aws.String("s"), MinSize: aws.Int64(0)},
+			wantErr:  `scaling schedule "s" requires minSize and maxSize without scalingConfig`,
+		},
+		{
+			name:     "min size greater than max size",
+			base:     base,
+			schedule: &svcapitypes.NodegroupScalingSchedule{Name: aws.String("s"), MinSize: aws.Int64(8)},
+			wantErr:  `scaling schedule "s" minSize 8 is greater than maxSize 6`,
+		},
+		{
+			name:     "desired size out of bounds",
+			base:     base,
+			schedule: &svcapitypes.NodegroupScalingSchedule{Name: aws.String("s"), DesiredSize: aws.Int64(8)},
+			wantErr:  `scaling schedule "s" desiredSize 8 is not between minSize 2 and maxSize 6`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := scheduledScalingConfig(tt.base, tt.schedule, tt.externallyManaged)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestCustomUpdateScalingSchedule(t *testing.T) {
+	setNow(t, "2026-10-14T21:00:00Z")
+	path := "/clusters/my-cluster/node-groups/workers/update-config"
+	hc := &testutil.FakeHTTPClient{Responses: map[string]string{
+		"POST " + path: `{"update": {"id": "u"}}`,
+	}}
+	rm := newReplacementManager(hc)
+	desired := newReplacementNodegroup("m5.large", 2)
+	desired.ko.Spec.ScalingSchedules = []*svcapitypes.NodegroupScalingSchedule{
+		newScalingSchedule("night", "0 20 * * *", "10h"),
+	}
+	latest := newReplacementNodegroup("m5.large", 2)
+	latest.ko.Spec.ScalingSchedules = desired.ko.Spec.ScalingSchedules
+
+	// The scaling configuration of the schedule is applied, the spec is kept.
+	latest.ko.Status.NextScalingScheduleEvaluation = aws.String("2026-10-14T20:00:00Z")
+	updated, err := rm.customUpdate(context.TODO(), desired, latest, newResourceDelta(desired, latest))
+	var requeueErr *ackrequeue.RequeueNeededAfter
+	require.True(t, errors.As(err, &requeueErr))
+	assert.Equal(t, RequeueAfterUpdateDuration, requeueErr.Duration())
+	assert.Equal(t, []string{"POST " + path}, hc.Requests)
+	assert.Equal(t, int64(2), *updated.ko.Spec.ScalingConfig.DesiredSize)
+	assert.Equal(t, int64(3), *updated.ko.Spec.ScalingConfig.MaxSize)
+	assert.Nil(t, updated.ko.Status.NextScalingScheduleEvaluation)
+
+	// Once applied, the nodegroup is requeued at the end of the schedule.
+	hc.Requests = nil
+	latest.ko.Spec.ScalingConfig = &svcapitypes.NodegroupScalingConfig{
+		MinSize: aws.Int64(0), DesiredSize: aws.Int64(0), MaxSize: aws.Int64(0),
+	}
+	updated, err = rm.customUpdate(context.TODO(), desired, latest, newResourceDelta(desired, latest))
+	require.True(t, errors.As(err, &requeueErr))
+	assert.Equal(t, 9*time.Hour, requeueErr.Duration())
+	assert.Empty(t, hc.Requests)
+	assert.Equal(t, int64(2), *updated.ko.Spec.ScalingConfig.DesiredSize)
+	assert.Equal(t, "2026-10-15T06:00:00Z", *updated.ko.Status.NextScalingScheduleEvaluation)
+
+	// Invalid schedules are terminal.
+	desired.ko.Spec.ScalingSchedules = []*svcapitypes.NodegroupScalingSchedule{
+		newScalingSchedule("night", "0 20 * * *", "-1h"),
+	}
+	_, err = rm.customUpdate(context.TODO(), desired, latest, newResourceDelta(desired, latest))
+	var terminalErr *ackerr.TerminalError
+	require.True(t, errors.As(err, &terminalErr))
+}
+
+func TestCompareScalingSchedules(t *testing.T) {
+	setNow(t, "2026-10-14T21:00:00Z")
+	scheduled := &svcapitypes.NodegroupScalingConfig{
+		MinSize: aws.Int64(0), DesiredSize: aws.Int64(0), MaxSize: aws.Int64(0),
+	}
+	for _, tt := range []struct {
+		name          string
+		schedule      *svcapitypes.NodegroupScalingSchedule
+		scalingConfig *svcapitypes.NodegroupScalingConfig
+		evaluation    *string
+		want          []string
+	}{
+		{
+			name:          "in sync with the active schedule",
+			schedule:      newScalingSchedule("night", "0 20 * * *", "10h"),
+			scalingConfig: scheduled,
+			evaluation:    aws.String("2026-10-15T06:00:00Z"),
+		},
+		{
+			name:       "out of sync with the active schedule",
+			schedule:   newScalingSchedule("night", "0 20 * * *", "10h"),
+			evaluation: aws.String("2026-10-15T06:00:00Z"),
+			want: []string{
+				"Spec.ScalingConfig.DesiredSize",
+				"Spec.ScalingConfig.MaxSize",
+			},
+		},
+		{
+			name:          "in sync and not requeued at the schedule end",
+			schedule:      newScalingSchedule("night", "0 20 * * *", "10h"),
+			scalingConfig: scheduled,
+			evaluation:    aws.String("2026-10-14T20:00:00Z"),
+			want:          []string{"Spec.ScalingSchedules"},
+		},
+		{
+			name:       "in sync with the spec before the schedule starts",
+			schedule:   newScalingSchedule("night", "0 22 * * *", "8h"),
+			evaluation: aws.String("2026-10-14T22:00:00Z"),
+		},
+		{
+			name:     "invalid schedule",
+			schedule: newScalingSchedule("night", "0 20 * *", "10h"),
+			want:     []string{"Spec.ScalingSchedules"},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			desired := newReplacementNodegroup("m5.large", 2)
+			desired.ko.Spec.ScalingSchedules = []*svcapitypes.NodegroupScalingSchedule{tt.schedule}
+			desired.ko.Status.NextScalingScheduleEvaluation = tt.evaluation
+			latest := newReplacementNodegroup("m5.large", 2)
+			if tt.scalingConfig != nil {
+				latest.ko.Spec.ScalingConfig = tt.scalingConfig.DeepCopy()
+			}
+
+			delta := newResourceDelta(desired, latest)
+			assert.Len(t, delta.Differences, len(tt.want))
+			for _, path := range tt.want {
+				assert.True(t, delta.DifferentAt(path), path)
+			}
+		})
+	}
+}
+
+func TestSyncActiveScalingSchedule(t *testing.T) {
+	setNow(t, "2026-10-14T21:00:00Z")
+	r := newReplacementNodegroup("m5.large", 2)
+	r.ko.Status.ActiveScalingSchedule = aws.String("stale")
+	syncActiveScalingSchedule(r)
+	assert.Nil(t, r.ko.Status.ActiveScalingSchedule)
+
+	r.ko.Spec.ScalingSchedules = []*svcapitypes.NodegroupScalingSchedule{
+		newScalingSchedule("night", "0 20 * * *", "10h"),
+	}
+	syncActiveScalingSchedule(r)
+	assert.Equal(t, "night", *r.ko.Status.ActiveScalingSchedule)
+}
@@ -75,6 +75,7 @@ func (rm *resourceManager) sdkFind(
 	if err != nil {
 		return nil, err
 	}
+	input.NodegroupName = nodegroupName(r)
 
 	var resp *svcsdk.DescribeNodegroupOutput
 	resp, err = rm.sdkapi.DescribeNodegroup(ctx, input)
@@ -170,6 +171,52 @@ func (rm *resourceManager) sdkFind(
 	} else {
 		ko.Status.ModifiedAt = nil
 	}
+	if resp.Nodegroup.NodeRepairConfig != nil {
+		f10 := &svcapitypes.NodeRepairConfig{}
+		if resp.Nodegroup.NodeRepairConfig.Enabled != nil {
+			f10.Enabled = resp.Nodegroup.NodeRepairConfig.Enabled
+		}
+		if resp.Nodegroup.NodeRepairConfig.MaxParallelNodesRepairedCount != nil {
+			maxParallelNodesRepairedCountCopy := int64(*resp.Nodegroup.NodeRepairConfig.MaxParallelNodesRepairedCount)
+			f10.MaxParallelNodesRepairedCount = &maxParallelNodesRepairedCountCopy
+		}
+		if resp.Nodegroup.NodeRepairConfig.MaxParallelNodesRepairedPercentage != nil {
+			maxParallelNodesRepairedPercentageCopy := int64(*resp.Nodegroup.NodeRepairConfig.MaxParallelNodesRepairedPercentage)
+			f10.MaxParallelNodesRepairedPercentage = &maxParallelNodesRepairedPercentageCopy
+		}
+		if resp.Nodegroup.NodeRepairConfig.MaxUnhealthyNodeThresholdCount != nil {
+			maxUnhealthyNodeThresholdCountCopy := int64(*resp.Nodegroup.NodeRepairConfig.MaxUnhealthyNodeThresholdCount)
+			f10.MaxUnhealthyNodeThresholdCount = &maxUnhealthyNodeThresholdCountCopy
+		}
+		if resp.Nodegroup.NodeRepairConfig.MaxUnhealthyNodeThresholdPercentage != nil {
+			maxUnhealthyNodeThresholdPercentageCopy := int64(*resp.Nodegroup.NodeRepairConfig.MaxUnhealthyNodeThresholdPercentage)
+			f10.MaxUnhealthyNodeThresholdPercentage = &maxUnhealthyNodeThresholdPercentageCopy
+		}
+		if resp.Nodegroup.NodeRepairConfig.NodeRepairConfigOverrides != nil {
+			f10f5 := []*svcapitypes.NodeRepairConfigOverrides{}
+			for _, f10f5iter := range resp.Nodegroup.NodeRepairConfig.NodeRepairConfigOverrides {
+				f10f5elem := &svcapitypes.NodeRepairConfigOverrides{}
+				if f10f5iter.MinRepairWaitTimeMins != nil {
+					minRepairWaitTimeMinsCopy := int64(*f10f5iter.MinRepairWaitTimeMins)
+					f10f5elem.MinRepairWaitTimeMins = &minRepairWaitTimeMinsCopy
+				}
+				if f10f5iter.NodeMonitoringCondition != nil {
+					f10f5elem.NodeMonitoringCondition = f10f5iter.NodeMonitoringCondition
+				}
+				if f10f5iter.NodeUnhealthyReason != nil {
+					f10f5elem.NodeUnhealthyReason = f10f5iter.NodeUnhealthyReason
+				}
+				if f10f5iter.RepairAction != "" {
+					f10f5elem.RepairAction = aws.String(string(f10f5iter.RepairAction))
+				}
+				f10f5 = append(f10f5, f10f5elem)
+			}
+			f10.NodeRepairConfigOverrides = f10f5
+		}
+		ko.Spec.NodeRepairConfig = f10
+	} else {
+		ko.Spec.NodeRepairConfig = nil
+	}
 	if resp.Nodegroup.NodeRole != nil {
 		ko.Spec.NodeRole = resp.Nodegroup.NodeRole
 	} else {
@@ -193,52 +240,52 @@ func (rm *resourceManager) sdkFind(
 		ko.Spec.ReleaseVersion = nil
 	}
 	if resp.Nodegroup.RemoteAccess != nil {
-		f14 := &svcapitypes.RemoteAccessConfig{}
+		f15 := &svcapitypes.RemoteAccessConfig{}
 		if resp.Nodegroup.RemoteAccess.Ec2SshKey != nil {
-			f14.EC2SshKey = resp.Nodegroup.RemoteAccess.Ec2SshKey
+			f15.EC2SshKey = resp.Nodegroup.RemoteAccess.Ec2SshKey
 		}
 		if resp.Nodegroup.RemoteAccess.SourceSecurityGroups != nil {
-			f14.SourceSecurityGroups = aws.StringSlice(resp.Nodegroup.RemoteAccess.SourceSecurityGroups)
+			f15.SourceSecurityGroups = aws.StringSlice(resp.Nodegroup.RemoteAccess.SourceSecurityGroups)
 		}
-		ko.Spec.RemoteAccess = f14
+		ko.Spec.RemoteAccess = f15
 	} else {
 		ko.Spec.RemoteAccess = nil
 	}
 	if resp.Nodegroup.Resources != nil {
-		f15 := &svcapitypes.NodegroupResources{}
+		f16 := &svcapitypes.NodegroupResources{}
 		if resp.Nodegroup.Resources.AutoScalingGroups != nil {
-			f15f0 := []*svcapitypes.AutoScalingGroup{}
-			for _, f15f0iter := range resp.Nodegroup.Resources.AutoScalingGroups {
-				f15f0elem := &svcapitypes.AutoScalingGroup{}
-				if f15f0iter.Name != nil {
-					f15f0elem.Name = f15f0iter.Name
+			f16f0 := []*svcapitypes.AutoScalingGroup{}
+			for _, f16f0iter := range resp.Nodegroup.Resources.AutoScalingGroups {
+				f16f0elem := &svcapitypes.AutoScalingGroup{}
+				if f16f0iter.Name != nil {
+					f16f0elem.Name = f16f0iter.Name
 				}
-				f15f0 = append(f15f0, f15f0elem)
+				f16f0 = append(f16f0, f16f0elem)
 			}
-			f15.AutoScalingGroups = f15f0
+			f16.AutoScalingGroups = f16f0
 		}
 		if resp.Nodegroup.Resources.RemoteAccessSecurityGroup != nil {
-			f15.RemoteAccessSecurityGroup = resp.Nodegroup.Resources.RemoteAccessSecurityGroup
+			f16.RemoteAccessSecurityGroup = resp.Nodegroup.Resources.RemoteAccessSecurityGroup
 		}
-		ko.Status.Resources = f15
+		ko.Status.Resources = f16
 	} else {
 		ko.Status.Resources = nil
 	}
 	if resp.Nodegroup.ScalingConfig != nil {
-		f16 := &svcapitypes.NodegroupScalingConfig{}
+		f17 := &svcapitypes.NodegroupScalingConfig{}
 		if resp.Nodegroup.ScalingConfig.DesiredSize != nil {
 			desiredSizeCopy := int64(*resp.Nodegroup.ScalingConfig.DesiredSize)
-			f16.DesiredSize = &desiredSizeCopy
+			f17.DesiredSize = &desiredSizeCopy
 		}
 		if resp.Nodegroup.ScalingConfig.MaxSize != nil {
 			maxSizeCopy := int64(*resp.Nodegroup.ScalingConfig.MaxSize)
-			f16.MaxSize = &maxSizeCopy
+			f17.MaxSize = &maxSizeCopy
 		}
 		if resp.Nodegroup.ScalingConfig.MinSize != nil {
 			minSizeCopy := int64(*resp.Nodegroup.ScalingConfig.MinSize)
-			f16.MinSize = &minSizeCopy
+			f17.MinSize = &minSizeCopy
 		}
-		ko.Spec.ScalingConfig = f16
+		ko.Spec.ScalingConfig = f17
 	} else {
 		ko.Spec.ScalingConfig = nil
 	}
@@ -258,35 +305,35 @@ func (rm *resourceManager) sdkFind(
 		ko.Spec.Tags = nil
 	}
 	if resp.Nodegroup.Taints != nil {
-		f20 := []*svcapitypes.Taint{}
-		for _, f20iter := range resp.Nodegroup.Taints {
-			f20elem := &svcapitypes.Taint{}
-			if f20iter.Effect != "" {
-				f20elem.Effect = aws.String(string(f20iter.Effect))
+		f21 := []*svcapitypes.Taint{}
+		for _, f21iter := range resp.Nodegroup.Taints {
+			f21elem := &svcapitypes.Taint{}
+			if f21iter.Effect != "" {
+				f21elem.Effect = aws.String(string(f21iter.Effect))
 			}
-			if f20iter.Key != nil {
-				f20elem.Key = f20iter.Key
+			if f21iter.Key != nil {
+				f21elem.Key = f21iter.Key
 			}
-			if f20iter.Value != nil {
-				f20elem.Value = f20iter.Value
+			if f21iter.Value != nil {
+				f21elem.Value = f21iter.Value
 			}
-			f20 = append(f20, f20elem)
+			f21 = append(f21, f21elem)
 		}
-		ko.Spec.Taints = f20
+		ko.Spec.Taints = f21
 	} else {
 		ko.Spec.Taints = nil
 	}
 	if resp.Nodegroup.UpdateConfig != nil {
-		f21 := &svcapitypes.NodegroupUpdateConfig{}
+		f22 := &svcapitypes.NodegroupUpdateConfig{}
 		if resp.Nodegroup.UpdateConfig.MaxUnavailable != nil {
 			maxUnavailableCopy := int64(*resp.Nodegroup.UpdateConfig.MaxUnavailable)
-			f21.MaxUnavailable = &maxUnavailableCopy
+			f22.MaxUnavailable = &maxUnavailableCopy
 		}
 		if resp.Nodegroup.UpdateConfig.MaxUnavailablePercentage != nil {
 			maxUnavailablePercentageCopy := int64(*resp.Nodegroup.UpdateConfig.MaxUnavailablePercentage)
-			f21.MaxUnavailablePercentage = &maxUnavailablePercentageCopy
+			f22.MaxUnavailablePercentage = &maxUnavailablePercentageCopy
 		}
-		ko.Spec.UpdateConfig = f21
+		ko.Spec.UpdateConfig = f22
 	} else {
 		ko.Spec.UpdateConfig = nil
 	}
@@ -295,11 +342,43 @@ func (rm *resourceManager) sdkFind(
 	} else {
 		ko.Spec.Version = nil
 	}
+	if resp.Nodegroup.WarmPoolConfig != nil {
+		f24 := &svcapitypes.WarmPoolConfig{}
+		if resp.Nodegroup.WarmPoolConfig.Enabled != nil {
+			f24.Enabled = resp.Nodegroup.WarmPoolConfig.Enabled
+		}
+		if resp.Nodegroup.WarmPoolConfig.MaxGroupPreparedCapacity != nil {
+			maxGroupPreparedCapacityCopy := int64(*resp.Nodegroup.WarmPoolConfig.MaxGroupPreparedCapacity)
+			f24.MaxGroupPreparedCapacity = &maxGroupPreparedCapacityCopy
+		}
+		if resp.Nodegroup.WarmPoolConfig.MinSize != nil {
+			minSizeCopy := int64(*resp.Nodegroup.WarmPoolConfig.MinSize)
+			f24.MinSize = &minSizeCopy
+		}
+		if resp.Nodegroup.WarmPoolConfig.PoolState != "" {
+			f24.PoolState = aws.String(string(resp.Nodegroup.WarmPoolConfig.PoolState))
+		}
+		if resp.Nodegroup.WarmPoolConfig.ReuseOnScaleIn != nil {
+			f24.ReuseOnScaleIn = resp.Nodegroup.WarmPoolConfig.ReuseOnScaleIn
+		}
+		ko.Spec.WarmPoolConfig = f24
+	} else {
+		ko.Spec.WarmPoolConfig = nil
+	}
 
 	rm.setStatusDefaults(ko)
+	// Once replaced, the node group backing the resource is not named after it.
+	ko.Spec.Name = r.ko.Spec.Name
 	if ko.Spec.ScalingConfig != nil && ko.Spec.ScalingConfig.DesiredSize != nil {
 		ko.Status.DesiredSize = ko.Spec.ScalingConfig.DesiredSize
 	}
+	clearDryRunPlan(&resource{ko})
+	syncHealthConditions(ctx, &resource{ko})
+	syncActiveScalingSchedule(&resource{ko})
+	syncMaintenanceWindowCondition(r, &resource{ko})
+	if err := rm.syncResolvedLaunchTemplateVersion(ctx, r, &resource{ko}); err != nil {
+		return nil, err
+	}
 
 	if !nodegroupActive(&resource{ko}) {
 		// Setting resource synced condition to false will trigger a requeue of
@@ -308,6 +387,9 @@ func (rm *resourceManager) sdkFind(
 	} else {
 		ackcondition.SetSynced(&resource{ko}, corev1.ConditionTrue, nil, nil)
 	}
+	if err := rm.syncReplacement(ctx, &resource{ko}); err != nil {
+		return nil, err
+	}
 
 	return &resource{ko}, nil
 }
@@ -446,6 +528,52 @@ func (rm *resourceManager) sdkCreate(
 	} else {
 		ko.Status.ModifiedAt = nil
 	}
+	if resp.Nodegroup.NodeRepairConfig != nil {
+		f10 := &svcapitypes.NodeRepairConfig{}
+		if resp.Nodegroup.NodeRepairConfig.Enabled != nil {
+			f10.Enabled = resp.Nodegroup.NodeRepairConfig.Enabled
+		}
+		if resp.Nodegroup.NodeRepairConfig.MaxParallelNodesRepairedCount != nil {
+			maxParallelNodesRepairedCountCopy := int64(*resp.Nodegroup.NodeRepairConfig.MaxParallelNodesRepairedCount)
+			f10.MaxParallelNodesRepairedCount = &maxParallelNodesRepairedCountCopy
+		}
+		if resp.Nodegroup.NodeRepairConfig.MaxParallelNodesRepairedPercentage != nil {
+			maxParallelNodesRepairedPercentageCopy := int64(*resp.Nodegroup.NodeRepairConfig.MaxParallelNodesRepairedPercentage)
+			f10.MaxParallelNodesRepairedPercentage = &maxParallelNodesRepairedPercentageCopy
+		}
+		if resp.Nodegroup.NodeRepairConfig.MaxUnhealthyNodeThresholdCount != nil {
+			maxUnhealthyNodeThresholdCountCopy := int64(*resp.Nodegroup.NodeRepairConfig.MaxUnhealthyNodeThresholdCount)
+			f10.MaxUnhealthyNodeThresholdCount = &maxUnhealthyNodeThresholdCountCopy
+		}
+		if resp.Nodegroup.NodeRepairConfig.MaxUnhealthyNodeThresholdPercentage != nil {
+			maxUnhealthyNodeThresholdPercentageCopy := int64(*resp.Nodegroup.NodeRepairConfig.MaxUnhealthyNodeThresholdPercentage)
+			f10.MaxUnhealthyNodeThresholdPercentage = &maxUnhealthyNodeThresholdPercentageCopy
+		}
+		if resp.Nodegroup.NodeRepairConfig.NodeRepairConfigOverrides != nil {
+			f10f5 := []*svcapitypes.NodeRepairConfigOverrides{}
+			for _, f10f5iter := range resp.Nodegroup.NodeRepairConfig.NodeRepairConfigOverrides {
+				f10f5elem := &svcapitypes.NodeRepairConfigOverrides{}
+				if f10f5iter.MinRepairWaitTimeMins != nil {
+					minRepairWaitTimeMinsCopy := int64(*f10f5iter.MinRepairWaitTimeMins)
+					f10f5elem.MinRepairWaitTimeMins = &minRepairWaitTimeMinsCopy
+				}
+				if f10f5iter.NodeMonitoringCondition != nil {
+					f10f5elem.NodeMonitoringCondition = f10f5iter.NodeMonitoringCondition
+				}
+				if f10f5iter.NodeUnhealthyReason != nil {
+					f10f5elem.NodeUnhealthyReason = f10f5iter.NodeUnhealthyReason
+				}
+				if f10f5iter.RepairAction != "" {
+					f10f5elem.RepairAction = aws.String(string(f10f5iter.RepairAction))
+				}
+				f10f5 = append(f10f5, f10f5elem)
+			}
+			f10.NodeRepairConfigOverrides = f10f5
+		}
+		ko.Spec.NodeRepairConfig = f10
+	} else {
+		ko.Spec.NodeRepairConfig = nil
+	}
 	if resp.Nodegroup.NodeRole != nil {
 		ko.Spec.NodeRole = resp.Nodegroup.NodeRole
 	} else {
@@ -469,52 +597,52 @@ func (rm *resourceManager) sdkCreate(
 		ko.Spec.ReleaseVersion = nil
 	}
 	if resp.Nodegroup.RemoteAccess != nil {
-		f14 := &svcapitypes.RemoteAccessConfig{}
+		f15 := &svcapitypes.RemoteAccessConfig{}
 		if resp.Nodegroup.RemoteAccess.Ec2SshKey != nil {
-			f14.EC2SshKey = resp.Nodegroup.RemoteAccess.Ec2SshKey
+			f15.EC2SshKey = resp.Nodegroup.RemoteAccess.Ec2SshKey
 		}
 		if resp.Nodegroup.RemoteAccess.SourceSecurityGroups != nil {
-			f14.SourceSecurityGroups = aws.StringSlice(resp.Nodegroup.RemoteAccess.SourceSecurityGroups)
+			f15.SourceSecurityGroups = aws.StringSlice(resp.Nodegroup.RemoteAccess.SourceSecurityGroups)
 		}
-		ko.Spec.RemoteAccess = f14
+		ko.Spec.RemoteAccess = f15
 	} else {
 		ko.Spec.RemoteAccess = nil
 	}
 	if resp.Nodegroup.Resources != nil {
-		f15 := &svcapitypes.NodegroupResources{}
+		f16 := &svcapitypes.NodegroupResources{}
 		if resp.Nodegroup.Resources.AutoScalingGroups != nil {
-			f15f0 := []*svcapitypes.AutoScalingGroup{}
-			for _, f15f0iter := range resp.Nodegroup.Resources.AutoScalingGroups {
-				f15f0elem := &svcapitypes.AutoScalingGroup{}
-				if f15f0iter.Name != nil {
-					f15f0elem.Name = f15f0iter.Name
+			f16f0 := []*svcapitypes.AutoScalingGroup{}
+			for _, f16f0iter := range resp.Nodegroup.Resources.AutoScalingGroups {
+				f16f0elem := &svcapitypes.AutoScalingGroup{}
+				if f16f0iter.Name != nil {
+					f16f0elem.Name = f16f0iter.Name
 				}
-				f15f0 = append(f15f0, f15f0elem)
+				f16f0 = append(f16f0, f16f0elem)
 			}
-			f15.AutoScalingGroups = f15f0
+			f16.AutoScalingGroups = f16f0
 		}
 		if resp.Nodegroup.Resources.RemoteAccessSecurityGroup != nil {
-			f15.RemoteAccessSecurityGroup = resp.Nodegroup.Resources.RemoteAccessSecurityGroup
+			f16.RemoteAccessSecurityGroup = resp.Nodegroup.Resources.RemoteAccessSecurityGroup
 		}
-		ko.Status.Resources = f15
+		ko.Status.Resources = f16
 	} else {
 		ko.Status.Resources = nil
 	}
 	if resp.Nodegroup.ScalingConfig != nil {
-		f16 := &svcapitypes.NodegroupScalingConfig{}
+		f17 := &svcapitypes.NodegroupScalingConfig{}
 		if resp.Nodegroup.ScalingConfig.DesiredSize != nil {
 			desiredSizeCopy := int64(*resp.Nodegroup.ScalingConfig.DesiredSize)
-			f16.DesiredSize = &desiredSizeCopy
+			f17.DesiredSize = &desiredSizeCopy
 		}
 		if resp.Nodegroup.ScalingConfig.MaxSize != nil {
 			maxSizeCopy := int64(*resp.Nodegroup.ScalingConfig.MaxSize)
-			f16.MaxSize = &maxSizeCopy
+			f17.MaxSize = &maxSizeCopy
 		}
 		if resp.Nodegroup.ScalingConfig.MinSize != nil {
 			minSizeCopy := int64(*resp.Nodegroup.ScalingConfig.MinSize)
-			f16.MinSize = &minSizeCopy
+			f17.MinSize = &minSizeCopy
 		}
-		ko.Spec.ScalingConfig = f16
+		ko.Spec.ScalingConfig = f17
 	} else {
 		ko.Spec.ScalingConfig = nil
 	}
@@ -534,35 +662,35 @@ func (rm *resourceManager) sdkCreate(
 		ko.Spec.Tags = nil
 	}
 	if resp.Nodegroup.Taints != nil {
-		f20 := []*svcapitypes.Taint{}
-		for _, f20iter := range resp.Nodegroup.Taints {
-			f20elem := &svcapitypes.Taint{}
-			if f20iter.Effect != "" {
-				f20elem.Effect = aws.String(string(f20iter.Effect))
+		f21 := []*svcapitypes.Taint{}
+		for _, f21iter := range resp.Nodegroup.Taints {
+			f21elem := &svcapitypes.Taint{}
+			if f21iter.Effect != "" {
+				f21elem.Effect = aws.String(string(f21iter.Effect))
 			}
-			if f20iter.Key != nil {
-				f20elem.Key = f20iter.Key
+			if f21iter.Key != nil {
+				f21elem.Key = f21iter.Key
 			}
-			if f20iter.Value != nil {
-				f20elem.Value = f20iter.Value
+			if f21iter.Value != nil {
+				f21elem.Value = f21iter.Value
 			}
-			f20 = append(f20, f20elem)
+			f21 = append(f21, f21elem)
 		}
-		ko.Spec.Taints = f20
+		ko.Spec.Taints = f21
 	} else {
 		ko.Spec.Taints = nil
 	}
 	if resp.Nodegroup.UpdateConfig != nil {
-		f21 := &svcapitypes.NodegroupUpdateConfig{}
+		f22 := &svcapitypes.NodegroupUpdateConfig{}
 		if resp.Nodegroup.UpdateConfig.MaxUnavailable != nil {
 			maxUnavailableCopy := int64(*resp.Nodegroup.UpdateConfig.MaxUnavailable)
-			f21.MaxUnavailable = &maxUnavailableCopy
+			f22.MaxUnavailable = &maxUnavailableCopy
 		}
 		if resp.Nodegroup.UpdateConfig.MaxUnavailablePercentage != nil {
 			maxUnavailablePercentageCopy := int64(*resp.Nodegroup.UpdateConfig.MaxUnavailablePercentage)
-			f21.MaxUnavailablePercentage = &maxUnavailablePercentageCopy
+			f22.MaxUnavailablePercentage = &maxUnavailablePercentageCopy
 		}
-		ko.Spec.UpdateConfig = f21
+		ko.Spec.UpdateConfig = f22
 	} else {
 		ko.Spec.UpdateConfig = nil
 	}
@@ -571,6 +699,29 @@ func (rm *resourceManager) sdkCreate(
 	} else {
 		ko.Spec.Version = nil
 	}
+	if resp.Nodegroup.WarmPoolConfig != nil {
+		f24 := &svcapitypes.WarmPoolConfig{}
+		if resp.Nodegroup.WarmPoolConfig.Enabled != nil {
+			f24.Enabled = resp.Nodegroup.WarmPoolConfig.Enabled
+		}
+		if resp.Nodegroup.WarmPoolConfig.MaxGroupPreparedCapacity != nil {
+			maxGroupPreparedCapacityCopy := int64(*resp.Nodegroup.WarmPoolConfig.MaxGroupPreparedCapacity)
+			f24.MaxGroupPreparedCapacity = &maxGroupPreparedCapacityCopy
+		}
+		if resp.Nodegroup.WarmPoolConfig.MinSize != nil {
+			minSizeCopy := int64(*resp.Nodegroup.WarmPoolConfig.MinSize)
+			f24.MinSize = &minSizeCopy
+		}
+		if resp.Nodegroup.WarmPoolConfig.PoolState != "" {
+			f24.PoolState = aws.String(string(resp.Nodegroup.WarmPoolConfig.PoolState))
+		}
+		if resp.Nodegroup.WarmPoolConfig.ReuseOnScaleIn != nil {
+			f24.ReuseOnScaleIn = resp.Nodegroup.WarmPoolConfig.ReuseOnScaleIn
+		}
+		ko.Spec.WarmPoolConfig = f24
+	} else {
+		ko.Spec.WarmPoolConfig = nil
+	}
 
 	rm.setStatusDefaults(ko)
 	// We expect the nodegroup to be in 'CREATING' status since we just issued
@@ -632,6 +783,70 @@ func (rm *resourceManager) newCreateRequestPayload(
 		}
 		res.LaunchTemplate = f7
 	}
+	if r.ko.Spec.NodeRepairConfig != nil {
+		f8 := &svcsdktypes.NodeRepairConfig{}
+		if r.ko.Spec.NodeRepairConfig.Enabled != nil {
+			f8.Enabled = r.ko.Spec.NodeRepairConfig.Enabled
+		}
+		if r.ko.Spec.NodeRepairConfig.MaxParallelNodesRepairedCount != nil {
+			maxParallelNodesRepairedCountCopy0 := *r.ko.Spec.NodeRepairConfig.MaxParallelNodesRepairedCount
+			if maxParallelNodesRepairedCountCopy0 > math.MaxInt32 || maxParallelNodesRepairedCountCopy0 < math.MinInt32 {
+				return nil, fmt.Errorf("error: field maxParallelNodesRepairedCount is of type int32")
+			}
+			maxParallelNodesRepairedCountCopy := int32(maxParallelNodesRepairedCountCopy0)
+			f8.MaxParallelNodesRepairedCount = &maxParallelNodesRepairedCountCopy
+		}
+		if r.ko.Spec.NodeRepairConfig.MaxParallelNodesRepairedPercentage != nil {
+			maxParallelNodesRepairedPercentageCopy0 := *r.ko.Spec.NodeRepairConfig.MaxParallelNodesRepairedPercentage
+			if maxParallelNodesRepairedPercentageCopy0 > math.MaxInt32 || maxParallelNodesRepairedPercentageCopy0 < math.MinInt32 {
+				return nil, fmt.Errorf("error: field maxParallelNodesRepairedPercentage is of type int32")
+			}
+			maxParallelNodesRepairedPercentageCopy := int32(maxParallelNodesRepairedPercentageCopy0)
+			f8.MaxParallelNodesRepairedPercentage = &maxParallelNodesRepairedPercentageCopy
+		}
+		if r.ko.Spec.NodeRepairConfig.MaxUnhealthyNodeThresholdCount != nil {
+			maxUnhealthyNodeThresholdCountCopy0 := *r.ko.Spec.NodeRepairConfig.MaxUnhealthyNodeThresholdCount
+			if maxUnhealthyNodeThresholdCountCopy0 > math.MaxInt32 || maxUnhealthyNodeThresholdCountCopy0 < math.MinInt32 {
+				return nil, fmt.Errorf("error: field maxUnhealthyNodeThresholdCount is of type int32")
+			}
+			maxUnhealthyNodeThresholdCountCopy := int32(maxUnhealthyNodeThresholdCountCopy0)
+			f8.MaxUnhealthyNodeThresholdCount = &maxUnhealthyNodeThresholdCountCopy
+		}
+		if r.ko.Spec.NodeRepairConfig.MaxUnhealthyNodeThresholdPercentage != nil {
+			maxUnhealthyNodeThresholdPercentageCopy0 := *r.ko.Spec.NodeRepairConfig.MaxUnhealthyNodeThresholdPercentage
+			if maxUnhealthyNodeThresholdPercentageCopy0 > math.MaxInt32 || maxUnhealthyNodeThresholdPercentageCopy0 < math.MinInt32 {
+				return nil, fmt.Errorf("error: field maxUnhealthyNodeThresholdPercentage is of type int32")
+			}
+			maxUnhealthyNodeThresholdPercentageCopy := int32(maxUnhealthyNodeThresholdPercentageCopy0)
+			f8.MaxUnhealthyNodeThresholdPercentage = &maxUnhealthyNodeThresholdPercentageCopy
+		}
+		if r.ko.Spec.NodeRepairConfig.NodeRepairConfigOverrides != nil {
+			f8f5 := []svcsdktypes.NodeRepairConfigOverrides{}
+			for _, f8f5iter := range r.ko.Spec.NodeRepairConfig.NodeRepairConfigOverrides {
+				f8f5elem := &svcsdktypes.NodeRepairConfigOverrides{}
+				if f8f5iter.MinRepairWaitTimeMins != nil {
+					minRepairWaitTimeMinsCopy0 := *f8f5iter.MinRepairWaitTimeMins
+					if minRepairWaitTimeMinsCopy0 > math.MaxInt32 || minRepairWaitTimeMinsCopy0 < math.MinInt32 {
+						return nil, fmt.Errorf("error: field minRepairWaitTimeMins is of type int32")
+					}
+					minRepairWaitTimeMinsCopy := int32(minRepairWaitTimeMinsCopy0)
+					f8f5elem.MinRepairWaitTimeMins = &minRepairWaitTimeMinsCopy
+				}
+				if f8f5iter.NodeMonitoringCondition != nil {
+					f8f5elem.NodeMonitoringCondition = f8f5iter.NodeMonitoringCondition
+				}
+				if f8f5iter.NodeUnhealthyReason != nil {
+					f8f5elem.NodeUnhealthyReason = f8f5iter.NodeUnhealthyReason
+				}
+				if f8f5iter.RepairAction != nil {
+					f8f5elem.RepairAction = svcsdktypes.RepairAction(*f8f5iter.RepairAction)
+				}
+				f8f5 = append(f8f5, *f8f5elem)
+			}
+			f8.NodeRepairConfigOverrides = f8f5
+		}
+		res.NodeRepairConfig = f8
+	}
 	if r.ko.Spec.NodeRole != nil {
 		res.NodeRole = r.ko.Spec.NodeRole
 	}
@@ -642,24 +857,24 @@ func (rm *resourceManager) newCreateRequestPayload(
 		res.ReleaseVersion = r.ko.Spec.ReleaseVersion
 	}
 	if r.ko.Spec.RemoteAccess != nil {
-		f11 := &svcsdktypes.RemoteAccessConfig{}
+		f12 := &svcsdktypes.RemoteAccessConfig{}
 		if r.ko.Spec.RemoteAccess.EC2SshKey != nil {
-			f11.Ec2SshKey = r.ko.Spec.RemoteAccess.EC2SshKey
+			f12.Ec2SshKey = r.ko.Spec.RemoteAccess.EC2SshKey
 		}
 		if r.ko.Spec.RemoteAccess.SourceSecurityGroups != nil {
-			f11.SourceSecurityGroups = aws.ToStringSlice(r.ko.Spec.RemoteAccess.SourceSecurityGroups)
+			f12.SourceSecurityGroups = aws.ToStringSlice(r.ko.Spec.RemoteAccess.SourceSecurityGroups)
 		}
-		res.RemoteAccess = f11
+		res.RemoteAccess = f12
 	}
 	if r.ko.Spec.ScalingConfig != nil {
-		f12 := &svcsdktypes.NodegroupScalingConfig{}
+		f13 := &svcsdktypes.NodegroupScalingConfig{}
 		if r.ko.Spec.ScalingConfig.DesiredSize != nil {
 			desiredSizeCopy0 := *r.ko.Spec.ScalingConfig.DesiredSize
 			if desiredSizeCopy0 > math.MaxInt32 || desiredSizeCopy0 < math.MinInt32 {
 				return nil, fmt.Errorf("error: field desiredSize is of type int32")
 			}
 			desiredSizeCopy := int32(desiredSizeCopy0)
-			f12.DesiredSize = &desiredSizeCopy
+			f13.DesiredSize = &desiredSizeCopy
 		}
 		if r.ko.Spec.ScalingConfig.MaxSize != nil {
 			maxSizeCopy0 := *r.ko.Spec.ScalingConfig.MaxSize
@@ -667,7 +882,7 @@ func (rm *resourceManager) newCreateRequestPayload(
 				return nil, fmt.Errorf("error: field maxSize is of type int32")
 			}
 			maxSizeCopy := int32(maxSizeCopy0)
-			f12.MaxSize = &maxSizeCopy
+			f13.MaxSize = &maxSizeCopy
 		}
 		if r.ko.Spec.ScalingConfig.MinSize != nil {
 			minSizeCopy0 := *r.ko.Spec.ScalingConfig.MinSize
@@ -675,9 +890,9 @@ func (rm *resourceManager) newCreateRequestPayload(
 				return nil, fmt.Errorf("error: field minSize is of type int32")
 			}
 			minSizeCopy := int32(minSizeCopy0)
-			f12.MinSize = &minSizeCopy
+			f13.MinSize = &minSizeCopy
 		}
-		res.ScalingConfig = f12
+		res.ScalingConfig = f13
 	}
 	if r.ko.Spec.Subnets != nil {
 		res.Subnets = aws.ToStringSlice(r.ko.Spec.Subnets)
@@ -686,31 +901,31 @@ func (rm *resourceManager) newCreateRequestPayload(
 		res.Tags = aws.ToStringMap(r.ko.Spec.Tags)
 	}
 	if r.ko.Spec.Taints != nil {
-		f15 := []svcsdktypes.Taint{}
-		for _, f15iter := range r.ko.Spec.Taints {
-			f15elem := &svcsdktypes.Taint{}
-			if f15iter.Effect != nil {
-				f15elem.Effect = svcsdktypes.TaintEffect(*f15iter.Effect)
+		f16 := []svcsdktypes.Taint{}
+		for _, f16iter := range r.ko.Spec.Taints {
+			f16elem := &svcsdktypes.Taint{}
+			if f16iter.Effect != nil {
+				f16elem.Effect = svcsdktypes.TaintEffect(*f16iter.Effect)
 			}
-			if f15iter.Key != nil {
-				f15elem.Key = f15iter.Key
+			if f16iter.Key != nil {
+				f16elem.Key = f16iter.Key
 			}
-			if f15iter.Value != nil {
-				f15elem.Value = f15iter.Value
+			if f16iter.Value != nil {
+				f16elem.Value = f16iter.Value
 			}
-			f15 = append(f15, *f15elem)
+			f16 = append(f16, *f16elem)
 		}
-		res.Taints = f15
+		res.Taints = f16
 	}
 	if r.ko.Spec.UpdateConfig != nil {
-		f16 := &svcsdktypes.NodegroupUpdateConfig{}
+		f17 := &svcsdktypes.NodegroupUpdateConfig{}
 		if r.ko.Spec.UpdateConfig.MaxUnavailable != nil {
 			maxUnavailableCopy0 := *r.ko.Spec.UpdateConfig.MaxUnavailable
 			if maxUnavailableCopy0 > math.MaxInt32 || maxUnavailableCopy0 < math.MinInt32 {
 				return nil, fmt.Errorf("error: field maxUnavailable is of type int32")
 			}
 			maxUnavailableCopy := int32(maxUnavailableCopy0)
-			f16.MaxUnavailable = &maxUnavailableCopy
+			f17.MaxUnavailable = &maxUnavailableCopy
 		}
 		if r.ko.Spec.UpdateConfig.MaxUnavailablePercentage != nil {
 			maxUnavailablePercentageCopy0 := *r.ko.Spec.UpdateConfig.MaxUnavailablePercentage
@@ -718,13 +933,42 @@ func (rm *resourceManager) newCreateRequestPayload(
 				return nil, fmt.Errorf("error: field maxUnavailablePercentage is of type int32")
 			}
 			maxUnavailablePercentageCopy := int32(maxUnavailablePercentageCopy0)
-			f16.MaxUnavailablePercentage = &maxUnavailablePercentageCopy
+			f17.MaxUnavailablePercentage = &maxUnavailablePercentageCopy
 		}
-		res.UpdateConfig = f16
+		res.UpdateConfig = f17
 	}
 	if r.ko.Spec.Version != nil {
 		res.Version = r.ko.Spec.Version
 	}
+	if r.ko.Spec.WarmPoolConfig != nil {
+		f19 := &svcsdktypes.WarmPoolConfig{}
+		if r.ko.Spec.WarmPoolConfig.Enabled != nil {
+			f19.Enabled = r.ko.Spec.WarmPoolConfig.Enabled
+		}
+		if r.ko.Spec.WarmPoolConfig.MaxGroupPreparedCapacity != nil {
+			maxGroupPreparedCapacityCopy0 := *r.ko.Spec.WarmPoolConfig.MaxGroupPreparedCapacity
+			if maxGroupPreparedCapacityCopy0 > math.MaxInt32 || maxGroupPreparedCapacityCopy0 < math.MinInt32 {
+				return nil, fmt.Errorf("error: field maxGroupPreparedCapacity is of type int32")
+			}
+			maxGroupPreparedCapacityCopy := int32(maxGroupPreparedCapacityCopy0)
+			f19.MaxGroupPreparedCapacity = &maxGroupPreparedCapacityCopy
+		}
+		if r.ko.Spec.WarmPoolConfig.MinSize != nil {
+			minSizeCopy0 := *r.ko.Spec.WarmPoolConfig.MinSize
+			if minSizeCopy0 > math.MaxInt32 || minSizeCopy0 < math.MinInt32 {
+				return nil, fmt.Errorf("error: field minSize is of type int32")
+			}
+			minSizeCopy := int32(minSizeCopy0)
+			f19.MinSize = &minSizeCopy
+		}
+		if r.ko.Spec.WarmPoolConfig.PoolState != nil {
+			f19.PoolState = svcsdktypes.WarmPoolState(*r.ko.Spec.WarmPoolConfig.PoolState)
+		}
+		if r.ko.Spec.WarmPoolConfig.ReuseOnScaleIn != nil {
+			f19.ReuseOnScaleIn = r.ko.Spec.WarmPoolConfig.ReuseOnScaleIn
+		}
+		res.WarmPoolConfig = f19
+	}
 
 	return res, nil
 }
@@ -753,11 +997,16 @@ func (rm *resourceManager) sdkDelete(
 	if nodegroupDeleting(r) {
 		return r, requeueWaitWhileDeleting
 	}
+	if err := rm.deleteReplacementNodegroup(ctx, r); err != nil {
+		return nil, err
+	}
 
 	input, err := rm.newDeleteRequestPayload(r)
 	if err != nil {
 		return nil, err
 	}
+	input.NodegroupName = nodegroupName(r)
+
 	var resp *svcsdk.DeleteNodegroupOutput
 	_ = resp
 	resp, err = rm.sdkapi.DeleteNodegroup(ctx, input)
@@ -911,6 +1160,77 @@ func (rm *resourceManager) terminalAWSError(err error) bool {
 	}
 }
 
+// newNodeRepairConfig returns a NodeRepairConfig object
+// with each the field set by the resource's corresponding spec field.
+func (rm *resourceManager) newNodeRepairConfig(
+	r *resource,
+) (*svcsdktypes.NodeRepairConfig, error) {
+	res := &svcsdktypes.NodeRepairConfig{}
+
+	if r.ko.Spec.NodeRepairConfig.Enabled != nil {
+		res.Enabled = r.ko.Spec.NodeRepairConfig.Enabled
+	}
+	if r.ko.Spec.NodeRepairConfig.MaxParallelNodesRepairedCount != nil {
+		maxParallelNodesRepairedCountCopy0 := *r.ko.Spec.NodeRepairConfig.MaxParallelNodesRepairedCount
+		if maxParallelNodesRepairedCountCopy0 > math.MaxInt32 || maxParallelNodesRepairedCountCopy0 < math.MinInt32 {
+			return nil, fmt.Errorf("error: field maxParallelNodesRepairedCount is of type int32")
+		}
+		maxParallelNodesRepairedCountCopy := int32(maxParallelNodesRepairedCountCopy0)
+		res.MaxParallelNodesRepairedCount = &maxParallelNodesRepairedCountCopy
+	}
+	if r.ko.Spec.NodeRepairConfig.MaxParallelNodesRepairedPercentage != nil {
+		maxParallelNodesRepairedPercentageCopy0 := *r.ko.Spec.NodeRepairConfig.MaxParallelNodesRepairedPercentage
+		if maxParallelNodesRepairedPercentageCopy0 > math.MaxInt32 || maxParallelNodesRepairedPercentageCopy0 < math.MinInt32 {
+			return nil, fmt.Errorf("error: field maxParallelNodesRepairedPercentage is of type int32")
+		}
+		maxParallelNodesRepairedPercentageCopy := int32(maxParallelNodesRepairedPercentageCopy0)
+		res.MaxParallelNodesRepairedPercentage = &maxParallelNodesRepairedPercentageCopy
+	}
+	if r.ko.Spec.NodeRepairConfig.MaxUnhealthyNodeThresholdCount != nil {
+		maxUnhealthyNodeThresholdCountCopy0 := *r.ko.Spec.NodeRepairConfig.MaxUnhealthyNodeThresholdCount
+		if maxUnhealthyNodeThresholdCountCopy0 > math.MaxInt32 || maxUnhealthyNodeThresholdCountCopy0 < math.MinInt32 {
+			return nil, fmt.Errorf("error: field maxUnhealthyNodeThresholdCount is of type int32")
+		}
+		maxUnhealthyNodeThresholdCountCopy := int32(maxUnhealthyNodeThresholdCountCopy0)
+		res.MaxUnhealthyNodeThresholdCount = &maxUnhealthyNodeThresholdCountCopy
+	}
+	if r.ko.Spec.NodeRepairConfig.MaxUnhealthyNodeThresholdPercentage != nil {
+		maxUnhealthyNodeThresholdPercentageCopy0 := *r.ko.Spec.NodeRepairConfig.MaxUnhealthyNodeThresholdPercentage
+		if maxUnhealthyNodeThresholdPercentageCopy0 > math.MaxInt32 || maxUnhealthyNodeThresholdPercentageCopy0 < math.MinInt32 {
+			return nil, fmt.Errorf("error: field maxUnhealthyNodeThresholdPercentage is of type int32")
+		}
+		maxUnhealthyNodeThresholdPercentageCopy := int32(maxUnhealthyNodeThresholdPercentageCopy0)
+		res.MaxUnhealthyNodeThresholdPercentage = &maxUnhealthyNodeThresholdPercentageCopy
+	}
+	if r.ko.Spec.NodeRepairConfig.NodeRepairConfigOverrides != nil {
+		f5 := []svcsdktypes.NodeRepairConfigOverrides{}
+		for _, f5iter := range r.ko.Spec.NodeRepairConfig.NodeRepairConfigOverrides {
+			f5elem := &svcsdktypes.NodeRepairConfigOverrides{}
+			if f5iter.MinRepairWaitTimeMins != nil {
+				minRepairWaitTimeMinsCopy0 := *f5iter.MinRepairWaitTimeMins
+				if minRepairWaitTimeMinsCopy0 > math.MaxInt32 || minRepairWaitTimeMinsCopy0 < math.MinInt32 {
+					return nil, fmt.Errorf("error: field minRepairWaitTimeMins is of type int32")
+				}
+				minRepairWaitTimeMinsCopy := int32(minRepairWaitTimeMinsCopy0)
+				f5elem.MinRepairWaitTimeMins = &minRepairWaitTimeMinsCopy
+			}
+			if f5iter.NodeMonitoringCondition != nil {
+				f5elem.NodeMonitoringCondition = f5iter.NodeMonitoringCondition
+			}
+			if f5iter.NodeUnhealthyReason != nil {
+				f5elem.NodeUnhealthyReason = f5iter.NodeUnhealthyReason
+			}
+			if f5iter.RepairAction != nil {
+				f5elem.RepairAction = svcsdktypes.RepairAction(*f5iter.RepairAction)
+			}
+			f5 = append(f5, *f5elem)
+		}
+		res.NodeRepairConfigOverrides = f5
+	}
+
+	return res, nil
+}
+
 // newNodegroupScalingConfig returns a NodegroupScalingConfig object
 // with each the field set by the resource's corresponding spec field.
 func (rm *resourceManager) newNodegroupScalingConfig(
@@ -972,3 +1292,39 @@ func (rm *resourceManager) newNodegroupUpdateConfig(
 
 	return res, nil
 }
+
+// newWarmPoolConfig returns a WarmPoolConfig object
+// with each the field set by the resource's corresponding spec field.
+func (rm *resourceManager) newWarmPoolConfig(
+	r *resource,
+) (*svcsdktypes.WarmPoolConfig, error) {
+	res := &svcsdktypes.WarmPoolConfig{}
+
+	if r.ko.Spec.WarmPoolConfig.Enabled != nil {
+		res.Enabled = r.ko.Spec.WarmPoolConfig.Enabled
+	}
+	if r.ko.Spec.WarmPoolConfig.MaxGroupPreparedCapacity != nil {
+		maxGroupPreparedCapacityCopy0 := *r.ko.Spec.WarmPoolConfig.MaxGroupPreparedCapacity
+		if maxGroupPreparedCapacityCopy0 > math.MaxInt32 || maxGroupPreparedCapacityCopy0 < math.MinInt32 {
+			return nil, fmt.Errorf("error: field maxGroupPreparedCapacity is of type int32")
+		}
+		maxGroupPreparedCapacityCopy := int32(maxGroupPreparedCapacityCopy0)
+		res.MaxGroupPreparedCapacity = &maxGroupPreparedCapacityCopy
+	}
+	if r.ko.Spec.WarmPoolConfig.MinSize != nil {
+		minSizeCopy0 := *r.ko.Spec.WarmPoolConfig.MinSize
+		if minSizeCopy0 > math.MaxInt32 || minSizeCopy0 < math.MinInt32 {
+			return nil, fmt.Errorf("error: field minSize is of type int32")
+		}
+		minSizeCopy := int32(minSizeCopy0)
+		res.MinSize = &minSizeCopy
+	}
+	if r.ko.Spec.WarmPoolConfig.PoolState != nil {
+		res.PoolState = svcsdktypes.WarmPoolState(*r.ko.Spec.WarmPoolConfig.PoolState)
+	}
+	if r.ko.Spec.WarmPoolConfig.ReuseOnScaleIn != nil {
+		res.ReuseOnScaleIn = r.ko.Spec.WarmPoolConfig.ReuseOnScaleIn
+	}
+
+	return res, nil
+}
@@ -15,6 +15,7 @@ package tags
 
 import (
 	"context"
+	"sort"
 
 	ackrtlog "github.com/aws-controllers-k8s/runtime/pkg/runtime/log"
 
@@ -41,7 +42,9 @@ import (
 // Below are some abstractions that can be used to abstract the implementation details
 // of tagging and untagging resources.
 
-type metricsRecorder interface {
+// MetricsRecorder records the outcome of the AWS API calls, see
+// ackmetrics.Metrics.
+type MetricsRecorder interface {
 	RecordAPICall(opType string, opID string, err error)
 }
 
@@ -57,7 +60,7 @@ type tagsClient interface {
 func SyncTags(
 	ctx context.Context,
 	client tagsClient,
-	mr metricsRecorder,
+	mr MetricsRecorder,
 	resourceARN string,
 	desiredTags map[string]string,
 	existingTags map[string]string,
@@ -66,27 +69,13 @@ func SyncTags(
 	exit := rlog.Trace("rm.syncTags")
 	defer func() { exit(err) }()
 
-	toAdd := map[string]string{}
-	toDelete := []string{}
-
-	for k, v := range desiredTags {
-		if ev, found := existingTags[k]; !found || ev != v {
-			toAdd[k] = v
-		}
-	}
-
-	for k, _ := range existingTags {
-		if _, found := desiredTags[k]; !found {
-			deleteKey := k
-			toDelete = append(toDelete, deleteKey)
-		}
-	}
+	toAdd, toDelete := Diff(desiredTags, existingTags)
 
 	if len(toAdd) > 0 {
 		for k, v := range toAdd {
 			rlog.Debug("adding tag to resource", "key", k, "value", v)
 		}
-		if err = addTags(
+		if err = AddTags(
 			ctx,
 			client,
 			mr,
@@ -100,7 +89,7 @@ func SyncTags(
 		for _, k := range toDelete {
 			rlog.Debug("removing tag from resource", "key", k)
 		}
-		if err = removeTags(
+		if err = RemoveTags(
 			ctx,
 			client,
 			mr,
@@ -114,11 +103,35 @@ func SyncTags(
 	return nil
 }
 
-// addTags adds the supplied Tags to the supplied resource
-func addTags(
+// Diff returns the Tags to add to a resource, and the sorted keys of the
+// Tags to remove from it, to go from the existing Tags to the desired ones.
+func Diff(
+	desiredTags map[string]string,
+	existingTags map[string]string,
+) (toAdd map[string]string, toDelete []string) {
+	toAdd = map[string]string{}
+	toDelete = []string{}
+
+	for k, v := range desiredTags {
+		if ev, found := existingTags[k]; !found || ev != v {
+			toAdd[k] = v
+		}
+	}
+
+	for k := range existingTags {
+		if _, found := desiredTags[k]; !found {
+			toDelete = append(toDelete, k)
+		}
+	}
+	sort.Strings(toDelete)
+	return toAdd, toDelete
+}
+
+// AddTags adds the supplied Tags to the supplied resource
+func AddTags(
 	ctx context.Context,
 	client tagsClient,
-	mr metricsRecorder,
+	mr MetricsRecorder,
 	resourceARN string,
 	tags map[string]string,
 ) (err error) {
@@ -136,11 +149,11 @@ func addTags(
 	return err
 }
 
-// removeTags removes the supplied Tags from the supplied resource
-func removeTags(
+// RemoveTags removes the supplied Tags from the supplied resource
+func RemoveTags(
 	ctx context.Context,
 	client tagsClient,
-	mr metricsRecorder,
+	mr MetricsRecorder,
 	resourceARN string,
 	tagKeys []string, // the set of tag keys to delete
 ) (err error) {
@@ -0,0 +1,77 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+// Package testutil provides the fake AWS clients shared by the tests of the
+// resource managers.
+package testutil
+
+import (
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	svcsdk "github.com/aws/aws-sdk-go-v2/service/eks"
+)
+
+// FakeHTTPClient answers the AWS requests with the response registered for
+// their method and path, and records them. A request without a registered
+// response fails with a ResourceNotFoundException.
+type FakeHTTPClient struct {
+	// Responses are the response bodies, keyed by "METHOD /path".
+	Responses map[string]string
+	// Requests are the "METHOD /path" keys of the requests received so far.
+	Requests []string
+}
+
+// Do implements the HTTP client interface of the AWS SDK.
+func (c *FakeHTTPClient) Do(req *http.Request) (*http.Response, error) {
+	key := req.Method + " " + req.URL.Path
+	c.Requests = append(c.Requests, key)
+	body, ok := c.Responses[key]
+	status := http.StatusOK
+	header := http.Header{}
+	if !ok {
+		status = http.StatusNotFound
+		body = `{"message": "not found"}`
+		header.Set("X-Amzn-Errortype", "ResourceNotFoundException")
+	}
+	return &http.Response{
+		StatusCode: status,
+		Header:     header,
+		Body:       io.NopCloser(strings.NewReader(body)),
+		Request:    req,
+	}, nil
+}
+
+// NewEKSClient returns an EKS client sending its requests, without retries,
+// through the supplied HTTP client.
+func NewEKSClient(hc svcsdk.HTTPClient) *svcsdk.Client {
+	return svcsdk.New(svcsdk.Options{
+		Region:           "us-west-2",
+		Credentials:      aws.AnonymousCredentials{},
+		HTTPClient:       hc,
+		RetryMaxAttempts: 1,
+	})
+}
+
+// NewClientConfig returns a client configuration whose clients send their
+// requests, without retries, through the supplied HTTP client.
+func NewClientConfig(hc aws.HTTPClient) aws.Config {
+	return aws.Config{
+		Region:           "us-west-2",
+		Credentials:      aws.AnonymousCredentials{},
+		HTTPClient:       hc,
+		RetryMaxAttempts: 1,
+	}
+}
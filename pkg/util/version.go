@@ -17,6 +17,8 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
+
+	utilversion "k8s.io/apimachinery/pkg/util/version"
 )
 
 // NOTE(a-hilaly): We can generalize this function and port it to the aws-controllers-k8s/pkg repository.
@@ -28,6 +30,8 @@ var (
 	ErrInvalidEKSKubernetesVersion = fmt.Errorf("invalid EKS kubernetes version")
 	// ErrInvalidEKSKubernetesReleaseVersion is an error that is returned when the given EKS kubernetes release version is invalid.
 	ErrInvalidEKSKubernetesReleaseVersion = fmt.Errorf("invalid EKS kubernetes release version")
+	// ErrInvalidAddonVersionConstraint is an error that is returned when the given add-on version constraint is invalid.
+	ErrInvalidAddonVersionConstraint = fmt.Errorf("invalid add-on version constraint")
 )
 
 // IncrementVersionMajor increments the minor version of the given EKS kubernetes version
@@ -97,6 +101,168 @@ func CompareEKSKubernetesVersions(version1, version2 string) (int, error) {
 	return 0, nil
 }
 
+// EKSMinorVersionSkew returns the number of minor versions version1 is ahead of
+// version2. The result is negative if version1 is behind version2. It returns an
+// error if the given versions are not in the expected format or if their major
+// versions differ.
+//
+// For example, given "1.30" and "1.28", it returns 2
+func EKSMinorVersionSkew(version1, version2 string) (int, error) {
+	majorVersion1, minorVersion1, err := parseEKSKubernetesVersion(version1)
+	if err != nil {
+		return 0, fmt.Errorf("failed to parse EKS kubernetes version: %w", err)
+	}
+	majorVersion2, minorVersion2, err := parseEKSKubernetesVersion(version2)
+	if err != nil {
+		return 0, fmt.Errorf("failed to parse EKS kubernetes version: %w", err)
+	}
+	if majorVersion1 != majorVersion2 {
+		return 0, fmt.Errorf("%w: %s and %s have different major versions", ErrInvalidEKSKubernetesVersion, version1, version2)
+	}
+	return minorVersion1 - minorVersion2, nil
+}
+
+// CompareAddonVersions compares two EKS add-on versions (e.g. "v1.19.0-eksbuild.1")
+// and returns 0 if they are equal, -1 if version1 is less than version2, and 1 if
+// version1 is greater than version2. It returns an error if the given versions are
+// not semantic versions.
+func CompareAddonVersions(version1, version2 string) (int, error) {
+	v1, err := utilversion.ParseSemantic(version1)
+	if err != nil {
+		return 0, fmt.Errorf("failed to parse add-on version: %w", err)
+	}
+	return v1.Compare(version2)
+}
+
+// MatchAddonVersionConstraint returns true if the given EKS add-on version (e.g.
+// "v1.19.0-eksbuild.1") satisfies the given constraint. It returns an error if the
+// constraint or the version are invalid.
+//
+// A constraint is a list of comparisons, separated by commas or spaces, that must
+// all be satisfied. A comparison is an operator followed by a partial version,
+// with an optional "v" prefix:
+//   - "~1.18" matches the versions from 1.18.0 up to, and excluding, 1.19.0
+//   - "^1.18" matches the versions from 1.18.0 up to, and excluding, 2.0.0
+//   - ">=1.18", ">1.18", "<=1.18" and "<1.18" compare with 1.18.0
+//   - "1.18" and "=1.18" match the 1.18 versions
+//
+// Only the major, minor and patch versions are compared, the eksbuild suffix is
+// ignored.
+func MatchAddonVersionConstraint(constraint, version string) (bool, error) {
+	comparisons, err := parseAddonVersionConstraint(constraint)
+	if err != nil {
+		return false, err
+	}
+	v, err := utilversion.ParseSemantic(version)
+	if err != nil {
+		return false, fmt.Errorf("failed to parse add-on version: %w", err)
+	}
+	actual := [3]int{int(v.Major()), int(v.Minor()), int(v.Patch())}
+	for _, c := range comparisons {
+		if !c.match(actual) {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// addonVersionComparison is a comparison of an add-on version constraint.
+type addonVersionComparison struct {
+	operator string
+	// version is the partial version of the comparison, padded with zeros.
+	version [3]int
+	// parts is the number of parts of the partial version.
+	parts int
+}
+
+// addonVersionOperators are the operators of add-on version comparisons,
+// longest first.
+var addonVersionOperators = []string{">=", "<=", ">", "<", "=", "~", "^"}
+
+// parseAddonVersionConstraint parses the comparisons of the given add-on version
+// constraint.
+func parseAddonVersionConstraint(constraint string) ([]addonVersionComparison, error) {
+	fields := strings.FieldsFunc(constraint, func(r rune) bool {
+		return r == ',' || r == ' '
+	})
+	if len(fields) == 0 {
+		return nil, fmt.Errorf("%w: %q: expected at least one comparison", ErrInvalidAddonVersionConstraint, constraint)
+	}
+	comparisons := make([]addonVersionComparison, 0, len(fields))
+	for _, field := range fields {
+		c := addonVersionComparison{operator: "="}
+		for _, operator := range addonVersionOperators {
+			if strings.HasPrefix(field, operator) {
+				c.operator = operator
+				field = field[len(operator):]
+				break
+			}
+		}
+		parts := strings.Split(strings.TrimPrefix(field, "v"), ".")
+		if len(parts) > 3 {
+			return nil, fmt.Errorf("%w: %q: expected a version of format major[.minor[.patch]]", ErrInvalidAddonVersionConstraint, constraint)
+		}
+		for i, part := range parts {
+			n, err := strconv.Atoi(part)
+			if err != nil || n < 0 {
+				return nil, fmt.Errorf("%w: %q: expected a version of format major[.minor[.patch]]", ErrInvalidAddonVersionConstraint, constraint)
+			}
+			c.version[i] = n
+		}
+		c.parts = len(parts)
+		comparisons = append(comparisons, c)
+	}
+	return comparisons, nil
+}
+
+// match returns true if the given major, minor and patch versions satisfy the
+// comparison.
+func (c addonVersionComparison) match(v [3]int) bool {
+	switch c.operator {
+	case ">=":
+		return compareVersionParts(v, c.version) >= 0
+	case "<=":
+		return compareVersionParts(v, c.version) <= 0
+	case ">":
+		return compareVersionParts(v, c.version) > 0
+	case "<":
+		return compareVersionParts(v, c.version) < 0
+	case "~":
+		upper := [3]int{c.version[0] + 1, 0, 0}
+		if c.parts > 1 {
+			upper = [3]int{c.version[0], c.version[1] + 1, 0}
+		}
+		return compareVersionParts(v, c.version) >= 0 && compareVersionParts(v, upper) < 0
+	case "^":
+		upper := [3]int{c.version[0] + 1, 0, 0}
+		if c.version[0] == 0 && c.parts > 1 {
+			upper = [3]int{0, c.version[1] + 1, 0}
+		}
+		return compareVersionParts(v, c.version) >= 0 && compareVersionParts(v, upper) < 0
+	default:
+		for i := 0; i < c.parts; i++ {
+			if v[i] != c.version[i] {
+				return false
+			}
+		}
+		return true
+	}
+}
+
+// compareVersionParts compares two major, minor and patch versions and returns
+// 0 if they are equal, -1 if v1 is less than v2, and 1 if v1 is greater than v2.
+func compareVersionParts(v1, v2 [3]int) int {
+	for i := range v1 {
+		if v1[i] < v2[i] {
+			return -1
+		}
+		if v1[i] > v2[i] {
+			return 1
+		}
+	}
+	return 0
+}
+
 // parseEKSKubernetesVersion parses the given EKS kubernetes version and returns the major and minor versions.
 // It returns an error if the given version is not in the EKS version format (major.minor).
 func parseEKSKubernetesVersion(version string) (int, int, error) {
@@ -217,6 +217,222 @@ func TestCompareEKSKubernetesVersions(t *testing.T) {
 	}
 }
 
+func TestEKSMinorVersionSkew(t *testing.T) {
+	type args struct {
+		version1 string
+		version2 string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    int
+		wantErr bool
+	}{
+		{
+			"empty string",
+			args{version1: "", version2: "1.16"},
+			0,
+			true,
+		},
+		{
+			"invalid version - patch versions",
+			args{version1: "1.16.8", version2: "1.16"},
+			0,
+			true,
+		},
+		{
+			"invalid version - different major versions",
+			args{version1: "2.0", version2: "1.16"},
+			0,
+			true,
+		},
+		{
+			"valid version - equal",
+			args{version1: "1.16", version2: "1.16"},
+			0,
+			false,
+		},
+		{
+			"valid version - version1 ahead of version2",
+			args{version1: "1.30", version2: "1.28"},
+			2,
+			false,
+		},
+		{
+			"valid version - version1 behind version2",
+			args{version1: "1.9", version2: "1.17"},
+			-8,
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := EKSMinorVersionSkew(tt.args.version1, tt.args.version2)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("EKSMinorVersionSkew() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("EKSMinorVersionSkew() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCompareAddonVersions(t *testing.T) {
+	type args struct {
+		version1 string
+		version2 string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    int
+		wantErr bool
+	}{
+		{
+			"invalid version",
+			args{version1: "latest", version2: "v1.19.0-eksbuild.1"},
+			0,
+			true,
+		},
+		{
+			"valid version - equal",
+			args{version1: "v1.19.0-eksbuild.1", version2: "v1.19.0-eksbuild.1"},
+			0,
+			false,
+		},
+		{
+			"valid version - newer eksbuild",
+			args{version1: "v1.19.0-eksbuild.2", version2: "v1.19.0-eksbuild.1"},
+			1,
+			false,
+		},
+		{
+			"valid version - older minor",
+			args{version1: "v1.18.6-eksbuild.1", version2: "v1.19.0-eksbuild.1"},
+			-1,
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := CompareAddonVersions(tt.args.version1, tt.args.version2)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("CompareAddonVersions() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("CompareAddonVersions() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMatchAddonVersionConstraint(t *testing.T) {
+	type args struct {
+		constraint string
+		version    string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    bool
+		wantErr bool
+	}{
+		{
+			"invalid constraint",
+			args{constraint: "latest", version: "v1.19.0-eksbuild.1"},
+			false,
+			true,
+		},
+		{
+			"empty constraint",
+			args{constraint: " , ", version: "v1.19.0-eksbuild.1"},
+			false,
+			true,
+		},
+		{
+			"invalid version",
+			args{constraint: "~1.19", version: "latest"},
+			false,
+			true,
+		},
+		{
+			"tilde - same minor",
+			args{constraint: "~1.18", version: "v1.18.6-eksbuild.2"},
+			true,
+			false,
+		},
+		{
+			"tilde - next minor",
+			args{constraint: "~1.18", version: "v1.19.0-eksbuild.1"},
+			false,
+			false,
+		},
+		{
+			"tilde - lower patch",
+			args{constraint: "~v1.18.3", version: "v1.18.2-eksbuild.1"},
+			false,
+			false,
+		},
+		{
+			"caret - next minor",
+			args{constraint: "^1.18", version: "v1.19.0-eksbuild.1"},
+			true,
+			false,
+		},
+		{
+			"caret - next major",
+			args{constraint: "^1.18", version: "v2.0.0-eksbuild.1"},
+			false,
+			false,
+		},
+		{
+			"caret - zero major",
+			args{constraint: "^0.3", version: "v0.4.0-eksbuild.1"},
+			false,
+			false,
+		},
+		{
+			"range - within",
+			args{constraint: ">=1.18, <1.20", version: "v1.19.4-eksbuild.1"},
+			true,
+			false,
+		},
+		{
+			"range - upper bound",
+			args{constraint: ">=1.18 <1.20", version: "v1.20.0-eksbuild.1"},
+			false,
+			false,
+		},
+		{
+			"partial version - match",
+			args{constraint: "1.18", version: "v1.18.6-eksbuild.1"},
+			true,
+			false,
+		},
+		{
+			"partial version - no match",
+			args{constraint: "=1.18", version: "v1.19.0-eksbuild.1"},
+			false,
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := MatchAddonVersionConstraint(tt.args.constraint, tt.args.version)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("MatchAddonVersionConstraint() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("MatchAddonVersionConstraint() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
 func Test_parseEKSKubernetesVersion(t *testing.T) {
 	type args struct {
 		version string
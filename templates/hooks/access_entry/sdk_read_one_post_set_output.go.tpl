@@ -1,3 +1,4 @@
 	if err := rm.setResourceAdditionalFields(ctx, ko); err != nil {
 		return nil, err
-	}
\ No newline at end of file
+	}
+	clearDryRunPlan(&resource{ko})
\ No newline at end of file
@@ -2,6 +2,9 @@
 	// stale create-time ResourceSynced=False condition (community#2967).
 	updatedDesired := rm.concreteResource(desired.DeepCopy())
 	updatedDesired.SetStatus(latest)
+	if dryRunEnabled(desired) {
+		return rm.planUpdate(ctx, desired, latest, delta)
+	}
 	if delta.DifferentAt("Spec.AccessPolicies") {
 		err := rm.syncAccessPolicies(ctx, desired, latest)
 		if err != nil {
@@ -0,0 +1,15 @@
+	if isAddonVersionAlias(desired.ko.Spec.AddonVersion) {
+		resolved, err := rm.resolveAddonVersion(ctx, desired)
+		if err != nil {
+			return nil, err
+		}
+		input.AddonVersion = &resolved
+	}
+	configurationValues, err := rm.desiredConfigurationValues(ctx, desired)
+	if err != nil {
+		return nil, err
+	}
+	input.ConfigurationValues = configurationValues
+	if err := rm.validateConfigurationValues(ctx, desired, input.AddonVersion, input.ConfigurationValues); err != nil {
+		return nil, err
+	}
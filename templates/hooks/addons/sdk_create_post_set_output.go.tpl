@@ -1,3 +1,11 @@
+	// The version alias is kept in the spec, the version it resolved to is
+	// reported in the status.
+	if isAddonVersionAlias(desired.ko.Spec.AddonVersion) {
+		ko.Status.ResolvedAddonVersion = ko.Spec.AddonVersion
+		ko.Spec.AddonVersion = desired.ko.Spec.AddonVersion
+	}
+	// So are configuration and configurationValuesFrom.
+	restoreConfiguration(desired, ko)
 	// We expect the addon to be in 'CREATING' status since we just issued
 	// the call to create it, but I suppose it doesn't hurt to check here.
 	if addonCreating(&resource{ko}) {
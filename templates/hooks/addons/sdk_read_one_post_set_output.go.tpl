@@ -1,10 +1,21 @@
 	if err := rm.setResourceAdditionalFields(ctx, ko, resp.Addon.PodIdentityAssociations); err != nil {
 		return nil, err
 	}
+	if err := rm.syncResolvedAddonVersion(ctx, r, &resource{ko}); err != nil {
+		return nil, err
+	}
+	configurationErr := rm.syncConfiguration(ctx, r, &resource{ko})
+	syncHealthConditions(ctx, &resource{ko})
+	clearDryRunPlan(&resource{ko})
 	if !addonActive(&resource{ko}) {
 		// Setting resource synced condition to false will trigger a requeue of
 		// the resource. No need to return a requeue error here.
 		ackcondition.SetSynced(&resource{ko}, corev1.ConditionFalse, nil, nil)
+	} else if configurationErr != nil {
+		// Requeue the add-on until the values selected by
+		// configurationValuesFrom can be read and compared.
+		msg := configurationErr.Error()
+		ackcondition.SetSynced(&resource{ko}, corev1.ConditionFalse, &msg, nil)
 	} else {
 		ackcondition.SetSynced(&resource{ko}, corev1.ConditionTrue, nil, nil)
 	}
\ No newline at end of file
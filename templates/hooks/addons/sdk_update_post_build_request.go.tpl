@@ -1,4 +1,23 @@
 	// If a user deleted all the PodIdentityAssociations we should send an empty list to the API
 	if delta.DifferentAt("Spec.PodIdentityAssociations") && len(desired.ko.Spec.PodIdentityAssociations) == 0 {
 		input.PodIdentityAssociations = []svcsdktypes.AddonPodIdentityAssociations{}
+	}
+	input.AddonVersion = updateAddonVersion(desired, latest, delta)
+	configurationValues, err := rm.desiredConfigurationValues(ctx, desired)
+	if err != nil {
+		return nil, err
+	}
+	input.ConfigurationValues = configurationValues
+	if delta.DifferentAt("Spec.ConfigurationValues") || delta.DifferentAt("Spec.Configuration") ||
+		delta.DifferentAt("Spec.AddonVersion") {
+		version := input.AddonVersion
+		if version == nil {
+			version = latest.ko.Status.ResolvedAddonVersion
+		}
+		if version == nil {
+			version = latest.ko.Spec.AddonVersion
+		}
+		if err := rm.validateConfigurationValues(ctx, desired, version, input.ConfigurationValues); err != nil {
+			return nil, err
+		}
 	}
\ No newline at end of file
@@ -1,3 +1,4 @@
+	ko.Status.ResolvedAddonVersion = latest.ko.Status.ResolvedAddonVersion
 	// Updating addons will very likely change the state of the addon
 	// so we should requeue the resource to check the status again.
 	returnAddonUpdating(&resource{ko})
\ No newline at end of file
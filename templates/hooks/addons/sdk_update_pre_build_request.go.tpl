@@ -13,6 +13,10 @@
 		return latest, requeueWaitUntilCanModify(latest)
 	}
 
+	if dryRunEnabled(desired) {
+		return rm.planUpdate(ctx, desired, latest, delta)
+	}
+
 	if delta.DifferentAt("Spec.Tags") {
 		err := syncTags(
 			ctx, rm.sdkapi, rm.metrics, 
@@ -0,0 +1,2 @@
+	clearDryRunPlan(&resource{ko})
+	syncHealthConditions(ctx, &resource{ko})
\ No newline at end of file
@@ -0,0 +1,6 @@
+	if err := rm.validateVersion(ctx, desired.ko.Spec.Version); err != nil {
+		return nil, err
+	}
+	if _, err := clusterDeletionPolicy(desired); err != nil {
+		return nil, err
+	}
@@ -1,9 +1,25 @@
 	if clusterDeleting(r) {
 		return r, requeueWaitWhileDeleting
 	}
-	inUse, err := rm.clusterInUse(ctx, r);
+	policy, err := clusterDeletionPolicy(r)
 	if err != nil {
 		return nil, err
-	} else if inUse {
-		return r, requeueWaitWhileInUse
+	}
+	switch policy {
+	case svcapitypes.ClusterDeletionPolicyCascade:
+		if err := rm.cascadeDelete(ctx, r); err != nil {
+			return r, err
+		}
+	case svcapitypes.ClusterDeletionPolicyBlock:
+		inUse, err := rm.clusterInUse(ctx, r)
+		if err != nil {
+			return nil, err
+		} else if inUse {
+			return r, requeueWaitWhileInUse
+		}
+	case svcapitypes.ClusterDeletionPolicyOrphan:
+		// The children, and their custom resources, are left in place.
+	}
+	if err := rm.deleteKubeconfigSecret(ctx, r); err != nil {
+		return nil, err
 	}
\ No newline at end of file
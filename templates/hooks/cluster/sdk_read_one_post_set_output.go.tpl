@@ -9,6 +9,29 @@
 	if r.ko.Spec.ResourcesVPCConfig != nil && r.ko.Spec.ResourcesVPCConfig.SecurityGroupRefs != nil {
 		ko.Spec.ResourcesVPCConfig.SecurityGroupRefs = r.ko.Spec.ResourcesVPCConfig.SecurityGroupRefs
 	}
+
+	if err := rm.syncUpdates(ctx, &resource{ko}); err != nil {
+		return nil, err
+	}
+	// The update plan is recomputed from the delta by customUpdate, any plan
+	// left in the status is stale once the cluster is read again.
+	ko.Status.UpdatePlan = nil
+	clearDryRunPlan(&resource{ko})
+	// Upgrade insights are only relevant while a version upgrade is pending.
+	if r.ko.Spec.Version == nil || ko.Spec.Version == nil || *r.ko.Spec.Version == *ko.Spec.Version {
+		clearUpgradeInsights(&resource{ko})
+	}
+	if clusterActive(&resource{ko}) {
+		if err := rm.syncUpgradeCascade(ctx, &resource{ko}); err != nil {
+			return nil, err
+		}
+		rm.syncKubeconfigSecret(ctx, &resource{ko})
+		if err := rm.syncVersionSupport(ctx, &resource{ko}); err != nil {
+			return nil, err
+		}
+	}
+	syncHealthConditions(ctx, &resource{ko})
+	syncMaintenanceWindowCondition(r, &resource{ko})
 	
 	if !clusterActive(&resource{ko}) {
 		// Setting resource synced condition to false will trigger a requeue of
@@ -0,0 +1 @@
+	rm.syncActivationSecret(ctx, &resource{ko})
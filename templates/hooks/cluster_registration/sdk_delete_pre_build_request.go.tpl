@@ -0,0 +1,6 @@
+	if clusterDeregistering(r) {
+		return r, requeueWaitWhileDeregistering
+	}
+	if err := rm.deleteActivationSecret(ctx, r); err != nil {
+		return nil, err
+	}
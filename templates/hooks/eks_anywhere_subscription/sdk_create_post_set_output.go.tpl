@@ -0,0 +1,3 @@
+	if err := rm.syncLicenseTokenSecret(ctx, &resource{ko}, resp.Subscription.Licenses); err != nil {
+		return &resource{ko}, err
+	}
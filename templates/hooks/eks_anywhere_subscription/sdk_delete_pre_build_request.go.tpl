@@ -0,0 +1,6 @@
+	if subscriptionDeleting(r) {
+		return r, requeueWaitWhileDeleting
+	}
+	if err := rm.deleteLicenseTokenSecret(ctx, r); err != nil {
+		return nil, err
+	}
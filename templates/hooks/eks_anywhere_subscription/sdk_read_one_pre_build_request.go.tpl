@@ -0,0 +1,7 @@
+	// Retrieve the subscription ID only during adoption
+	if r.ko.Status.ID == nil && runtime.NeedAdoption(r) {
+		r.ko.Status.ID, err = rm.getSubscriptionID(ctx, r)
+		if err != nil {
+			return nil, err
+		}
+	}
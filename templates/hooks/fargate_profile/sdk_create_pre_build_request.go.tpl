@@ -0,0 +1,3 @@
+	if err := rm.checkSelectorOverlap(ctx, desired); err != nil {
+		return nil, err
+	}
\ No newline at end of file
@@ -0,0 +1 @@
+	input.FargateProfileName = fargateProfileName(r)
\ No newline at end of file
@@ -1,3 +1,6 @@
 	if profileDeleting(r) {
 		return r, requeueWaitWhileDeleting
 	}
+	if err := rm.deleteReplacementFargateProfile(ctx, r); err != nil {
+		return r, err
+	}
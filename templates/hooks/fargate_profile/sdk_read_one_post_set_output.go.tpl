@@ -0,0 +1,9 @@
+	// Once replaced, the Fargate profile backing the resource is not named after it.
+	ko.Spec.Name = r.ko.Spec.Name
+	syncHealthConditions(ctx, &resource{ko})
+	if err := rm.syncReplacement(ctx, &resource{ko}); err != nil {
+		return nil, err
+	}
+	if err := rm.syncSelectorOverlap(ctx, &resource{ko}); err != nil {
+		return nil, err
+	}
\ No newline at end of file
@@ -1,5 +1,6 @@
 	identityProviderStatus := StatusCreating
 	ko.Status.Status = &identityProviderStatus
+	recordReplacementAssociation(&resource{ko}, resp.Update)
 
 	// Setting resource synced condition to false will trigger a requeue of
 	// the resource. No need to return a requeue error here.
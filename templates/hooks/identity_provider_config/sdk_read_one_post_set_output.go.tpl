@@ -1,5 +1,9 @@
 	if resp.IdentityProviderConfig.Oidc != nil {
 		ko.Spec.Tags = aws.StringMap(resp.IdentityProviderConfig.Oidc.Tags)
+		if resp.IdentityProviderConfig.Oidc.IdentityProviderConfigArn != nil {
+			arn := ackv1alpha1.AWSResourceName(*resp.IdentityProviderConfig.Oidc.IdentityProviderConfigArn)
+			ko.Status.ACKResourceMetadata.ARN = &arn
+		}
 	}
 	temp := string(resp.IdentityProviderConfig.Oidc.Status)
 	ko.Status.Status = &temp
@@ -10,3 +14,6 @@
 	} else {
 		ackcondition.SetSynced(&resource{ko}, corev1.ConditionTrue, nil, nil)
 	}
+	if err := rm.syncReplacement(ctx, &resource{ko}); err != nil {
+		return nil, err
+	}
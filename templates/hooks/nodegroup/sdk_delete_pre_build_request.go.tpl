@@ -1,3 +1,6 @@
 	if nodegroupDeleting(r) {
 		return r, requeueWaitWhileDeleting
 	}
+	if err := rm.deleteReplacementNodegroup(ctx, r); err != nil {
+		return nil, err
+	}
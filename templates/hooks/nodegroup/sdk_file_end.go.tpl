@@ -3,7 +3,7 @@
 
 {{/* Find the structure field within the operation */}}
 {{- range $fieldName, $field := $CRD.SpecFields -}}
-{{- if (or (eq $field.Path "ScalingConfig") (eq $field.Path "UpdateConfig")) }}
+{{- if (or (eq $field.Path "NodeRepairConfig") (eq $field.Path "ScalingConfig") (eq $field.Path "UpdateConfig") (eq $field.Path "WarmPoolConfig")) }}
 
 {{- $shapeName := $field.ShapeRef.ShapeName }}
 
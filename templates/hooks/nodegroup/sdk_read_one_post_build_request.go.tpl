@@ -0,0 +1 @@
+	input.NodegroupName = nodegroupName(r)
\ No newline at end of file
@@ -1,6 +1,15 @@
+	// Once replaced, the node group backing the resource is not named after it.
+	ko.Spec.Name = r.ko.Spec.Name
 	if ko.Spec.ScalingConfig != nil && ko.Spec.ScalingConfig.DesiredSize != nil {
 		ko.Status.DesiredSize = ko.Spec.ScalingConfig.DesiredSize
 	}
+	clearDryRunPlan(&resource{ko})
+	syncHealthConditions(ctx, &resource{ko})
+	syncActiveScalingSchedule(&resource{ko})
+	syncMaintenanceWindowCondition(r, &resource{ko})
+	if err := rm.syncResolvedLaunchTemplateVersion(ctx, r, &resource{ko}); err != nil {
+		return nil, err
+	}
 
 	if !nodegroupActive(&resource{ko}) {
 		// Setting resource synced condition to false will trigger a requeue of
@@ -9,4 +18,6 @@
 	} else {
 		ackcondition.SetSynced(&resource{ko}, corev1.ConditionTrue, nil, nil)
 	}
-
+	if err := rm.syncReplacement(ctx, &resource{ko}); err != nil {
+		return nil, err
+	}
\ No newline at end of file